@@ -40,6 +40,10 @@ func (e Entry) Meta(ctx context.Context) interface{} {
 		dest = &NotificationMetaData{}
 	case TypeCreated:
 		dest = &CreatedMetaData{}
+	case TypeAckTimeout:
+		dest = &AckTimeoutMetaData{}
+	case TypeSnoozed:
+		dest = &SnoozeMetaData{}
 	default:
 		return nil
 	}
@@ -105,14 +109,31 @@ func (e Entry) Subject() *Subject {
 }
 
 func escalationMsg(m *EscalationMetaData) string {
+	if m.Fallback {
+		return fmt.Sprintf(" to policy fallback after step #%d", m.NewStepIndex+1)
+	}
+	if m.SafetyNet {
+		return fmt.Sprintf(" to safety net after step #%d, as no one was successfully notified", m.NewStepIndex+1)
+	}
 	msg := fmt.Sprintf(" to step #%d", m.NewStepIndex+1)
+	if m.TargetIndex > 0 {
+		msg = fmt.Sprintf(" to target #%d on step #%d", m.TargetIndex+1, m.NewStepIndex+1)
+	}
 	if m.Repeat {
 		msg += " (policy repeat)"
 	}
+	switch m.TargetMode {
+	case "round_robin":
+		msg += " (round-robin)"
+	case "sequential":
+		msg += " (sequential)"
+	}
 	if m.Forced {
 		msg += " due to manual escalation"
 	} else if m.Deleted {
 		msg += " due to current step being deleted"
+	} else if m.Unreachable {
+		msg += " immediately, as no one could be notified"
 	} else if m.OldDelayMinutes > 0 {
 		msg += fmt.Sprintf(" automatically after %d minutes", m.OldDelayMinutes)
 	}
@@ -148,6 +169,20 @@ func (e Entry) String(ctx context.Context) string {
 		msg = "Suppressed duplicate: created"
 	case TypeEscalationRequest:
 		msg = "Escalation requested"
+	case TypeAckTimeout:
+		msg = "Re-triggered"
+		meta, ok := e.Meta(ctx).(*AckTimeoutMetaData)
+		if ok {
+			msg += fmt.Sprintf(" after acknowledgement timeout of %d minutes (%d of %d)", meta.TimeoutMinutes, meta.Count, meta.Limit)
+		}
+	case TypeSnoozed:
+		msg = "Snoozed"
+		meta, ok := e.Meta(ctx).(*SnoozeMetaData)
+		if ok {
+			msg += " until " + meta.Until.UTC().Format(time.RFC3339)
+		}
+	case TypeSnoozeExpired:
+		msg = "Snooze expired, re-triggered"
 	default:
 		return "Error"
 	}
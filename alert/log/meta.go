@@ -1,5 +1,7 @@
 package alertlog
 
+import "time"
+
 type EscalationMetaData struct {
 	NewStepIndex    int
 	Repeat          bool
@@ -7,6 +9,36 @@ type EscalationMetaData struct {
 	Deleted         bool
 	OldDelayMinutes int
 	NoOneOnCall     bool
+
+	// Fallback indicates the policy fallback target was notified.
+	Fallback bool
+
+	// Unreachable indicates the previous step was skipped as it was unable to notify anyone.
+	Unreachable bool
+
+	// SafetyNet indicates the configured safety net was notified, as the final step was
+	// reached without any successful notification.
+	SafetyNet bool
+
+	// TargetMode is the target mode of the new step (e.g., round_robin or sequential).
+	TargetMode string
+
+	// TargetIndex is the position of the notified user for sequential steps.
+	TargetIndex int
+}
+
+// AckTimeoutMetaData describes an alert being re-triggered after an acknowledgement timeout.
+type AckTimeoutMetaData struct {
+	TimeoutMinutes int
+
+	// Count is the number of times the alert has been re-triggered, up to Limit.
+	Count int
+	Limit int
+}
+
+// SnoozeMetaData describes an alert being snoozed.
+type SnoozeMetaData struct {
+	Until time.Time
 }
 
 type NotificationMetaData struct {
@@ -314,6 +314,13 @@ func (db *DB) logAny(ctx context.Context, tx *sql.Tx, insertStmt *sql.Stmt, id i
 			if r.subject.userID.String != "" {
 				r.subject.userID.Valid = true
 			}
+		case permission.SourceTypeAPIKey:
+			r.subject.classifier = "API"
+			r.subject.userID.String = permission.UserID(ctx)
+			if r.subject.userID.String != "" {
+				r.subject._type = SubjectTypeUser
+				r.subject.userID.Valid = true
+			}
 		case permission.SourceTypeContactMethod:
 			r.subject._type = SubjectTypeUser
 			r.subject.userID.String = permission.UserID(ctx)
@@ -12,4 +12,18 @@ var (
 		Name:      "created_total",
 		Help:      "The total number of created alerts.",
 	})
+
+	metricStormsTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: "goalert",
+		Subsystem: "alert",
+		Name:      "storms_total",
+		Help:      "The total number of alert storms started.",
+	})
+
+	metricStormAggregatedTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: "goalert",
+		Subsystem: "alert",
+		Name:      "storm_aggregated_total",
+		Help:      "The total number of new alerts aggregated into a storm alert.",
+	})
 )
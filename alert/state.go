@@ -10,4 +10,10 @@ type State struct {
 	StepNumber     int
 	RepeatCount    int
 	LastEscalation time.Time
+
+	// FallbackNotified indicates the escalation policy fallback has been notified.
+	FallbackNotified bool
+
+	// SnoozeUntil, if set, is when a snoozed alert will be re-triggered.
+	SnoozeUntil *time.Time
 }
@@ -19,6 +19,9 @@ import (
 
 const maxBatch = 500
 
+// MaxSnoozeDuration is the maximum length of time an alert can be snoozed for.
+const MaxSnoozeDuration = 7 * 24 * time.Hour
+
 type Store interface {
 	Manager
 	Create(context.Context, *Alert) (*Alert, error)
@@ -42,6 +45,9 @@ type Store interface {
 	LegacySearch(ctx context.Context, opt *LegacySearchOptions) ([]Alert, int, error)
 	Search(ctx context.Context, opts *SearchOptions) ([]Alert, error)
 	State(ctx context.Context, alertIDs []int) ([]State, error)
+
+	// Storm will return the current alert storm for the given service, or nil if there is none.
+	Storm(ctx context.Context, serviceID string) (*Storm, error)
 }
 type Manager interface {
 	FindOne(context.Context, int) (*Alert, error)
@@ -50,6 +56,12 @@ type Manager interface {
 	UpdateStatusByService(ctx context.Context, serviceID string, status Status) error
 	UpdateManyAlertStatus(ctx context.Context, status Status, alertIDs []int) (updatedAlertIDs []int, err error)
 	UpdateStatusTx(context.Context, *sql.Tx, int, Status) error
+
+	// Snooze will acknowledge an alert until the given time, after which it will be
+	// re-triggered at the current escalation step.
+	Snooze(ctx context.Context, alertID int, until time.Time) error
+	SnoozeTx(ctx context.Context, tx *sql.Tx, alertID int, until time.Time) error
+
 	EPID(ctx context.Context, alertID int) (string, error)
 
 	// ServiceInfo will return the name of the given service ID as well as the current number
@@ -85,9 +97,17 @@ type DB struct {
 
 	epID *sql.Stmt
 
-	escalate *sql.Stmt
-	epState  *sql.Stmt
-	svcInfo  *sql.Stmt
+	escalate      *sql.Stmt
+	snooze        *sql.Stmt
+	unsnooze      *sql.Stmt
+	unsnoozeBySvc *sql.Stmt
+	epState       *sql.Stmt
+	svcInfo       *sql.Stmt
+
+	stormState     *sql.Stmt
+	stormStart     *sql.Stmt
+	stormAggregate *sql.Stmt
+	findStorm      *sql.Stmt
 }
 
 // A Trigger signals that an alert needs to be processed
@@ -242,8 +262,25 @@ func NewDB(ctx context.Context, db *sql.DB, logDB alertlog.Store) (*DB, error) {
 			RETURNING state.alert_id
 		`),
 
+		snooze: p(`
+			UPDATE escalation_policy_state
+			SET snooze_until = $2
+			WHERE alert_id = $1
+		`),
+		unsnooze: p(`
+			UPDATE escalation_policy_state
+			SET snooze_until = NULL
+			WHERE alert_id = ANY($1) AND snooze_until NOTNULL
+			RETURNING alert_id
+		`),
+		unsnoozeBySvc: p(`
+			UPDATE escalation_policy_state
+			SET snooze_until = NULL
+			WHERE service_id = $1 AND snooze_until NOTNULL
+		`),
+
 		epState: p(`
-			SELECT alert_id, last_escalation, loop_count, escalation_policy_step_number 
+			SELECT alert_id, last_escalation, loop_count, escalation_policy_step_number, fallback_notified, snooze_until
 			FROM escalation_policy_state
 			WHERE alert_id = ANY ($1)
 		`),
@@ -255,6 +292,51 @@ func NewDB(ctx context.Context, db *sql.DB, logDB alertlog.Store) (*DB, error) {
 			FROM services
 			WHERE id = $1
 		`),
+
+		stormState: p(`
+			SELECT
+				svc.alert_storm_threshold,
+				svc.alert_storm_window_minutes,
+				CASE WHEN svc.alert_storm_threshold > 0 THEN (
+					SELECT count(*)
+					FROM alerts a
+					WHERE
+						a.service_id = svc.id AND
+						a.created_at > now() - interval '1 minute' * svc.alert_storm_window_minutes
+				) ELSE 0 END,
+				EXISTS (SELECT 1 FROM alerts a WHERE a.service_id = svc.id AND a.dedup_key = $2),
+				storm.alert_id,
+				storm.last_alert_at > now() - interval '1 minute' * svc.alert_storm_window_minutes
+			FROM services svc
+			LEFT JOIN alert_storms storm ON
+				storm.service_id = svc.id AND
+				EXISTS (SELECT 1 FROM alerts a WHERE a.id = storm.alert_id AND a.status != 'closed')
+			WHERE svc.id = $1
+		`),
+		stormStart: p(`
+			INSERT INTO alert_storms (service_id, alert_id, alert_count)
+			VALUES ($1, $2, 1)
+			ON CONFLICT (service_id) DO UPDATE
+			SET alert_id = $2, alert_count = 1, started_at = now(), last_alert_at = now()
+		`),
+		stormAggregate: p(`
+			UPDATE alert_storms
+			SET alert_count = alert_count + 1, last_alert_at = now()
+			WHERE service_id = $1
+		`),
+		findStorm: p(`
+			SELECT
+				storm.service_id,
+				storm.alert_id,
+				storm.alert_count,
+				storm.started_at,
+				storm.last_alert_at,
+				storm.last_alert_at > now() - interval '1 minute' * svc.alert_storm_window_minutes
+			FROM alert_storms storm
+			JOIN services svc ON svc.id = storm.service_id
+			JOIN alerts a ON a.id = storm.alert_id AND a.status != 'closed'
+			WHERE storm.service_id = $1
+		`),
 	}, prep.Err
 }
 
@@ -422,6 +504,14 @@ func (db *DB) UpdateStatusByService(ctx context.Context, serviceID string, statu
 		return err
 	}
 
+	if status == StatusActive {
+		// acknowledging ends any snooze
+		_, err = tx.StmtContext(ctx, db.unsnoozeBySvc).ExecContext(ctx, serviceID)
+		if err != nil {
+			return err
+		}
+	}
+
 	return tx.Commit()
 }
 
@@ -477,6 +567,18 @@ func (db *DB) UpdateManyAlertStatus(ctx context.Context, status Status, alertIDs
 		}
 		updatedIDs = append(updatedIDs, id)
 	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if status == StatusActive {
+		// acknowledging a snoozed alert ends the snooze
+		unsnoozed, err := db.unsnoozeTx(ctx, tx, alertIDs)
+		if err != nil {
+			return nil, err
+		}
+		updatedIDs = append(updatedIDs, unsnoozed...)
+	}
 
 	// Logging Batch Updates for every alertID whose status was updated
 	err = db.logDB.LogManyTx(ctx, tx, updatedIDs, t, nil)
@@ -521,17 +623,31 @@ func (db *DB) Create(ctx context.Context, a *Alert) (*Alert, error) {
 		return nil, err
 	}
 
-	n, meta, err := db._create(ctx, tx, *n)
+	stormAlert, isNew, err := db.stormTx(ctx, tx, n)
 	if err != nil {
 		return nil, err
 	}
+	if stormAlert != nil {
+		n = stormAlert
+	} else {
+		var meta *alertlog.CreatedMetaData
+		n, meta, err = db._create(ctx, tx, *n)
+		if err != nil {
+			return nil, err
+		}
 
-	db.logDB.MustLogTx(ctx, tx, n.ID, alertlog.TypeCreated, meta)
+		db.logDB.MustLogTx(ctx, tx, n.ID, alertlog.TypeCreated, meta)
+		isNew = true
+	}
 
 	err = tx.Commit()
 	if err != nil {
 		return nil, err
 	}
+	if !isNew {
+		// aggregated into an ongoing alert storm
+		return n, nil
+	}
 
 	trace.FromContext(ctx).Annotate(
 		[]trace.Attribute{
@@ -597,6 +713,15 @@ func (db *DB) CreateOrUpdateTx(ctx context.Context, tx *sql.Tx, a *Alert) (*Aler
 	var meta interface{}
 	switch n.Status {
 	case StatusTriggered:
+		var stormAlert *Alert
+		stormAlert, inserted, err = db.stormTx(ctx, tx, n)
+		if err != nil {
+			return nil, false, err
+		}
+		if stormAlert != nil {
+			return stormAlert, inserted, nil
+		}
+
 		var m alertlog.CreatedMetaData
 		err = tx.Stmt(db.createUpdNew).
 			QueryRowContext(ctx, n.Summary, n.Details, n.ServiceID, n.Source, n.DedupKey()).
@@ -618,6 +743,13 @@ func (db *DB) CreateOrUpdateTx(ctx context.Context, tx *sql.Tx, a *Alert) (*Aler
 			Scan(&n.ID, &n.Summary, &n.Details, &oldStatus, &n.CreatedAt)
 		if oldStatus != n.Status {
 			logType = alertlog.TypeAcknowledged
+		} else if err == nil {
+			// acknowledging a snoozed alert ends the snooze
+			var unsnoozed []int
+			unsnoozed, err = db.unsnoozeTx(ctx, tx, []int{n.ID})
+			if len(unsnoozed) > 0 {
+				logType = alertlog.TypeAcknowledged
+			}
 		}
 	case StatusClosed:
 		err = tx.Stmt(db.createUpdClose).
@@ -694,7 +826,17 @@ func (db *DB) UpdateStatusTx(ctx context.Context, tx *sql.Tx, id int, s Status)
 		return logError{isAlreadyClosed: true, alertID: id, _type: alertlog.TypeClosed, logDB: db.logDB}
 	}
 	if stat == StatusActive && s == StatusActive {
-		return logError{isAlreadyAcknowledged: true, alertID: id, _type: alertlog.TypeAcknowledged, logDB: db.logDB}
+		// acknowledging a snoozed alert ends the snooze
+		unsnoozed, err := db.unsnoozeTx(ctx, tx, []int{id})
+		if err != nil {
+			return err
+		}
+		if len(unsnoozed) == 0 {
+			return logError{isAlreadyAcknowledged: true, alertID: id, _type: alertlog.TypeAcknowledged, logDB: db.logDB}
+		}
+
+		db.logDB.MustLogTx(ctx, tx, id, alertlog.TypeAcknowledged, nil)
+		return nil
 	}
 
 	_, err = tx.Stmt(db.update).ExecContext(ctx, id, s)
@@ -735,6 +877,85 @@ func (db *DB) UpdateStatus(ctx context.Context, id int, s Status) error {
 	return tx.Commit()
 }
 
+func (db *DB) SnoozeTx(ctx context.Context, tx *sql.Tx, id int, until time.Time) error {
+	var stat Status
+	err := tx.Stmt(db.getStatusAndLockSvc).QueryRowContext(ctx, id).Scan(&stat)
+	if err != nil {
+		return err
+	}
+	if stat == StatusClosed {
+		return logError{isAlreadyClosed: true, alertID: id, _type: alertlog.TypeClosed, logDB: db.logDB}
+	}
+	if stat == StatusTriggered {
+		_, err = tx.Stmt(db.update).ExecContext(ctx, id, StatusActive)
+		if err != nil {
+			return err
+		}
+	}
+
+	res, err := tx.Stmt(db.snooze).ExecContext(ctx, id, until)
+	if err != nil {
+		return err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return validation.NewFieldError("AlertID", "alert is not being escalated")
+	}
+
+	return db.logDB.LogTx(ctx, tx, id, alertlog.TypeSnoozed, &alertlog.SnoozeMetaData{Until: until})
+}
+
+// unsnoozeTx will end the snooze of any of the provided alerts, returning the IDs of those that were snoozed.
+func (db *DB) unsnoozeTx(ctx context.Context, tx *sql.Tx, alertIDs []int) ([]int, error) {
+	rows, err := tx.StmtContext(ctx, db.unsnooze).QueryContext(ctx, sqlutil.IntArray(alertIDs))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ids []int
+	for rows.Next() {
+		var id int
+		err = rows.Scan(&id)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+
+	return ids, rows.Err()
+}
+
+func (db *DB) Snooze(ctx context.Context, id int, until time.Time) error {
+	now := time.Now()
+	if !until.After(now) {
+		return validation.NewFieldError("Until", "must be in the future")
+	}
+	if until.Sub(now) > MaxSnoozeDuration {
+		return validation.NewFieldError("Until", "must be within 7 days")
+	}
+	err := db.canTouchAlert(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	tx, err := db.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	err = db.SnoozeTx(ctx, tx, id, until)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
 func (db *DB) GetCreationTime(ctx context.Context, id int) (t time.Time, err error) {
 	err = permission.LimitCheckAny(ctx, permission.System, permission.Admin, permission.User)
 	if err != nil {
@@ -838,7 +1059,6 @@ func (db *DB) State(ctx context.Context, alertIDs []int) ([]State, error) {
 		return nil, err
 	}
 
-	var t sqlutil.NullTime
 	rows, err := db.epState.QueryContext(ctx, sqlutil.IntArray(alertIDs))
 	if errors.Is(err, sql.ErrNoRows) {
 		err = nil
@@ -851,15 +1071,19 @@ func (db *DB) State(ctx context.Context, alertIDs []int) ([]State, error) {
 	list := make([]State, 0, len(alertIDs))
 	for rows.Next() {
 		var s State
-		err = rows.Scan(&s.AlertID, &t, &s.RepeatCount, &s.StepNumber)
+		var t, snooze sqlutil.NullTime
+		err = rows.Scan(&s.AlertID, &t, &s.RepeatCount, &s.StepNumber, &s.FallbackNotified, &snooze)
+		if err != nil {
+			return nil, err
+		}
 		if t.Valid {
 			s.LastEscalation = t.Time
 		}
-		if err != nil {
-			return nil, err
+		if snooze.Valid {
+			s.SnoozeUntil = &snooze.Time
 		}
 		list = append(list, s)
 	}
 
-	return list, nil
+	return list, rows.Err()
 }
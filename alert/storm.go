@@ -0,0 +1,127 @@
+package alert
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	alertlog "github.com/target/goalert/alert/log"
+	"github.com/target/goalert/permission"
+	"github.com/target/goalert/util/log"
+	"github.com/target/goalert/util/sqlutil"
+	"github.com/target/goalert/validation/validate"
+
+	"github.com/pkg/errors"
+)
+
+// A Storm is an ongoing aggregation of new alerts for a service into a single storm alert.
+//
+// A storm starts when the number of alerts created for a service within its storm window
+// reaches the service's storm threshold. It ends once no new alerts have been received for
+// a full window, or the storm alert is closed.
+type Storm struct {
+	ServiceID string
+
+	// AlertID is the ID of the storm alert.
+	AlertID int
+
+	// AlertCount is the number of new alerts aggregated into the storm alert.
+	AlertCount int
+
+	StartedAt   time.Time
+	LastAlertAt time.Time
+
+	// Active indicates new alerts are currently being aggregated.
+	Active bool
+}
+
+func newStormAlert(a *Alert, threshold, windowMinutes int) *Alert {
+	return &Alert{
+		ServiceID: a.ServiceID,
+		Source:    a.Source,
+		Status:    StatusTriggered,
+		Summary:   fmt.Sprintf("Alert storm: %d or more alerts in %d minutes", threshold, windowMinutes),
+		Details: "New alerts for this service are being aggregated into this alert until the rate drops.\n\n" +
+			"First aggregated alert: " + a.Summary,
+	}
+}
+
+// stormTx will check if the new alert `a` should be aggregated into an alert storm for its service.
+//
+// If so, the storm alert is returned, creating it if necessary (indicated by isNew). Otherwise,
+// nil is returned and the alert should be created normally.
+func (db *DB) stormTx(ctx context.Context, tx *sql.Tx, a *Alert) (storm *Alert, isNew bool, err error) {
+	var threshold, window, recent int
+	var dup bool
+	var stormAlertID sql.NullInt64
+	var active sql.NullBool
+	err = tx.StmtContext(ctx, db.stormState).QueryRowContext(ctx, a.ServiceID, a.DedupKey()).
+		Scan(&threshold, &window, &recent, &dup, &stormAlertID, &active)
+	if err != nil {
+		return nil, false, err
+	}
+	if threshold == 0 || dup {
+		return nil, false, nil
+	}
+
+	if stormAlertID.Valid && (active.Bool || recent >= threshold) {
+		_, err = tx.StmtContext(ctx, db.stormAggregate).ExecContext(ctx, a.ServiceID)
+		if err != nil {
+			return nil, false, err
+		}
+
+		var s Alert
+		err = s.scanFrom(tx.StmtContext(ctx, db.findMany).QueryRowContext(ctx, sqlutil.IntArray{int(stormAlertID.Int64)}).Scan)
+		if err != nil {
+			return nil, false, err
+		}
+		metricStormAggregatedTotal.Inc()
+
+		return &s, false, nil
+	}
+	if recent < threshold {
+		return nil, false, nil
+	}
+
+	storm, meta, err := db._create(ctx, tx, *newStormAlert(a, threshold, window))
+	if err != nil {
+		return nil, false, err
+	}
+	db.logDB.MustLogTx(ctx, tx, storm.ID, alertlog.TypeCreated, meta)
+
+	_, err = tx.StmtContext(ctx, db.stormStart).ExecContext(ctx, a.ServiceID, storm.ID)
+	if err != nil {
+		return nil, false, err
+	}
+
+	ctx = log.WithFields(ctx, log.Fields{"AlertID": storm.ID, "ServiceID": storm.ServiceID})
+	log.Logf(ctx, "Alert storm started.")
+	metricStormsTotal.Inc()
+	metricStormAggregatedTotal.Inc()
+
+	return storm, true, nil
+}
+
+func (db *DB) Storm(ctx context.Context, serviceID string) (*Storm, error) {
+	err := permission.LimitCheckAny(ctx, permission.System, permission.User)
+	if err != nil {
+		return nil, err
+	}
+
+	err = validate.UUID("ServiceID", serviceID)
+	if err != nil {
+		return nil, err
+	}
+
+	var s Storm
+	err = db.findStorm.QueryRowContext(ctx, serviceID).Scan(&s.ServiceID, &s.AlertID, &s.AlertCount, &s.StartedAt, &s.LastAlertAt, &s.Active)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &s, nil
+}
@@ -0,0 +1,64 @@
+package apikey
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/target/goalert/validation"
+	"github.com/target/goalert/validation/validate"
+)
+
+// An APIKey provides long-lived access to the GraphQL API.
+type APIKey struct {
+	ID          string
+	Name        string
+	Description string
+
+	// UserID is the user the key acts on behalf of. If empty, the key is for a
+	// service account and is not associated with any user.
+	UserID string
+
+	Scope Scope
+
+	CreatedAt time.Time
+
+	// ExpiresAt is the time the key will stop working, or zero if it never expires.
+	ExpiresAt time.Time
+
+	// LastUsedAt is the last time the key was used, or zero if it never has been.
+	LastUsedAt time.Time
+
+	token string
+}
+
+// Token returns the authorization token associated with this APIKey. It
+// is only available when calling CreateTx.
+func (k APIKey) Token() string { return k.token }
+
+// IsServiceAccount returns true if the key is not associated with a user.
+func (k APIKey) IsServiceAccount() bool { return k.UserID == "" }
+
+// Normalize will validate and produce a normalized APIKey struct.
+func (k APIKey) Normalize() (*APIKey, error) {
+	if k.ID == "" {
+		k.ID = uuid.New().String()
+	}
+
+	err := validate.Many(
+		validate.UUID("ID", k.ID),
+		validate.IDName("Name", k.Name),
+		validate.Text("Description", k.Description, 1, 255),
+		validate.OneOf("Scope", k.Scope, ScopeReadOnly, ScopeAlertOps, ScopeAdmin),
+	)
+	if k.UserID != "" {
+		err = validate.Many(err, validate.UUID("UserID", k.UserID))
+	}
+	if !k.ExpiresAt.IsZero() && !k.ExpiresAt.After(time.Now()) {
+		err = validate.Many(err, validation.NewFieldError("ExpiresAt", "must be in the future"))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &k, nil
+}
@@ -0,0 +1,114 @@
+package apikey
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/target/goalert/permission"
+	"github.com/target/goalert/validation"
+)
+
+// Scope determines what an API key is allowed to do.
+type Scope string
+
+// Available scopes.
+const (
+	// ScopeReadOnly allows queries only.
+	ScopeReadOnly Scope = "read_only"
+
+	// ScopeAlertOps allows queries as well as creating and managing alerts.
+	ScopeAlertOps Scope = "alert_ops"
+
+	// ScopeAdmin allows all operations permitted by the key's role.
+	ScopeAdmin Scope = "admin"
+)
+
+// alertOpsMutations are the GraphQL mutations allowed with ScopeAlertOps.
+var alertOpsMutations = map[string]bool{
+	"createAlert":           true,
+	"updateAlerts":          true,
+	"updateAlertsByService": true,
+	"escalateAlerts":        true,
+	"snoozeAlert":           true,
+}
+
+// AllowsMutation returns true if the scope permits the named GraphQL mutation.
+func (s Scope) AllowsMutation(name string) bool {
+	switch s {
+	case ScopeAdmin:
+		return true
+	case ScopeAlertOps:
+		return alertOpsMutations[name]
+	}
+
+	return false
+}
+
+// Role returns the maximum role granted by the scope.
+func (s Scope) Role() permission.Role {
+	if s == ScopeAdmin {
+		return permission.RoleAdmin
+	}
+
+	return permission.RoleUser
+}
+
+type scopeContextKey int
+
+// ScopeContext will return a context with the provided Scope.
+func ScopeContext(ctx context.Context, s Scope) context.Context {
+	return context.WithValue(ctx, scopeContextKey(0), s)
+}
+
+// ScopeFromContext will return the Scope of the API key used to authorize a context, or an
+// empty string if the context was not authorized with an API key.
+func ScopeFromContext(ctx context.Context) Scope {
+	s, _ := ctx.Value(scopeContextKey(0)).(Scope)
+	return s
+}
+
+// CheckMutation will return a permission error if the context was authorized with an API key whose
+// scope does not permit the named GraphQL mutation.
+func CheckMutation(ctx context.Context, name string) error {
+	s := ScopeFromContext(ctx)
+	if s == "" || s.AllowsMutation(name) {
+		return nil
+	}
+
+	return permission.NewAccessDenied(fmt.Sprintf("API key scope '%s' does not allow %s", s, name))
+}
+
+// UnmarshalGQL implements the graphql.Marshaler interface
+func (s *Scope) UnmarshalGQL(v interface{}) error {
+	str, err := graphql.UnmarshalString(v)
+	if err != nil {
+		return err
+	}
+
+	switch str {
+	case "READ_ONLY":
+		*s = ScopeReadOnly
+	case "ALERT_OPS":
+		*s = ScopeAlertOps
+	case "ADMIN":
+		*s = ScopeAdmin
+	default:
+		return validation.NewFieldError("Scope", "unknown scope "+str)
+	}
+
+	return nil
+}
+
+// MarshalGQL implements the graphql.Marshaler interface
+func (s Scope) MarshalGQL(w io.Writer) {
+	switch s {
+	case ScopeReadOnly:
+		graphql.MarshalString("READ_ONLY").MarshalGQL(w)
+	case ScopeAlertOps:
+		graphql.MarshalString("ALERT_OPS").MarshalGQL(w)
+	case ScopeAdmin:
+		graphql.MarshalString("ADMIN").MarshalGQL(w)
+	}
+}
@@ -0,0 +1,21 @@
+package apikey
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScope_AllowsMutation(t *testing.T) {
+	assert.False(t, ScopeReadOnly.AllowsMutation("updateAlerts"))
+	assert.False(t, ScopeReadOnly.AllowsMutation("createService"))
+
+	assert.True(t, ScopeAlertOps.AllowsMutation("updateAlerts"))
+	assert.True(t, ScopeAlertOps.AllowsMutation("createAlert"))
+	assert.False(t, ScopeAlertOps.AllowsMutation("createService"))
+	assert.False(t, ScopeAlertOps.AllowsMutation("createAPIKey"))
+
+	assert.True(t, ScopeAdmin.AllowsMutation("createService"))
+
+	assert.False(t, Scope("").AllowsMutation("updateAlerts"))
+}
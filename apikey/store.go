@@ -0,0 +1,269 @@
+package apikey
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/target/goalert/auth/authtoken"
+	"github.com/target/goalert/keyring"
+	"github.com/target/goalert/permission"
+	"github.com/target/goalert/util"
+	"github.com/target/goalert/util/sqlutil"
+	"github.com/target/goalert/validation"
+	"github.com/target/goalert/validation/validate"
+)
+
+// Store allows the lookup and management of API keys.
+type Store struct {
+	db *sql.DB
+
+	authKey   *sql.Stmt
+	create    *sql.Stmt
+	findOne   *sql.Stmt
+	findAll   *sql.Stmt
+	findByUsr *sql.Stmt
+	delete    *sql.Stmt
+
+	keys keyring.Keyring
+}
+
+// NewStore will create a new Store with the given parameters.
+func NewStore(ctx context.Context, db *sql.DB, apiKeyring keyring.Keyring) (*Store, error) {
+	p := &util.Prepare{DB: db, Ctx: ctx}
+
+	return &Store{
+		db:   db,
+		keys: apiKeyring,
+
+		authKey: p.P(`
+			UPDATE api_keys k
+			SET last_used_at = now()
+			WHERE
+				id = $1 AND
+				date_trunc('second', created_at) = $2 AND
+				(expires_at ISNULL OR expires_at > now()) AND
+				NOT coalesce((SELECT disabled FROM users WHERE id = k.user_id), false)
+			RETURNING
+				user_id,
+				scope,
+				(SELECT role FROM users WHERE id = k.user_id),
+				array(SELECT team_id::text FROM team_members WHERE user_id = k.user_id)
+		`),
+		create: p.P(`
+			INSERT INTO api_keys (id, name, description, user_id, scope, expires_at)
+			VALUES ($1, $2, $3, $4, $5, $6)
+			RETURNING created_at
+		`),
+		findOne: p.P(`
+			SELECT id, name, description, user_id, scope, created_at, expires_at, last_used_at
+			FROM api_keys
+			WHERE id = $1
+		`),
+		findAll: p.P(`
+			SELECT id, name, description, user_id, scope, created_at, expires_at, last_used_at
+			FROM api_keys
+			ORDER BY lower(name)
+		`),
+		findByUsr: p.P(`
+			SELECT id, name, description, user_id, scope, created_at, expires_at, last_used_at
+			FROM api_keys
+			WHERE user_id = $1
+			ORDER BY lower(name)
+		`),
+		delete: p.P(`
+			DELETE FROM api_keys
+			WHERE id = any($1) AND ($2 OR user_id = $3)
+		`),
+	}, p.Err
+}
+
+func wrapTx(ctx context.Context, tx *sql.Tx, stmt *sql.Stmt) *sql.Stmt {
+	if tx == nil {
+		return stmt
+	}
+	return tx.StmtContext(ctx, stmt)
+}
+
+func (k *APIKey) scanFrom(scanFn func(...interface{}) error) error {
+	var userID sql.NullString
+	var expires, lastUsed sql.NullTime
+	err := scanFn(&k.ID, &k.Name, &k.Description, &userID, &k.Scope, &k.CreatedAt, &expires, &lastUsed)
+	if err != nil {
+		return err
+	}
+
+	k.UserID = userID.String
+	k.ExpiresAt = expires.Time
+	k.LastUsedAt = lastUsed.Time
+	return nil
+}
+
+// Authorize will return an authorized context associated with the given token. If the token is invalid,
+// expired, or otherwise can not be authenticated, an error is returned.
+func (s *Store) Authorize(ctx context.Context, tok authtoken.Token) (context.Context, error) {
+	if tok.Type != authtoken.TypeAPIKey {
+		return ctx, validation.NewFieldError("token", "invalid type")
+	}
+
+	var userID, userRole sql.NullString
+	var scope Scope
+	var teamIDs sqlutil.StringArray
+	err := s.authKey.QueryRowContext(ctx, tok.ID, tok.CreatedAt).Scan(&userID, &scope, &userRole, &teamIDs)
+	if errors.Is(err, sql.ErrNoRows) {
+		return ctx, validation.NewFieldError("token", "invalid or expired")
+	}
+	if err != nil {
+		return ctx, err
+	}
+
+	role := scope.Role()
+	if userID.Valid && permission.Role(userRole.String) != permission.RoleAdmin {
+		// never grant more than the user's own role
+		role = permission.RoleUser
+	}
+
+	ctx = ScopeContext(ctx, scope)
+	ctx = permission.UserSourceContext(ctx, userID.String, role, &permission.SourceInfo{
+		Type: permission.SourceTypeAPIKey,
+		ID:   tok.ID.String(),
+	})
+	return permission.UserTeamsContext(ctx, teamIDs), nil
+}
+
+// CreateTx will return a created API key with the given input. The token for the
+// key is only available from the returned value.
+//
+// Keys for service accounts, and keys with the admin scope, can only be created by an admin.
+func (s *Store) CreateTx(ctx context.Context, tx *sql.Tx, k *APIKey) (*APIKey, error) {
+	n, err := k.Normalize()
+	if err != nil {
+		return nil, err
+	}
+
+	if n.IsServiceAccount() || n.Scope == ScopeAdmin {
+		err = permission.LimitCheckAny(ctx, permission.System, permission.Admin)
+	} else {
+		err = permission.LimitCheckAny(ctx, permission.System, permission.Admin, permission.MatchUser(n.UserID))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var createdAt time.Time
+	err = wrapTx(ctx, tx, s.create).QueryRowContext(ctx,
+		n.ID, n.Name, n.Description,
+		sql.NullString{String: n.UserID, Valid: n.UserID != ""},
+		n.Scope,
+		sql.NullTime{Time: n.ExpiresAt, Valid: !n.ExpiresAt.IsZero()},
+	).Scan(&createdAt)
+	if err != nil {
+		return nil, err
+	}
+	n.CreatedAt = createdAt
+
+	tokID, err := uuid.Parse(n.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	n.token, err = authtoken.Token{
+		Type:      authtoken.TypeAPIKey,
+		Version:   2,
+		CreatedAt: createdAt,
+		ID:        tokID,
+	}.Encode(s.keys.Sign)
+	return n, err
+}
+
+// FindOne will return a single API key for the given id. Non-admin users may
+// only find their own keys.
+func (s *Store) FindOne(ctx context.Context, id string) (*APIKey, error) {
+	err := permission.LimitCheckAny(ctx, permission.System, permission.User)
+	if err != nil {
+		return nil, err
+	}
+
+	err = validate.UUID("ID", id)
+	if err != nil {
+		return nil, err
+	}
+
+	var k APIKey
+	err = k.scanFrom(s.findOne.QueryRowContext(ctx, id).Scan)
+	if errors.Is(err, sql.ErrNoRows) || (err == nil && !permission.Admin(ctx) && k.UserID != permission.UserID(ctx)) {
+		return nil, validation.NewFieldError("ID", "not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &k, nil
+}
+
+// FindAll will return all API keys, including those for service accounts.
+func (s *Store) FindAll(ctx context.Context) ([]APIKey, error) {
+	err := permission.LimitCheckAny(ctx, permission.System, permission.Admin)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.scanAll(s.findAll.QueryContext(ctx))
+}
+
+// FindAllByUser will return all API keys for the given user.
+func (s *Store) FindAllByUser(ctx context.Context, userID string) ([]APIKey, error) {
+	err := permission.LimitCheckAny(ctx, permission.System, permission.Admin, permission.MatchUser(userID))
+	if err != nil {
+		return nil, err
+	}
+
+	err = validate.UUID("UserID", userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.scanAll(s.findByUsr.QueryContext(ctx, userID))
+}
+
+func (s *Store) scanAll(rows *sql.Rows, err error) ([]APIKey, error) {
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var keys []APIKey
+	for rows.Next() {
+		var k APIKey
+		err = k.scanFrom(rows.Scan)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, k)
+	}
+
+	return keys, rows.Err()
+}
+
+// DeleteTx will delete the API keys with the given IDs. Non-admin users may only
+// delete their own keys.
+func (s *Store) DeleteTx(ctx context.Context, tx *sql.Tx, ids ...string) error {
+	err := permission.LimitCheckAny(ctx, permission.System, permission.User)
+	if err != nil {
+		return err
+	}
+
+	err = validate.ManyUUID("ID", ids, 50)
+	if err != nil {
+		return err
+	}
+
+	_, err = wrapTx(ctx, tx, s.delete).ExecContext(ctx,
+		sqlutil.UUIDArray(ids),
+		permission.Admin(ctx),
+		sql.NullString{String: permission.UserID(ctx), Valid: permission.UserID(ctx) != ""},
+	)
+	return err
+}
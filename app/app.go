@@ -10,10 +10,13 @@ import (
 	"github.com/pkg/errors"
 	"github.com/target/goalert/alert"
 	alertlog "github.com/target/goalert/alert/log"
+	"github.com/target/goalert/apikey"
 	"github.com/target/goalert/app/lifecycle"
+	"github.com/target/goalert/audit"
 	"github.com/target/goalert/auth"
 	"github.com/target/goalert/auth/basic"
 	"github.com/target/goalert/auth/nonce"
+	"github.com/target/goalert/auth/saml"
 	"github.com/target/goalert/calendarsubscription"
 	"github.com/target/goalert/config"
 	"github.com/target/goalert/engine"
@@ -31,16 +34,20 @@ import (
 	"github.com/target/goalert/notification/twilio"
 	"github.com/target/goalert/notificationchannel"
 	"github.com/target/goalert/oncall"
+	"github.com/target/goalert/oncall/workload"
 	"github.com/target/goalert/override"
 	"github.com/target/goalert/schedule"
 	"github.com/target/goalert/schedule/rotation"
 	"github.com/target/goalert/schedule/rule"
+	"github.com/target/goalert/scim"
 	"github.com/target/goalert/service"
+	"github.com/target/goalert/team"
 	"github.com/target/goalert/timezone"
 	"github.com/target/goalert/user"
 	"github.com/target/goalert/user/contactmethod"
 	"github.com/target/goalert/user/favorite"
 	"github.com/target/goalert/user/notificationrule"
+	"github.com/target/goalert/user/timeoff"
 	"github.com/target/goalert/util/log"
 	"github.com/target/goalert/util/sqlutil"
 	"google.golang.org/grpc"
@@ -72,6 +79,9 @@ type App struct {
 	Engine              *engine.Engine
 	graphql2            *graphqlapp.App
 	AuthHandler         *auth.Handler
+	samlProvider        *saml.Provider
+	basicProvider       *basic.Provider
+	scimHandler         *scim.Handler
 
 	twilioSMS    *twilio.SMS
 	twilioVoice  *twilio.Voice
@@ -99,7 +109,11 @@ type App struct {
 	RotationStore       rotation.Store
 
 	CalSubStore    *calendarsubscription.Store
+	APIKeyStore    *apikey.Store
+	TeamStore      *team.Store
+	AuditStore     *audit.Store
 	OverrideStore  override.Store
+	TimeOffStore   *timeoff.Store
 	Resolver       resolver.Resolver
 	LimitStore     *limit.Store
 	HeartbeatStore *heartbeat.Store
@@ -111,6 +125,7 @@ type App struct {
 	NonceStore    *nonce.Store
 	LabelStore    label.Store
 	OnCallStore   oncall.Store
+	WorkloadStore *workload.Store
 	NCStore       notificationchannel.Store
 	TimeZoneStore *timezone.Store
 	NoticeStore   *notice.Store
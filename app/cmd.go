@@ -309,6 +309,10 @@ Migration: %s (#%d)
 				serviceList = append(serviceList, service{name: "OIDC", baseUrl: cfg.OIDC.IssuerURL + "/.well-known.openid-configuration"})
 			}
 
+			if cfg.SAML.Enable {
+				serviceList = append(serviceList, service{name: "SAML", baseUrl: cfg.SAML.IDPMetadataURL})
+			}
+
 			if cfg.GitHub.Enable {
 				url := "https://github.com"
 				if cfg.GitHub.EnterpriseURL != "" {
@@ -570,7 +574,7 @@ Migration: %s (#%d)
 
 			ctx := permission.SystemContext(cmd.Context(), "AddUser")
 
-			basicStore, err := basic.NewStore(ctx, db)
+			basicStore, err := basic.NewStore(ctx, db, c.EncryptionKeys)
 			if err != nil {
 				return errors.Wrap(err, "init basic auth store")
 			}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/spf13/viper"
+	"github.com/target/goalert/audit"
 	"github.com/target/goalert/config"
 	"github.com/target/goalert/permission"
 	"github.com/target/goalert/util/log"
@@ -41,6 +42,11 @@ func getSetConfig(ctx context.Context, setCfg bool, data []byte) error {
 	}
 	defer tx.Rollback()
 
+	err = audit.SetActorTx(ctx, tx)
+	if err != nil {
+		return errors.Wrap(err, "set audit actor")
+	}
+
 	s, err := config.NewStore(ctx, db, c.EncryptionKeys, "")
 	if err != nil {
 		return errors.Wrap(err, "init config store")
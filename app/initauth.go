@@ -7,7 +7,9 @@ import (
 	"github.com/target/goalert/auth"
 	"github.com/target/goalert/auth/basic"
 	"github.com/target/goalert/auth/github"
+	"github.com/target/goalert/auth/ldap"
 	"github.com/target/goalert/auth/oidc"
+	"github.com/target/goalert/auth/saml"
 )
 
 func (app *App) initAuth(ctx context.Context) error {
@@ -18,6 +20,8 @@ func (app *App) initAuth(ctx context.Context) error {
 		SessionKeyring: app.SessionKeyring,
 		IntKeyStore:    app.IntegrationKeyStore,
 		CalSubStore:    app.CalSubStore,
+		APIKeyStore:    app.APIKeyStore,
+		TeamStore:      app.TeamStore,
 		APIKeyring:     app.APIKeyring,
 	})
 	if err != nil {
@@ -34,6 +38,15 @@ func (app *App) initAuth(ctx context.Context) error {
 	}
 	app.AuthHandler.AddIdentityProvider("oidc", oidcProvider)
 
+	app.samlProvider, err = saml.NewProvider(ctx, saml.Config{
+		Keyring:    app.OAuthKeyring,
+		NonceStore: app.NonceStore,
+	})
+	if err != nil {
+		return errors.Wrap(err, "init SAML auth provider")
+	}
+	app.AuthHandler.AddIdentityProvider("saml", app.samlProvider)
+
 	githubConfig := &github.Config{
 		Keyring:    app.OAuthKeyring,
 		NonceStore: app.NonceStore,
@@ -45,11 +58,19 @@ func (app *App) initAuth(ctx context.Context) error {
 	}
 	app.AuthHandler.AddIdentityProvider("github", githubProvider)
 
-	basicProvider, err := basic.NewProvider(ctx, app.AuthBasicStore)
+	ldapProvider, err := ldap.NewProvider(ctx)
+	if err != nil {
+		return errors.Wrap(err, "init LDAP auth provider")
+	}
+	app.AuthHandler.AddIdentityProvider("ldap", ldapProvider)
+
+	app.basicProvider, err = basic.NewProvider(ctx, app.AuthBasicStore, basic.Config{
+		Keyring: app.SessionKeyring,
+	})
 	if err != nil {
 		return errors.Wrap(err, "init basic auth provider")
 	}
-	app.AuthHandler.AddIdentityProvider("basic", basicProvider)
+	app.AuthHandler.AddIdentityProvider("basic", app.basicProvider)
 
 	return err
 }
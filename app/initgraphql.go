@@ -22,13 +22,18 @@ func (app *App) initGraphQL(ctx context.Context) error {
 		PolicyStore:         app.EscalationStore,
 		ScheduleStore:       app.ScheduleStore,
 		CalSubStore:         app.CalSubStore,
+		APIKeyStore:         app.APIKeyStore,
+		TeamStore:           app.TeamStore,
+		AuditStore:          app.AuditStore,
 		RotationStore:       app.RotationStore,
 		OnCallStore:         app.OnCallStore,
+		WorkloadStore:       app.WorkloadStore,
 		TimeZoneStore:       app.TimeZoneStore,
 		IntKeyStore:         app.IntegrationKeyStore,
 		LabelStore:          app.LabelStore,
 		RuleStore:           app.ScheduleRuleStore,
 		OverrideStore:       app.OverrideStore,
+		TimeOffStore:        app.TimeOffStore,
 		ConfigStore:         app.ConfigStore,
 		LimitStore:          app.LimitStore,
 		NotificationStore:   app.NotificationStore,
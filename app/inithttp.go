@@ -15,6 +15,7 @@ import (
 	"github.com/target/goalert/mailgun"
 	"github.com/target/goalert/notification/twilio"
 	prometheus "github.com/target/goalert/prometheusalertmanager"
+	"github.com/target/goalert/scim"
 	"github.com/target/goalert/site24x7"
 	"github.com/target/goalert/util/errutil"
 	"github.com/target/goalert/util/log"
@@ -178,6 +179,10 @@ func (app *App) initHTTP(ctx context.Context) error {
 
 	basicAuth := app.AuthHandler.IdentityProviderHandler("basic")
 	mux.HandleFunc("/api/v2/identity/providers/basic", basicAuth)
+	mux.HandleFunc("/api/v2/identity/providers/basic/enroll", app.basicProvider.ServeEnroll)
+
+	ldapAuth := app.AuthHandler.IdentityProviderHandler("ldap")
+	mux.HandleFunc("/api/v2/identity/providers/ldap", ldapAuth)
 
 	githubAuth := app.AuthHandler.IdentityProviderHandler("github")
 	mux.HandleFunc("/api/v2/identity/providers/github", githubAuth)
@@ -187,6 +192,11 @@ func (app *App) initHTTP(ctx context.Context) error {
 	mux.HandleFunc("/api/v2/identity/providers/oidc", oidcAuth)
 	mux.HandleFunc("/api/v2/identity/providers/oidc/callback", oidcAuth)
 
+	samlAuth := app.AuthHandler.IdentityProviderHandler("saml")
+	mux.HandleFunc("/api/v2/identity/providers/saml", samlAuth)
+	mux.HandleFunc("/api/v2/identity/providers/saml/acs", samlAuth)
+	mux.HandleFunc("/api/v2/identity/providers/saml/metadata", app.samlProvider.ServeMetadata)
+
 	mux.HandleFunc("/api/v2/mailgun/incoming", mailgun.IngressWebhooks(app.AlertStore, app.IntegrationKeyStore))
 	mux.HandleFunc("/api/v2/grafana/incoming", grafana.GrafanaToEventsAPI(app.AlertStore, app.IntegrationKeyStore))
 	mux.HandleFunc("/api/v2/site24x7/incoming", site24x7.Site24x7ToEventsAPI(app.AlertStore, app.IntegrationKeyStore))
@@ -196,6 +206,8 @@ func (app *App) initHTTP(ctx context.Context) error {
 	mux.HandleFunc("/api/v2/heartbeat/", generic.ServeHeartbeatCheck)
 	mux.HandleFunc("/api/v2/user-avatar/", generic.ServeUserAvatar)
 	mux.HandleFunc("/api/v2/calendar", app.CalSubStore.ServeICalData)
+	mux.HandleFunc("/api/v2/reports/oncall-workload.csv", app.WorkloadStore.ServeCSV)
+	mux.Handle(scim.BasePath+"/", app.scimHandler)
 
 	mux.HandleFunc("/api/v2/twilio/message", app.twilioSMS.ServeMessage)
 	mux.HandleFunc("/api/v2/twilio/message/status", app.twilioSMS.ServeStatusCallback)
@@ -6,6 +6,8 @@ import (
 
 	"github.com/target/goalert/alert"
 	alertlog "github.com/target/goalert/alert/log"
+	"github.com/target/goalert/apikey"
+	"github.com/target/goalert/audit"
 	"github.com/target/goalert/auth/basic"
 	"github.com/target/goalert/auth/nonce"
 	"github.com/target/goalert/calendarsubscription"
@@ -22,17 +24,21 @@ import (
 	"github.com/target/goalert/notification/slack"
 	"github.com/target/goalert/notificationchannel"
 	"github.com/target/goalert/oncall"
+	"github.com/target/goalert/oncall/workload"
 	"github.com/target/goalert/override"
 	"github.com/target/goalert/permission"
 	"github.com/target/goalert/schedule"
 	"github.com/target/goalert/schedule/rotation"
 	"github.com/target/goalert/schedule/rule"
+	"github.com/target/goalert/scim"
 	"github.com/target/goalert/service"
+	"github.com/target/goalert/team"
 	"github.com/target/goalert/timezone"
 	"github.com/target/goalert/user"
 	"github.com/target/goalert/user/contactmethod"
 	"github.com/target/goalert/user/favorite"
 	"github.com/target/goalert/user/notificationrule"
+	"github.com/target/goalert/user/timeoff"
 
 	"github.com/pkg/errors"
 )
@@ -137,7 +143,7 @@ func (app *App) initStores(ctx context.Context) error {
 	}
 
 	if app.AuthBasicStore == nil {
-		app.AuthBasicStore, err = basic.NewStore(ctx, app.db)
+		app.AuthBasicStore, err = basic.NewStore(ctx, app.db, app.cfg.EncryptionKeys)
 	}
 	if err != nil {
 		return errors.Wrap(err, "init basic auth store")
@@ -219,6 +225,13 @@ func (app *App) initStores(ctx context.Context) error {
 		return errors.Wrap(err, "init override store")
 	}
 
+	if app.TimeOffStore == nil {
+		app.TimeOffStore, err = timeoff.NewStore(ctx, app.db)
+	}
+	if err != nil {
+		return errors.Wrap(err, "init time off store")
+	}
+
 	if app.Resolver == nil {
 		app.Resolver, err = resolver.NewDB(ctx, app.db, app.ScheduleRuleStore, app.ScheduleStore)
 	}
@@ -252,6 +265,13 @@ func (app *App) initStores(ctx context.Context) error {
 		return errors.Wrap(err, "init on-call store")
 	}
 
+	if app.WorkloadStore == nil {
+		app.WorkloadStore, err = workload.NewStore(ctx, app.db)
+	}
+	if err != nil {
+		return errors.Wrap(err, "init on-call workload store")
+	}
+
 	if app.TimeZoneStore == nil {
 		app.TimeZoneStore = timezone.NewStore(ctx, app.db)
 	}
@@ -263,6 +283,34 @@ func (app *App) initStores(ctx context.Context) error {
 		return errors.Wrap(err, "init calendar subscription store")
 	}
 
+	if app.APIKeyStore == nil {
+		app.APIKeyStore, err = apikey.NewStore(ctx, app.db, app.APIKeyring)
+	}
+	if err != nil {
+		return errors.Wrap(err, "init API key store")
+	}
+
+	if app.TeamStore == nil {
+		app.TeamStore, err = team.NewStore(ctx, app.db)
+	}
+	if err != nil {
+		return errors.Wrap(err, "init team store")
+	}
+
+	if app.AuditStore == nil {
+		app.AuditStore, err = audit.NewStore(ctx, app.db)
+	}
+	if err != nil {
+		return errors.Wrap(err, "init audit store")
+	}
+
+	if app.scimHandler == nil {
+		app.scimHandler, err = scim.NewHandler(ctx, app.db, app.UserStore)
+	}
+	if err != nil {
+		return errors.Wrap(err, "init SCIM handler")
+	}
+
 	if app.NoticeStore == nil {
 		app.NoticeStore, err = notice.NewStore(ctx, app.db)
 	}
@@ -35,7 +35,7 @@ func (app *App) initSysAPI(ctx context.Context) error {
 
 	srv := grpc.NewServer(opts...)
 	reflection.Register(srv)
-	sysapi.RegisterSysAPIServer(srv, &sysapiserver.Server{UserStore: app.UserStore})
+	sysapi.RegisterSysAPIServer(srv, &sysapiserver.Server{UserStore: app.UserStore, APIKeyStore: app.APIKeyStore})
 	app.hSrv = health.NewServer()
 	grpc_health_v1.RegisterHealthServer(srv, app.hSrv)
 
@@ -31,6 +31,7 @@ const (
 	TargetTypeContactMethod
 	TargetTypeHeartbeatMonitor
 	TargetTypeUserSession
+	TargetTypeUserTimeOff
 )
 
 var _ graphql.Marshaler = TargetType(0)
@@ -71,6 +72,8 @@ func (tt *TargetType) UnmarshalText(data []byte) error {
 		*tt = TargetTypeHeartbeatMonitor
 	case "userSession":
 		*tt = TargetTypeUserSession
+	case "userTimeOff":
+		*tt = TargetTypeUserTimeOff
 	default:
 		return validation.NewFieldError("TargetType", "unknown target type "+str)
 	}
@@ -119,6 +122,8 @@ func (tt TargetType) MarshalText() ([]byte, error) {
 		return []byte("heartbeatMonitor"), nil
 	case TargetTypeUserSession:
 		return []byte("userSession"), nil
+	case TargetTypeUserTimeOff:
+		return []byte("userTimeOff"), nil
 	}
 
 	return nil, validation.NewFieldError("TargetType", "unknown target type "+tt.String())
@@ -0,0 +1,62 @@
+package audit
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/target/goalert/permission"
+)
+
+// ActorType identifies what made a change.
+type ActorType string
+
+// Possible actor types.
+const (
+	// ActorTypeSystem is used for changes made by the engine, CLI, or other internal components.
+	ActorTypeSystem ActorType = "system"
+
+	// ActorTypeUser is used for changes made by a user (e.g., the web UI).
+	ActorTypeUser ActorType = "user"
+
+	// ActorTypeAPIKey is used for changes made with an API key (e.g., SCIM provisioning).
+	ActorTypeAPIKey ActorType = "apiKey"
+
+	// ActorTypeIntegrationKey is used for changes made by an integration key or heartbeat monitor.
+	ActorTypeIntegrationKey ActorType = "integrationKey"
+)
+
+// actor returns the type, ID, and user ID (if any) responsible for changes made with ctx.
+func actor(ctx context.Context) (typ ActorType, id, userID string) {
+	if name := permission.SystemComponentName(ctx); name != "" {
+		return ActorTypeSystem, name, ""
+	}
+
+	userID = permission.UserID(ctx)
+	if src := permission.Source(ctx); src != nil {
+		switch src.Type {
+		case permission.SourceTypeAPIKey:
+			return ActorTypeAPIKey, src.ID, userID
+		case permission.SourceTypeIntegrationKey, permission.SourceTypeHeartbeat:
+			return ActorTypeIntegrationKey, src.ID, ""
+		}
+	}
+	if userID != "" {
+		return ActorTypeUser, userID, userID
+	}
+
+	return ActorTypeSystem, "", ""
+}
+
+// SetActorTx will attribute all changes made within tx to the actor of ctx.
+//
+// Changes made outside of a transaction with an actor set are recorded as ActorTypeSystem.
+func SetActorTx(ctx context.Context, tx *sql.Tx) error {
+	typ, id, userID := actor(ctx)
+	_, err := tx.ExecContext(ctx, `
+		select
+			set_config('goalert.audit_actor_type', $1, true),
+			set_config('goalert.audit_actor_id', $2, true),
+			set_config('goalert.audit_actor_user_id', $3, true)
+	`, string(typ), id, userID)
+	return err
+}
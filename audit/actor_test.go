@@ -0,0 +1,34 @@
+package audit
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/target/goalert/permission"
+)
+
+func TestActor(t *testing.T) {
+	check := func(desc string, ctx context.Context, expType ActorType, expID, expUserID string) {
+		t.Helper()
+		typ, id, userID := actor(ctx)
+		assert.Equal(t, expType, typ, desc+": type")
+		assert.Equal(t, expID, id, desc+": id")
+		assert.Equal(t, expUserID, userID, desc+": user id")
+	}
+
+	const userID = "a7ac7a3f-3bbf-4ff8-9a17-0a2d1a7a6e1b"
+	ctx := context.Background()
+
+	check("none", ctx, ActorTypeSystem, "", "")
+	check("system", permission.SystemContext(ctx, "Engine"), ActorTypeSystem, "Engine", "")
+	check("user", permission.UserContext(ctx, userID, permission.RoleAdmin), ActorTypeUser, userID, userID)
+	check("api key",
+		permission.UserSourceContext(ctx, userID, permission.RoleAdmin, &permission.SourceInfo{Type: permission.SourceTypeAPIKey, ID: "key"}),
+		ActorTypeAPIKey, "key", userID,
+	)
+	check("integration key",
+		permission.ServiceSourceContext(ctx, "svc", &permission.SourceInfo{Type: permission.SourceTypeIntegrationKey, ID: "intkey"}),
+		ActorTypeIntegrationKey, "intkey", "",
+	)
+}
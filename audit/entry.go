@@ -0,0 +1,56 @@
+package audit
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// Action describes the kind of change recorded by an Entry.
+type Action string
+
+// Possible actions.
+const (
+	ActionCreate Action = "create"
+	ActionUpdate Action = "update"
+	ActionDelete Action = "delete"
+)
+
+// ObjectType identifies the kind of object an Entry refers to.
+type ObjectType string
+
+// Object types recorded in the audit log.
+const (
+	ObjectTypeService                    ObjectType = "service"
+	ObjectTypeIntegrationKey             ObjectType = "integrationKey"
+	ObjectTypeEscalationPolicy           ObjectType = "escalationPolicy"
+	ObjectTypeEscalationPolicyStep       ObjectType = "escalationPolicyStep"
+	ObjectTypeEscalationPolicyStepTarget ObjectType = "escalationPolicyStepTarget"
+	ObjectTypeSchedule                   ObjectType = "schedule"
+	ObjectTypeScheduleRule               ObjectType = "scheduleRule"
+	ObjectTypeRotation                   ObjectType = "rotation"
+	ObjectTypeRotationParticipant        ObjectType = "rotationParticipant"
+	ObjectTypeUser                       ObjectType = "user"
+	ObjectTypeContactMethod              ObjectType = "contactMethod"
+	ObjectTypeConfig                     ObjectType = "config"
+)
+
+// Entry is a single recorded change.
+type Entry struct {
+	ID        int
+	Timestamp time.Time
+
+	ActorType ActorType
+	ActorID   string
+
+	// ActorUserID is the user responsible for the change, if any.
+	ActorUserID string
+
+	ObjectType ObjectType
+	ObjectID   string
+	Action     Action
+
+	// Before and After are the JSON state of the object. Before is nil
+	// for ActionCreate, and After is nil for ActionDelete.
+	Before json.RawMessage
+	After  json.RawMessage
+}
@@ -0,0 +1,151 @@
+package audit
+
+import (
+	"context"
+	"database/sql"
+	"text/template"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/target/goalert/permission"
+	"github.com/target/goalert/search"
+	"github.com/target/goalert/util/sqlutil"
+	"github.com/target/goalert/validation/validate"
+)
+
+// SearchOptions allow filtering and paginating the audit log.
+type SearchOptions struct {
+	After SearchCursor `json:"a,omitempty"`
+	Limit int          `json:"-"`
+
+	ObjectTypes  []ObjectType `json:"o,omitempty"`
+	ObjectID     string       `json:"i,omitempty"`
+	ActorUserIDs []string     `json:"u,omitempty"`
+	Actions      []Action     `json:"c,omitempty"`
+
+	// Start and End limit results to entries recorded at or after Start, and before End.
+	Start time.Time `json:"s,omitempty"`
+	End   time.Time `json:"e,omitempty"`
+}
+
+// SearchCursor is used to indicate a position in a paginated list.
+type SearchCursor struct {
+	ID int `json:"i,omitempty"`
+}
+
+var searchTemplate = template.Must(template.New("search").Parse(`
+	SELECT
+		id, timestamp, actor_type, actor_id, actor_user_id, object_type, object_id, action, before, after
+	FROM audit_logs
+	WHERE true
+	{{if .ObjectTypes}}
+		AND object_type = any(:objectTypes)
+	{{end}}
+	{{if .ObjectID}}
+		AND object_id = :objectID
+	{{end}}
+	{{if .ActorUserIDs}}
+		AND actor_user_id = any(:actorUserIDs)
+	{{end}}
+	{{if .Actions}}
+		AND action::text = any(:actions)
+	{{end}}
+	{{if not .Start.IsZero}}
+		AND timestamp >= :start
+	{{end}}
+	{{if not .End.IsZero}}
+		AND timestamp < :end
+	{{end}}
+	{{if .After.ID}}
+		AND id < :afterID
+	{{end}}
+	ORDER BY id DESC
+	LIMIT {{.Limit}}
+`))
+
+type renderData SearchOptions
+
+func (opts renderData) Normalize() (*renderData, error) {
+	if opts.Limit == 0 {
+		opts.Limit = search.DefaultMaxResults
+	}
+
+	err := validate.Many(
+		validate.Range("Limit", opts.Limit, 0, search.MaxResults),
+		validate.Range("ObjectTypes", len(opts.ObjectTypes), 0, 20),
+		validate.Text("ObjectID", opts.ObjectID, 0, 255),
+		validate.ManyUUID("ActorUserIDs", opts.ActorUserIDs, 50),
+		validate.Range("Actions", len(opts.Actions), 0, 3),
+	)
+	for _, a := range opts.Actions {
+		err = validate.Many(err, validate.OneOf("Actions", a, ActionCreate, ActionUpdate, ActionDelete))
+	}
+
+	return &opts, err
+}
+
+func (opts renderData) QueryArgs() []sql.NamedArg {
+	objTypes := make(sqlutil.StringArray, len(opts.ObjectTypes))
+	for i, t := range opts.ObjectTypes {
+		objTypes[i] = string(t)
+	}
+	actions := make(sqlutil.StringArray, len(opts.Actions))
+	for i, a := range opts.Actions {
+		actions[i] = string(a)
+	}
+
+	return []sql.NamedArg{
+		sql.Named("objectTypes", objTypes),
+		sql.Named("objectID", opts.ObjectID),
+		sql.Named("actorUserIDs", sqlutil.UUIDArray(opts.ActorUserIDs)),
+		sql.Named("actions", actions),
+		sql.Named("start", opts.Start),
+		sql.Named("end", opts.End),
+		sql.Named("afterID", opts.After.ID),
+	}
+}
+
+// Search will return audit log entries matching the provided options, newest first.
+func (s *Store) Search(ctx context.Context, opts *SearchOptions) ([]Entry, error) {
+	err := permission.LimitCheckAny(ctx, permission.System, permission.Admin)
+	if err != nil {
+		return nil, err
+	}
+	if opts == nil {
+		opts = &SearchOptions{}
+	}
+	data, err := (*renderData)(opts).Normalize()
+	if err != nil {
+		return nil, err
+	}
+	query, args, err := search.RenderQuery(ctx, searchTemplate, data)
+	if err != nil {
+		return nil, errors.Wrap(err, "render query")
+	}
+
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []Entry
+	for rows.Next() {
+		var e Entry
+		var actorUserID sql.NullString
+		var before, after []byte
+		err = rows.Scan(&e.ID, &e.Timestamp, &e.ActorType, &e.ActorID, &actorUserID, &e.ObjectType, &e.ObjectID, &e.Action, &before, &after)
+		if err != nil {
+			return nil, err
+		}
+		e.ActorUserID = actorUserID.String
+		e.Before = before
+		e.After = after
+		result = append(result, e)
+	}
+
+	return result, rows.Err()
+}
@@ -0,0 +1,19 @@
+package audit
+
+import (
+	"context"
+	"database/sql"
+)
+
+// Store allows searching audit log entries.
+//
+// Entries are recorded by database triggers when objects are changed (and by the config
+// store for config changes); the actor is attributed using SetActorTx.
+type Store struct {
+	db *sql.DB
+}
+
+// NewStore will create a new Store with the given parameters.
+func NewStore(ctx context.Context, db *sql.DB) (*Store, error) {
+	return &Store{db: db}, nil
+}
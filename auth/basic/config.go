@@ -1,5 +1,9 @@
 package basic
 
+import "github.com/target/goalert/keyring"
+
 // Config configures the basic auth provider
 type Config struct {
+	// Keyring is used to sign tokens for the MFA login step.
+	Keyring keyring.Keyring
 }
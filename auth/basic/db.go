@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"fmt"
 
+	"github.com/target/goalert/keyring"
 	"github.com/target/goalert/permission"
 	"github.com/target/goalert/util"
 	"github.com/target/goalert/validation/validate"
@@ -15,23 +16,78 @@ import (
 
 // Store can create new user/pass links and validate a username and password. bcrypt is used
 // for password storage & verification.
+//
+// Users may also enroll in TOTP multi-factor authentication, with secrets encrypted using keys.
 type Store struct {
+	db   *sql.DB
+	keys keyring.Keys
+
 	insert        *sql.Stmt
 	getByUsername *sql.Stmt
+
+	getUsername     *sql.Stmt
+	mfaState        *sql.Stmt
+	setMFA          *sql.Stmt
+	enableMFA       *sql.Stmt
+	deleteMFA       *sql.Stmt
+	setCounter      *sql.Stmt
+	deleteRecovery  *sql.Stmt
+	insertRecovery  *sql.Stmt
+	useRecoveryCode *sql.Stmt
+	getLockedAt     *sql.Stmt
+	addFailure      *sql.Stmt
+	resetFailures   *sql.Stmt
 }
 
 const tableName = "auth_basic_users"
 const passCost = 14
 
 // NewStore creates a new DB. Error is returned if the prepared statements fail to register.
-func NewStore(ctx context.Context, db *sql.DB) (*Store, error) {
+func NewStore(ctx context.Context, db *sql.DB, keys keyring.Keys) (*Store, error) {
 	p := &util.Prepare{
 		DB:  db,
 		Ctx: ctx,
 	}
 	return &Store{
+		db:   db,
+		keys: keys,
+
 		insert:        p.P(fmt.Sprintf("INSERT INTO %s(user_id, username, password_hash) VALUES ($1, $2, $3)", tableName)),
 		getByUsername: p.P(fmt.Sprintf("SELECT user_id, password_hash FROM %s WHERE username = $1", tableName)),
+
+		getUsername: p.P(fmt.Sprintf("SELECT username FROM %s WHERE user_id = $1", tableName)),
+		mfaState:    p.P(`SELECT totp_secret, enabled FROM auth_basic_mfa WHERE user_id = $1`),
+		setMFA: p.P(`
+			INSERT INTO auth_basic_mfa (user_id, totp_secret)
+			VALUES ($1, $2)
+			ON CONFLICT (user_id) DO UPDATE
+			SET totp_secret = $2, enabled = false, last_counter = 0, created_at = now()
+			WHERE NOT auth_basic_mfa.enabled
+		`),
+		enableMFA: p.P(`UPDATE auth_basic_mfa SET enabled = true WHERE user_id = $1`),
+		deleteMFA: p.P(`DELETE FROM auth_basic_mfa WHERE user_id = $1`),
+		setCounter: p.P(`
+			UPDATE auth_basic_mfa
+			SET last_counter = $2
+			WHERE user_id = $1 AND last_counter < $2
+		`),
+		deleteRecovery: p.P(`DELETE FROM auth_basic_recovery_codes WHERE user_id = $1`),
+		insertRecovery: p.P(`INSERT INTO auth_basic_recovery_codes (user_id, code_hash) VALUES ($1, $2)`),
+		useRecoveryCode: p.P(`
+			UPDATE auth_basic_recovery_codes
+			SET used_at = now()
+			WHERE user_id = $1 AND code_hash = $2 AND used_at ISNULL
+		`),
+		getLockedAt: p.P(`SELECT locked_at FROM auth_basic_mfa WHERE user_id = $1`),
+		addFailure: p.P(`
+			UPDATE auth_basic_mfa
+			SET
+				failed_attempts = CASE WHEN failed_attempts + 1 >= $2 THEN 0 ELSE failed_attempts + 1 END,
+				locked_at = CASE WHEN failed_attempts + 1 >= $2 THEN now() ELSE locked_at END
+			WHERE user_id = $1
+			RETURNING failed_attempts = 0
+		`),
+		resetFailures: p.P(`UPDATE auth_basic_mfa SET failed_attempts = 0 WHERE user_id = $1 AND failed_attempts > 0`),
 	}, p.Err
 }
 
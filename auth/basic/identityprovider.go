@@ -2,15 +2,32 @@ package basic
 
 import (
 	"context"
+	"encoding/json"
 	"net/http"
+	"time"
 
+	"github.com/golang-jwt/jwt/v4"
 	"github.com/pkg/errors"
 	"github.com/target/goalert/auth"
 	"github.com/target/goalert/config"
+	"github.com/target/goalert/permission"
+	"github.com/target/goalert/util/errutil"
 	"github.com/target/goalert/util/log"
 	"github.com/target/goalert/validation/validate"
 )
 
+const (
+	challengeAudience = "goalert-basic-mfa"
+
+	// challengeExpiration is the time allowed to complete the MFA step after entering a password.
+	challengeExpiration = 5 * time.Minute
+)
+
+var (
+	errChallengeExpired = auth.Error("Login expired. Please try again.")
+	errMFALocked        = auth.Error("Too many invalid codes. Please try again later.")
+)
+
 // Info implements the auth.Provider interface.
 func (Provider) Info(ctx context.Context) auth.ProviderInfo {
 	cfg := config.FromContext(ctx)
@@ -33,10 +50,92 @@ func userPass(req *http.Request) (string, string) {
 	return req.URL.User.Username(), p
 }
 
+// newChallengeToken returns a signed token identifying a user that has provided a valid password.
+func (p *Provider) newChallengeToken(userID string) (string, error) {
+	now := time.Now()
+	return p.cfg.Keyring.SignJWT(jwt.RegisteredClaims{
+		Subject:   userID,
+		Audience:  jwt.ClaimStrings{challengeAudience},
+		IssuedAt:  jwt.NewNumericDate(now),
+		ExpiresAt: jwt.NewNumericDate(now.Add(challengeExpiration)),
+	})
+}
+
+// challengeUserID returns the user ID and issue time of a valid challenge token.
+func (p *Provider) challengeUserID(tok string) (string, time.Time, bool) {
+	var claims jwt.RegisteredClaims
+	_, err := p.cfg.Keyring.VerifyJWT(tok, &claims)
+	if err != nil || !claims.VerifyAudience(challengeAudience, true) || claims.IssuedAt == nil {
+		return "", time.Time{}, false
+	}
+
+	return claims.Subject, claims.IssuedAt.Time, validate.UUID("UserID", claims.Subject) == nil
+}
+
+// challengeCookie returns the challenge token stored by the auth handler, if any.
+func challengeCookie(req *http.Request) string {
+	c, err := req.Cookie(auth.ChallengeCookieName)
+	if err != nil {
+		return ""
+	}
+
+	return c.Value
+}
+
+// checkLockout will return an error if MFA login is locked for the user, or if the
+// challenge token was issued (if not zero) before the most recent lockout.
+func (p *Provider) checkLockout(ctx context.Context, userID string, issued time.Time) error {
+	lockedAt, err := p.b.mfaLockedAt(ctx, userID)
+	if err != nil {
+		log.Log(ctx, errors.Wrap(err, "basic login: lookup MFA lockout"))
+		return auth.Error("Could not login. You can try again")
+	}
+	if time.Since(lockedAt) < mfaLockout {
+		return errMFALocked
+	}
+	if !issued.IsZero() && issued.Before(lockedAt) {
+		return errChallengeExpired
+	}
+
+	return nil
+}
+
+// codeFailed will record an invalid code for the user, returning errMFALocked if
+// too many have been entered, otherwise err.
+func (p *Provider) codeFailed(ctx context.Context, userID string, err error) error {
+	auth.Delay(ctx)
+	locked, dbErr := p.b.recordMFAFailure(ctx, userID)
+	if dbErr != nil {
+		log.Log(ctx, errors.Wrap(dbErr, "basic login: record MFA failure"))
+	}
+	if locked {
+		log.Logf(ctx, "basic login: MFA locked after %d invalid codes", mfaMaxFailures)
+		return errMFALocked
+	}
+
+	return err
+}
+
+// codeSucceeded will reset the count of invalid codes for the user.
+func (p *Provider) codeSucceeded(ctx context.Context, userID string) {
+	err := p.b.resetMFAFailures(ctx, userID)
+	if err != nil {
+		log.Log(ctx, errors.Wrap(err, "basic login: reset MFA failures"))
+	}
+}
+
 // ExtractIdentity implements the auth.IdentityProvider interface, providing identity based
 // on the given username and password fields.
+//
+// If the user has MFA enabled (or it is required), an auth.Challenge is returned for the
+// second login step, unless a valid `code` is provided with the password.
 func (p *Provider) ExtractIdentity(route *auth.RouteInfo, w http.ResponseWriter, req *http.Request) (*auth.Identity, error) {
 	ctx := req.Context()
+	cfg := config.FromContext(ctx)
+
+	if req.FormValue("challenge") == "1" {
+		return p.extractChallenge(ctx, challengeCookie(req), req.FormValue("code"))
+	}
 
 	username, password := userPass(req)
 	err := validate.Username("Username", username)
@@ -45,14 +144,155 @@ func (p *Provider) ExtractIdentity(route *auth.RouteInfo, w http.ResponseWriter,
 	}
 	ctx = log.WithField(ctx, "username", username)
 
-	_, err = p.b.Validate(ctx, username, password)
+	userID, err := p.b.Validate(ctx, username, password)
 	if err != nil {
 		log.Debug(ctx, errors.Wrap(err, "basic login"))
 		auth.Delay(ctx)
 		return nil, auth.Error("unknown username/password")
 	}
 
+	_, enabled, err := p.b.loadSecret(ctx, nil, userID)
+	if err != nil {
+		log.Log(ctx, errors.Wrap(err, "basic login: lookup MFA"))
+		return nil, auth.Error("Could not login. You can try again")
+	}
+	if !enabled && !cfg.Auth.RequireMFA {
+		return &auth.Identity{
+			SubjectID: username,
+		}, nil
+	}
+
+	if code := req.FormValue("code"); enabled && code != "" {
+		err = p.checkLockout(ctx, userID, time.Time{})
+		if err != nil {
+			return nil, err
+		}
+		err = p.b.ValidateMFA(ctx, userID, code)
+		if err != nil {
+			log.Debug(ctx, errors.Wrap(err, "basic login: MFA"))
+			return nil, p.codeFailed(ctx, userID, auth.Error("invalid code"))
+		}
+		p.codeSucceeded(ctx, userID)
+
+		return &auth.Identity{
+			SubjectID: username,
+		}, nil
+	}
+
+	tok, err := p.newChallengeToken(userID)
+	if err != nil {
+		log.Log(ctx, errors.Wrap(err, "basic login: sign challenge token"))
+		return nil, auth.Error("Could not login. You can try again")
+	}
+
+	return nil, auth.Challenge{Token: tok, Enroll: !enabled}
+}
+
+// extractChallenge handles the MFA login step, enabling MFA if the user is enrolling.
+//
+// After too many invalid codes, MFA login is locked for the user and the challenge token
+// may no longer be used.
+func (p *Provider) extractChallenge(ctx context.Context, tok, code string) (*auth.Identity, error) {
+	userID, issued, ok := p.challengeUserID(tok)
+	if !ok {
+		return nil, errChallengeExpired
+	}
+	ctx = log.WithField(ctx, "UserID", userID)
+
+	err := p.checkLockout(ctx, userID, issued)
+	if err != nil {
+		return nil, err
+	}
+
+	_, enabled, err := p.b.loadSecret(ctx, nil, userID)
+	if err != nil {
+		log.Log(ctx, errors.Wrap(err, "basic login: lookup MFA"))
+		return nil, auth.Error("Could not login. You can try again")
+	}
+	if code == "" {
+		return nil, auth.Challenge{Token: tok, Enroll: !enabled, Message: "A code is required."}
+	}
+
+	if enabled {
+		err = p.b.ValidateMFA(ctx, userID, code)
+	} else {
+		err = p.enable(ctx, userID, code)
+	}
+	if err != nil {
+		log.Debug(ctx, errors.Wrap(err, "basic login: MFA"))
+		return nil, p.codeFailed(ctx, userID, auth.Challenge{Token: tok, Enroll: !enabled, Message: "Invalid code."})
+	}
+	p.codeSucceeded(ctx, userID)
+
+	var username string
+	err = p.b.getUsername.QueryRowContext(ctx, userID).Scan(&username)
+	if err != nil {
+		log.Log(ctx, errors.Wrap(err, "basic login: lookup username"))
+		return nil, auth.Error("Could not login. You can try again")
+	}
+
 	return &auth.Identity{
 		SubjectID: username,
 	}, nil
 }
+
+func (p *Provider) enable(ctx context.Context, userID, code string) error {
+	tx, err := p.b.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	err = p.b.EnableMFATx(permission.SystemContext(ctx, "BasicMFA"), tx, userID, code)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// ServeEnroll will start MFA setup for a user enrolling during login, responding
+// with the secret and recovery codes as JSON.
+//
+// A valid challenge token cookie is required.
+func (p *Provider) ServeEnroll(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+	if req.Method != "POST" {
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	userID, issued, ok := p.challengeUserID(challengeCookie(req))
+	if !ok {
+		http.Error(w, errChallengeExpired.Error(), http.StatusUnauthorized)
+		return
+	}
+	err := p.checkLockout(ctx, userID, issued)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+		return
+	}
+
+	tx, err := p.b.db.BeginTx(ctx, nil)
+	if errutil.HTTPError(ctx, w, err) {
+		return
+	}
+	defer tx.Rollback()
+
+	// resume any pending setup, so reloading the page doesn't invalidate a secret already added to an app
+	setup, err := p.b.setupMFA(permission.SystemContext(ctx, "BasicMFA"), tx, userID, true)
+	if errutil.HTTPError(ctx, w, err) {
+		return
+	}
+	err = tx.Commit()
+	if errutil.HTTPError(ctx, w, err) {
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	err = json.NewEncoder(w).Encode(setup)
+	if err != nil {
+		log.Log(ctx, err)
+	}
+}
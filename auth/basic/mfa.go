@@ -0,0 +1,336 @@
+package basic
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/target/goalert/config"
+	"github.com/target/goalert/permission"
+	"github.com/target/goalert/util/sqlutil"
+	"github.com/target/goalert/validation"
+	"github.com/target/goalert/validation/validate"
+)
+
+const (
+	secretLen         = 20 // 160 bits, as recommended by RFC 4226
+	recoveryCodeCount = 10
+	recoveryCodeLen   = 10 // base32 characters, 50 bits
+
+	// mfaMaxFailures is the number of invalid codes allowed before MFA login is locked.
+	mfaMaxFailures = 5
+
+	// mfaLockout is how long MFA login is locked after too many invalid codes.
+	mfaLockout = 15 * time.Minute
+)
+
+// MFASetup contains the details needed to add a TOTP secret to an authenticator app.
+type MFASetup struct {
+	// Secret is the base32-encoded secret for manual entry.
+	Secret string
+
+	// URL is the otpauth URL of the secret, suitable for a QR code.
+	URL string
+
+	// RecoveryCodes are one-time codes that may be used in place of a TOTP code.
+	// They are only available at setup.
+	RecoveryCodes []string
+}
+
+// ErrMFAInvalidCode is returned when a TOTP or recovery code is not valid.
+var ErrMFAInvalidCode = errors.New("invalid code")
+
+func hashRecoveryCode(code string) []byte {
+	code = strings.ToUpper(strings.NewReplacer("-", "", " ", "").Replace(code))
+	sum := sha256.Sum256([]byte(code))
+	return sum[:]
+}
+
+func newRecoveryCode() (string, error) {
+	buf := make([]byte, 8)
+	_, err := rand.Read(buf)
+	if err != nil {
+		return "", err
+	}
+	code := b32.EncodeToString(buf)[:recoveryCodeLen]
+
+	return code[:5] + "-" + code[5:], nil
+}
+
+// MFAEnabled returns true if the user has completed MFA enrollment.
+func (b *Store) MFAEnabled(ctx context.Context, userID string) (bool, error) {
+	err := permission.LimitCheckAny(ctx, permission.System, permission.Admin, permission.MatchUser(userID))
+	if err != nil {
+		return false, err
+	}
+	err = validate.UUID("UserID", userID)
+	if err != nil {
+		return false, err
+	}
+
+	_, enabled, err := b.loadSecret(ctx, nil, userID)
+	return enabled, err
+}
+
+// loadSecret returns the decrypted TOTP secret for the user, if any.
+func (b *Store) loadSecret(ctx context.Context, tx *sql.Tx, userID string) (secret []byte, enabled bool, err error) {
+	stmt := b.mfaState
+	if tx != nil {
+		stmt = tx.StmtContext(ctx, stmt)
+	}
+
+	var encSecret []byte
+	err = stmt.QueryRowContext(ctx, userID).Scan(&encSecret, &enabled)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+
+	secret, _, err = b.keys.Decrypt(encSecret)
+	if err != nil {
+		return nil, false, errors.Wrap(err, "decrypt TOTP secret")
+	}
+
+	return secret, enabled, nil
+}
+
+// SetupMFATx will generate a new TOTP secret and recovery codes for the user. MFA is not
+// enabled until EnableMFATx is called with a valid code.
+//
+// An error is returned if MFA is already enabled for the user.
+func (b *Store) SetupMFATx(ctx context.Context, tx *sql.Tx, userID string) (*MFASetup, error) {
+	return b.setupMFA(ctx, tx, userID, false)
+}
+
+// setupMFA will setup MFA for the user, optionally re-using a pending secret
+// so that setup may be resumed (e.g., after entering an invalid code). Recovery
+// codes are always regenerated.
+func (b *Store) setupMFA(ctx context.Context, tx *sql.Tx, userID string, resume bool) (*MFASetup, error) {
+	err := permission.LimitCheckAny(ctx, permission.System, permission.MatchUser(userID))
+	if err != nil {
+		return nil, err
+	}
+	err = validate.UUID("UserID", userID)
+	if err != nil {
+		return nil, err
+	}
+
+	var username string
+	err = tx.StmtContext(ctx, b.getUsername).QueryRowContext(ctx, userID).Scan(&username)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, validation.NewFieldError("UserID", "MFA is only available for username/password logins")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var secret []byte
+	if resume {
+		secret, _, err = b.loadSecret(ctx, tx, userID)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if secret == nil {
+		secret = make([]byte, secretLen)
+		_, err = rand.Read(secret)
+		if err != nil {
+			return nil, err
+		}
+	}
+	encSecret, err := b.keys.Encrypt("TOTP_SECRET", secret)
+	if err != nil {
+		return nil, errors.Wrap(err, "encrypt TOTP secret")
+	}
+
+	res, err := tx.StmtContext(ctx, b.setMFA).ExecContext(ctx, userID, encSecret)
+	if err != nil {
+		return nil, err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return nil, err
+	}
+	if n == 0 {
+		return nil, validation.NewFieldError("UserID", "MFA is already enabled")
+	}
+
+	_, err = tx.StmtContext(ctx, b.deleteRecovery).ExecContext(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	codes := make([]string, recoveryCodeCount)
+	for i := range codes {
+		codes[i], err = newRecoveryCode()
+		if err != nil {
+			return nil, err
+		}
+		_, err = tx.StmtContext(ctx, b.insertRecovery).ExecContext(ctx, userID, hashRecoveryCode(codes[i]))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &MFASetup{
+		Secret:        b32.EncodeToString(secret),
+		URL:           totpURL(config.FromContext(ctx).ApplicationName(), username, secret),
+		RecoveryCodes: codes,
+	}, nil
+}
+
+// useTOTP will validate the code against the secret, and record it as used to prevent replay.
+func (b *Store) useTOTP(ctx context.Context, tx *sql.Tx, userID string, secret []byte, code string) error {
+	counter, ok := totpMatch(secret, code, time.Now())
+	if !ok {
+		return ErrMFAInvalidCode
+	}
+
+	stmt := b.setCounter
+	if tx != nil {
+		stmt = tx.StmtContext(ctx, stmt)
+	}
+	res, err := stmt.ExecContext(ctx, userID, int64(counter))
+	if err != nil {
+		return err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		// code (or a later one) was already used
+		return ErrMFAInvalidCode
+	}
+
+	return nil
+}
+
+// EnableMFATx will enable MFA for the user after validating a code from the pending secret.
+func (b *Store) EnableMFATx(ctx context.Context, tx *sql.Tx, userID, code string) error {
+	err := permission.LimitCheckAny(ctx, permission.System, permission.MatchUser(userID))
+	if err != nil {
+		return err
+	}
+	err = validate.UUID("UserID", userID)
+	if err != nil {
+		return err
+	}
+
+	secret, enabled, err := b.loadSecret(ctx, tx, userID)
+	if err != nil {
+		return err
+	}
+	if enabled {
+		return validation.NewFieldError("UserID", "MFA is already enabled")
+	}
+	if secret == nil {
+		return validation.NewFieldError("UserID", "MFA setup has not been started")
+	}
+
+	err = b.useTOTP(ctx, tx, userID, secret, code)
+	if errors.Is(err, ErrMFAInvalidCode) {
+		return validation.NewFieldError("Code", "invalid code")
+	}
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.StmtContext(ctx, b.enableMFA).ExecContext(ctx, userID)
+	return err
+}
+
+// DisableMFATx will remove MFA (including any pending setup) for the user.
+//
+// Admins may disable MFA for any user (e.g., for a lost device). Users may disable their own,
+// unless MFA is required by config.
+func (b *Store) DisableMFATx(ctx context.Context, tx *sql.Tx, userID string) error {
+	checks := []permission.Checker{permission.System, permission.Admin}
+	if !config.FromContext(ctx).Auth.RequireMFA {
+		checks = append(checks, permission.MatchUser(userID))
+	}
+	err := permission.LimitCheckAny(ctx, checks...)
+	if err != nil {
+		return err
+	}
+	err = validate.UUID("UserID", userID)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.StmtContext(ctx, b.deleteMFA).ExecContext(ctx, userID)
+	return err
+}
+
+// ValidateMFA will return nil if the code is a valid TOTP or unused recovery code
+// for the user. Codes may only be used once.
+func (b *Store) ValidateMFA(ctx context.Context, userID, code string) error {
+	err := validate.Many(
+		validate.UUID("UserID", userID),
+		validate.Text("Code", code, 1, 32),
+	)
+	if err != nil {
+		return err
+	}
+
+	secret, enabled, err := b.loadSecret(ctx, nil, userID)
+	if err != nil {
+		return err
+	}
+	if !enabled {
+		return errors.New("MFA not enabled")
+	}
+
+	err = b.useTOTP(ctx, nil, userID, secret, code)
+	if !errors.Is(err, ErrMFAInvalidCode) {
+		return err
+	}
+
+	res, err := b.useRecoveryCode.ExecContext(ctx, userID, hashRecoveryCode(code))
+	if err != nil {
+		return err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return ErrMFAInvalidCode
+	}
+
+	return nil
+}
+
+// mfaLockedAt returns the time MFA login was last locked for the user, if ever.
+func (b *Store) mfaLockedAt(ctx context.Context, userID string) (time.Time, error) {
+	var lockedAt sqlutil.NullTime
+	err := b.getLockedAt.QueryRowContext(ctx, userID).Scan(&lockedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return time.Time{}, nil
+	}
+
+	return lockedAt.Time, err
+}
+
+// recordMFAFailure will count an invalid code for the user, returning true if MFA login is
+// now locked.
+func (b *Store) recordMFAFailure(ctx context.Context, userID string) (bool, error) {
+	var locked bool
+	err := b.addFailure.QueryRowContext(ctx, userID, mfaMaxFailures).Scan(&locked)
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, nil
+	}
+
+	return locked, err
+}
+
+// resetMFAFailures will clear the count of invalid codes for the user.
+func (b *Store) resetMFAFailures(ctx context.Context, userID string) error {
+	_, err := b.resetFailures.ExecContext(ctx, userID)
+	return err
+}
@@ -6,10 +6,11 @@ import (
 
 // Provider implements the auth.IdentityProvider interface.
 type Provider struct {
-	b *Store
+	b   *Store
+	cfg Config
 }
 
 // NewProvider creates a new Provider with the associated config.
-func NewProvider(ctx context.Context, store *Store) (*Provider, error) {
-	return &Provider{b: store}, nil
+func NewProvider(ctx context.Context, store *Store, cfg Config) (*Provider, error) {
+	return &Provider{b: store, cfg: cfg}, nil
 }
@@ -0,0 +1,78 @@
+package basic
+
+import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// TOTP parameters (RFC 6238), using the defaults supported by all common authenticator apps.
+const (
+	totpPeriod = 30 * time.Second
+	totpDigits = 6
+	totpMod    = 1000000 // 10^totpDigits
+
+	// totpSkew is the number of periods before or after the current one that are accepted.
+	totpSkew = 1
+)
+
+var b32 = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// totpCode returns the code for the given secret and counter value (RFC 4226).
+func totpCode(secret []byte, counter uint64) string {
+	var buf [8]byte
+	binary.BigEndian.PutUint64(buf[:], counter)
+
+	h := hmac.New(sha1.New, secret)
+	h.Write(buf[:])
+	sum := h.Sum(nil)
+
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:]) & 0x7fffffff
+
+	return fmt.Sprintf("%0*d", totpDigits, value%totpMod)
+}
+
+func totpCounter(t time.Time) uint64 { return uint64(t.Unix() / int64(totpPeriod/time.Second)) }
+
+// totpMatch checks the code against the periods surrounding t, returning
+// the counter value of the matching period.
+func totpMatch(secret []byte, code string, t time.Time) (uint64, bool) {
+	code = strings.ReplaceAll(code, " ", "")
+	if len(code) != totpDigits {
+		return 0, false
+	}
+
+	now := totpCounter(t)
+	for c := now - totpSkew; c <= now+totpSkew; c++ {
+		if subtle.ConstantTimeCompare([]byte(totpCode(secret, c)), []byte(code)) == 1 {
+			return c, true
+		}
+	}
+
+	return 0, false
+}
+
+// totpURL returns an otpauth URL for adding the secret to an authenticator app.
+func totpURL(issuer, account string, secret []byte) string {
+	v := make(url.Values)
+	v.Set("secret", b32.EncodeToString(secret))
+	v.Set("issuer", issuer)
+	v.Set("algorithm", "SHA1")
+	v.Set("digits", fmt.Sprint(totpDigits))
+	v.Set("period", fmt.Sprint(int(totpPeriod/time.Second)))
+
+	u := url.URL{
+		Scheme:   "otpauth",
+		Host:     "totp",
+		Path:     "/" + issuer + ":" + account,
+		RawQuery: v.Encode(),
+	}
+	return u.String()
+}
@@ -0,0 +1,67 @@
+package basic
+
+import (
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTOTPCode(t *testing.T) {
+	// test vectors from RFC 6238 (SHA1), truncated to 6 digits
+	secret := []byte("12345678901234567890")
+	check := func(unix int64, expected string) {
+		t.Helper()
+		assert.Equal(t, expected, totpCode(secret, totpCounter(time.Unix(unix, 0))))
+	}
+
+	check(59, "287082")
+	check(1111111109, "081804")
+	check(1111111111, "050471")
+	check(1234567890, "005924")
+	check(2000000000, "279037")
+	check(20000000000, "353130")
+}
+
+func TestTOTPMatch(t *testing.T) {
+	secret := []byte("12345678901234567890")
+	now := time.Unix(1111111109, 0)
+
+	c, ok := totpMatch(secret, "081 804", now)
+	assert.True(t, ok)
+	assert.Equal(t, totpCounter(now), c)
+
+	_, ok = totpMatch(secret, "081804", now.Add(totpPeriod))
+	assert.True(t, ok, "previous period")
+
+	_, ok = totpMatch(secret, "081804", now.Add(3*totpPeriod))
+	assert.False(t, ok, "expired")
+
+	_, ok = totpMatch(secret, "000000", now)
+	assert.False(t, ok, "wrong code")
+
+	_, ok = totpMatch(secret, "81804", now)
+	assert.False(t, ok, "wrong length")
+}
+
+func TestTOTPURL(t *testing.T) {
+	u, err := url.Parse(totpURL("GoAlert", "jsmith", []byte("12345678901234567890")))
+	require.NoError(t, err)
+
+	assert.Equal(t, "otpauth", u.Scheme)
+	assert.Equal(t, "totp", u.Host)
+	assert.Equal(t, "/GoAlert:jsmith", u.Path)
+	assert.Equal(t, "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ", u.Query().Get("secret"))
+	assert.Equal(t, "GoAlert", u.Query().Get("issuer"))
+}
+
+func TestHashRecoveryCode(t *testing.T) {
+	assert.Equal(t, hashRecoveryCode("ABCDE-FGHIJ"), hashRecoveryCode("abcde fghij"), "normalized")
+	assert.NotEqual(t, hashRecoveryCode("ABCDE-FGHIJ"), hashRecoveryCode("ABCDE-FGHIK"))
+
+	code, err := newRecoveryCode()
+	require.NoError(t, err)
+	assert.Len(t, code, recoveryCodeLen+1)
+}
@@ -29,6 +29,20 @@ func SetCookieAge(w http.ResponseWriter, req *http.Request, name, value string,
 	})
 }
 
+// SetCrossSiteCookie behaves like SetCookie, but the cookie will also be sent with cross-site POST
+// requests (e.g., a SAML response posted by the identity provider). Browsers only accept such
+// cookies over HTTPS.
+func SetCrossSiteCookie(w http.ResponseWriter, req *http.Request, name, value string) {
+	http.SetCookie(w, &http.Cookie{
+		HttpOnly: true,
+		Secure:   true,
+		SameSite: http.SameSiteNoneMode,
+		Name:     name,
+		Path:     "/",
+		Value:    value,
+	})
+}
+
 // ClearCookie will clear and expire the cookie with the given name, for all API prefixes.
 func ClearCookie(w http.ResponseWriter, req *http.Request, name string) {
 	SetCookieAge(w, req, name, "", -time.Second)
@@ -166,61 +166,36 @@ func (p *Provider) ExtractIdentity(route *auth.RouteInfo, w http.ResponseWriter,
 		}
 	}
 
+	var orgs []string
+	if !inUsers {
+		orgs = append(orgs, cfg.GitHub.AllowedOrgs...)
+	}
+	orgs = append(orgs, cfg.GitHub.AdminOrgs...)
+	orgs = append(orgs, mappedOrgs(cfg.GitHub.TeamOrgs)...)
+
+	memberOf, err := orgMemberships(ctx, g, login, orgs)
+	if err != nil {
+		log.Log(ctx, err)
+		return nil, auth.Error("Failed to read GitHub org membership")
+	}
+
 	var inOrg bool
 	if !inUsers && len(cfg.GitHub.AllowedOrgs) > 0 {
-		for _, o := range cfg.GitHub.AllowedOrgs {
-			if strings.Contains(o, "/") {
-				//skip teams (process below)
-				continue
-			}
-			m, _, err := g.Organizations.IsMember(ctx, o, login)
-			if err != nil {
-				log.Log(ctx, errors.Wrap(err, "fetch GitHub org membership"))
-				return nil, auth.Error("Failed to read GitHub org membership")
-			}
-			if m {
+		for _, o := range memberOf {
+			if containsOrg(cfg.GitHub.AllowedOrgs, o) {
 				inOrg = true
 				ctx = log.WithField(ctx, "github_org", o)
-				log.Debugf(ctx, "GitHub Auth matched org")
+				log.Debugf(ctx, "GitHub Auth matched org or team")
 				break
 			}
 		}
 
+		// if still no match, log everything
 		if !inOrg {
-
-			opt := &github.ListOptions{}
-			teams := make([]string, 0, 30)
-
-		CheckTeams:
-			for {
-				tm, resp, err := g.Teams.ListUserTeams(ctx, opt)
-				if err != nil {
-					log.Log(ctx, errors.Wrap(err, "fetch GitHub teams"))
-					return nil, auth.Error("Failed to read GitHub team membership")
-				}
-				for _, t := range tm {
-					teamName := strings.ToLower(t.Organization.GetLogin()) + "/" + strings.ToLower(t.GetSlug())
-					teams = append(teams, teamName)
-					if containsOrg(cfg.GitHub.AllowedOrgs, teamName) {
-						inOrg = true
-						ctx = log.WithField(ctx, "github_team", teamName)
-						log.Debugf(ctx, "GitHub Auth matched team")
-						break CheckTeams
-					}
-				}
-				if resp.NextPage == 0 {
-					break
-				}
-				opt.Page = resp.NextPage
-			}
-
-			// if still no match, log everything
-			if !inOrg {
-				log.Debugf(log.WithFields(ctx, log.Fields{
-					"AllowedOrgs":     cfg.GitHub.AllowedOrgs,
-					"TeamMemberships": teams,
-				}), "not in any matching team or org")
-			}
+			log.Debugf(log.WithFields(ctx, log.Fields{
+				"AllowedOrgs": cfg.GitHub.AllowedOrgs,
+				"Memberships": memberOf,
+			}), "not in any matching team or org")
 		}
 	}
 
@@ -228,9 +203,14 @@ func (p *Provider) ExtractIdentity(route *auth.RouteInfo, w http.ResponseWriter,
 		return nil, auth.Error("Not a member of an allowed org or whitelisted user.")
 	}
 
-	return &auth.Identity{
+	id := &auth.Identity{
 		Email:     u.GetEmail(),
 		Name:      u.GetName(),
 		SubjectID: strconv.FormatInt(u.GetID(), 10),
-	}, nil
+	}
+	if len(cfg.GitHub.AdminOrgs) > 0 || len(cfg.GitHub.TeamOrgs) > 0 {
+		id.Groups = append([]string{}, memberOf...)
+	}
+
+	return id, nil
 }
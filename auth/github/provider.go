@@ -4,6 +4,8 @@ import (
 	"context"
 	"strings"
 
+	"github.com/google/go-github/github"
+	"github.com/pkg/errors"
 	"github.com/target/goalert/config"
 	"golang.org/x/oauth2"
 	o2Github "golang.org/x/oauth2/github"
@@ -24,7 +26,7 @@ func authConfig(ctx context.Context) *oauth2.Config {
 		tokenURL = strings.TrimSuffix(cfg.GitHub.EnterpriseURL, "/") + "/login/oauth/access_token"
 	}
 	scopes := []string{"read:user"}
-	if len(cfg.GitHub.AllowedOrgs) > 0 {
+	if len(cfg.GitHub.AllowedOrgs) > 0 || len(cfg.GitHub.AdminOrgs) > 0 || len(cfg.GitHub.TeamOrgs) > 0 {
 		scopes = append(scopes, "read:org")
 	}
 	return &oauth2.Config{
@@ -55,3 +57,61 @@ func containsOrg(orgs []string, name string) bool {
 	}
 	return false
 }
+
+// mappedOrgs returns the org and team names from mappings using the format 'org/team=Team Name'.
+func mappedOrgs(mapping []string) []string {
+	orgs := make([]string, 0, len(mapping))
+	for _, m := range mapping {
+		orgs = append(orgs, strings.TrimSpace(strings.SplitN(m, "=", 2)[0]))
+	}
+	return orgs
+}
+
+// orgMemberships returns the lower-cased names of the given orgs (or teams, using
+// the format 'org/team') the user is a member of.
+func orgMemberships(ctx context.Context, g *github.Client, login string, orgs []string) ([]string, error) {
+	var memberOf, checked []string
+	var checkTeams bool
+	for _, o := range orgs {
+		if strings.Contains(o, "/") {
+			// teams are processed below
+			checkTeams = true
+			continue
+		}
+		if containsOrg(checked, o) {
+			continue
+		}
+		checked = append(checked, o)
+
+		m, _, err := g.Organizations.IsMember(ctx, o, login)
+		if err != nil {
+			return nil, errors.Wrap(err, "fetch GitHub org membership")
+		}
+		if m {
+			memberOf = append(memberOf, strings.ToLower(o))
+		}
+	}
+	if !checkTeams {
+		return memberOf, nil
+	}
+
+	opt := &github.ListOptions{}
+	for {
+		tm, resp, err := g.Teams.ListUserTeams(ctx, opt)
+		if err != nil {
+			return nil, errors.Wrap(err, "fetch GitHub teams")
+		}
+		for _, t := range tm {
+			teamName := strings.ToLower(t.Organization.GetLogin()) + "/" + strings.ToLower(t.GetSlug())
+			if containsOrg(orgs, teamName) {
+				memberOf = append(memberOf, teamName)
+			}
+		}
+		if resp.NextPage == 0 {
+			break
+		}
+		opt.Page = resp.NextPage
+	}
+
+	return memberOf, nil
+}
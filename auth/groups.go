@@ -0,0 +1,119 @@
+package auth
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/target/goalert/config"
+	"github.com/target/goalert/permission"
+	"github.com/target/goalert/util/log"
+)
+
+// groupMapping returns the configured admin groups and team mappings for a provider.
+func groupMapping(cfg config.Config, providerID string) (adminGroups, teamGroups []string) {
+	switch providerID {
+	case "oidc":
+		return cfg.OIDC.AdminGroups, cfg.OIDC.TeamGroups
+	case "github":
+		return cfg.GitHub.AdminOrgs, cfg.GitHub.TeamOrgs
+	}
+
+	return nil, nil
+}
+
+// containsGroup returns true if any of the groups match any of the names (case-insensitive).
+func containsGroup(groups, names []string) bool {
+	for _, g := range groups {
+		for _, n := range names {
+			if strings.EqualFold(strings.TrimSpace(g), strings.TrimSpace(n)) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// teamMembership returns the lower-cased names of mapped teams, and whether the
+// groups grant membership to each.
+//
+// Mappings use the format 'group=Team Name'. A team mapped from multiple groups
+// requires membership in any one of them.
+func teamMembership(mapping, groups []string) map[string]bool {
+	teams := make(map[string]bool, len(mapping))
+	for _, m := range mapping {
+		parts := strings.SplitN(m, "=", 2)
+		if len(parts) != 2 {
+			continue
+		}
+		name := strings.ToLower(strings.TrimSpace(parts[1]))
+		teams[name] = teams[name] || containsGroup(groups, parts[:1])
+	}
+
+	return teams
+}
+
+// syncGroups will update the user's role and team membership from the groups
+// provided by the identity provider.
+func (h *Handler) syncGroups(ctx context.Context, providerID, userID string, groups []string) error {
+	adminGroups, teamGroups := groupMapping(config.FromContext(ctx), providerID)
+	if len(adminGroups) == 0 && len(teamGroups) == 0 {
+		return nil
+	}
+
+	tx, err := h.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	permission.SudoContext(ctx, func(ctx context.Context) {
+		if len(adminGroups) > 0 {
+			role := permission.RoleUser
+			if containsGroup(groups, adminGroups) {
+				role = permission.RoleAdmin
+			}
+			err = h.cfg.UserStore.SetUserRoleTx(ctx, tx, userID, role)
+			if err != nil {
+				err = errors.Wrap(err, "set user role")
+				return
+			}
+		}
+		if len(teamGroups) == 0 {
+			return
+		}
+
+		teams, findErr := h.cfg.TeamStore.FindAll(ctx)
+		if findErr != nil {
+			err = errors.Wrap(findErr, "lookup teams")
+			return
+		}
+		member := teamMembership(teamGroups, groups)
+		for _, t := range teams {
+			isMember, ok := member[strings.ToLower(t.Name)]
+			if !ok {
+				continue
+			}
+			delete(member, strings.ToLower(t.Name))
+
+			if isMember {
+				err = h.cfg.TeamStore.AddMembersTx(ctx, tx, t.ID, []string{userID})
+			} else {
+				err = h.cfg.TeamStore.RemoveMembersTx(ctx, tx, t.ID, []string{userID})
+			}
+			if err != nil {
+				err = errors.Wrapf(err, "update membership of team '%s'", t.Name)
+				return
+			}
+		}
+		for name := range member {
+			log.Log(ctx, errors.Errorf("sync groups: mapped team '%s' does not exist", name))
+		}
+	})
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
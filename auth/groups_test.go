@@ -0,0 +1,28 @@
+package auth
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTeamMembership(t *testing.T) {
+	mapping := []string{
+		"sre=Platform",
+		"platform-oncall = Platform",
+		"dba=Databases",
+		"Net Ops=Network",
+	}
+
+	assert.Equal(t, map[string]bool{
+		"platform":  true,
+		"databases": false,
+		"network":   true,
+	}, teamMembership(mapping, []string{"Platform-OnCall", "net ops"}))
+
+	assert.Equal(t, map[string]bool{
+		"platform":  false,
+		"databases": false,
+		"network":   false,
+	}, teamMembership(mapping, []string{}), "no groups")
+}
@@ -60,6 +60,7 @@ type Handler struct {
 	userSessions       *sql.Stmt
 	endSessionUser     *sql.Stmt
 	endAllSessionsUser *sql.Stmt
+	endUserSessions    *sql.Stmt
 }
 
 // NewHandler creates a new Handler using the provided config.
@@ -84,19 +85,20 @@ func NewHandler(ctx context.Context, db *sql.DB, cfg HandlerConfig) (*Handler, e
 		`),
 
 		userLookup: p.P(`
-			select user_id
-			from auth_subjects
+			select s.user_id, u.disabled
+			from auth_subjects s
+			join users u on u.id = s.user_id
 			where
-				provider_id = $1 and
-				subject_id = $2
+				s.provider_id = $1 and
+				s.subject_id = $2
 		`),
 		addSubject: p.P(`
 			insert into auth_subjects (provider_id, subject_id, user_id)
 			values ($1, $2, $3)
 		`),
 		startSession: p.P(`
-			insert into auth_user_sessions (id, user_agent, user_id)
-			values ($1, $2, $3)
+			insert into auth_user_sessions (id, user_agent, user_id, ip_address)
+			values ($1, $2, $3, $4)
 		`),
 		endSession: p.P(`
 			delete from auth_user_sessions
@@ -110,32 +112,48 @@ func NewHandler(ctx context.Context, db *sql.DB, cfg HandlerConfig) (*Handler, e
 		`),
 
 		fetchSession: p.P(`
-			with update as (
-				update auth_user_sessions
-				set last_access_at = now()
-				where id = $1 AND (last_access_at isnull OR last_access_at < now() - '1 minute'::interval)
+			with sess as (
+				select id, user_id, last_access_at
+				from auth_user_sessions
+				where
+					id = $1 and
+					coalesce(last_access_at, created_at) > now() - $3::int * '1 minute'::interval and
+					($4::int = 0 or created_at > now() - $4::int * '1 hour'::interval)
+			), update as (
+				update auth_user_sessions s
+				set last_access_at = now(), ip_address = $2
+				from sess
+				where s.id = sess.id and (sess.last_access_at isnull or sess.last_access_at < now() - '1 minute'::interval)
 			)
-			select sess.user_id, u.role
-			from auth_user_sessions sess
-			join users u on u.id = sess.user_id
-			where sess.id = $1
+			select
+				sess.user_id,
+				u.role,
+				array(select team_id::text from team_members where user_id = sess.user_id)
+			from sess
+			join users u on u.id = sess.user_id and not u.disabled
 		`),
 
 		userSessions: p.P(`
-			select id, user_agent, created_at, last_access_at
+			select id, user_agent, ip_address, created_at, last_access_at
 			from auth_user_sessions
 			where user_id = $1
+			order by created_at desc
 		`),
 
 		endSessionUser: p.P(`
 			delete from auth_user_sessions
-			where user_id = $1 and id = $2
+			where user_id = $1 and id = any($2)
 		`),
 
 		endAllSessionsUser: p.P(`
 			delete from auth_user_sessions
 			where user_id = $1 and id != $2
 		`),
+
+		endUserSessions: p.P(`
+			delete from auth_user_sessions
+			where user_id = $1
+		`),
 	}
 
 	return h, p.Err
@@ -145,6 +163,7 @@ func NewHandler(ctx context.Context, db *sql.DB, cfg HandlerConfig) (*Handler, e
 type UserSession struct {
 	ID           string
 	UserAgent    string
+	IPAddress    string
 	CreatedAt    time.Time
 	LastAccessAt time.Time
 	UserID       string
@@ -182,6 +201,30 @@ func (h *Handler) EndAllUserSessionsTx(ctx context.Context, tx *sql.Tx) error {
 	return err
 }
 
+// EndAllSessionsForUserTx ends all sessions for the given user. If the user is the
+// current user, the active session is left intact.
+func (h *Handler) EndAllSessionsForUserTx(ctx context.Context, tx *sql.Tx, userID string) error {
+	err := permission.LimitCheckAny(ctx, permission.Admin, permission.MatchUser(userID))
+	if err != nil {
+		return err
+	}
+	err = validate.UUID("UserID", userID)
+	if err != nil {
+		return err
+	}
+
+	if userID == permission.UserID(ctx) {
+		return h.EndAllUserSessionsTx(ctx, tx)
+	}
+
+	stmt := h.endUserSessions
+	if tx != nil {
+		stmt = tx.StmtContext(ctx, stmt)
+	}
+	_, err = stmt.ExecContext(ctx, userID)
+	return err
+}
+
 func (h *Handler) FindAllUserSessions(ctx context.Context, userID string) ([]UserSession, error) {
 	err := permission.LimitCheckAny(ctx, permission.Admin, permission.MatchUser(userID))
 	if err != nil {
@@ -197,7 +240,7 @@ func (h *Handler) FindAllUserSessions(ctx context.Context, userID string) ([]Use
 	for rows.Next() {
 		s := UserSession{UserID: userID}
 		var lastAccess sql.NullTime
-		err = rows.Scan(&s.ID, &s.UserAgent, &s.CreatedAt, &lastAccess)
+		err = rows.Scan(&s.ID, &s.UserAgent, &s.IPAddress, &s.CreatedAt, &lastAccess)
 		if err != nil {
 			return nil, err
 		}
@@ -273,8 +316,15 @@ func (h *Handler) IdentityProviderHandler(id string) http.HandlerFunc {
 
 		req = req.WithContext(ctx)
 
+		var external bool
+		if ext, ok := p.(ExternalCallbackProvider); ok && req.Method == "POST" {
+			external = ext.ExternalCallback(relativePath(id, req))
+		}
+
 		var refU *url.URL
-		if req.Method == "POST" {
+		if external {
+			refU = h.externalCallbackRedirURL(req)
+		} else if req.Method == "POST" {
 			var ok bool
 			refU, ok = h.refererURL(w, req)
 			if !ok {
@@ -305,7 +355,7 @@ func (h *Handler) IdentityProviderHandler(id string) http.HandlerFunc {
 			return
 		}
 
-		if req.Method == "POST" {
+		if req.Method == "POST" && !external {
 			h.serveProviderPost(id, p, refU, w, req)
 			return
 		}
@@ -337,6 +387,37 @@ func (RedirectURL) Error() string { return "must redirect to acquire identity" }
 // RedirectURL implements the Redirector interface.
 func (r RedirectURL) RedirectURL() string { return string(r) }
 
+// ChallengeCookieName is the name of the cookie holding the token of a pending Challenge.
+const ChallengeCookieName = "goalert_login_challenge"
+
+// challengeCookieAge is how long the challenge cookie is kept by the browser.
+const challengeCookieAge = 10 * time.Minute
+
+// A Challenge can be returned as an error to indicate an additional login step is
+// required (e.g., entering a one-time code). The user will be returned to the login
+// page with the challenge details.
+type Challenge struct {
+	// Token identifies the login attempt. It is stored in the ChallengeCookieName
+	// cookie, rather than the URL, for the next request.
+	Token string
+
+	// Enroll indicates the user must first enroll (e.g., set up an authenticator app).
+	Enroll bool
+
+	// Message is an optional error to display, such as for an invalid code.
+	Message string
+}
+
+func (c Challenge) Error() string {
+	if c.Message != "" {
+		return c.Message
+	}
+	return "additional login step required"
+}
+
+// ClientError indicates an error meant for the client to see.
+func (Challenge) ClientError() bool { return true }
+
 func (h *Handler) canCreateUser(ctx context.Context, providerID string) bool {
 	cfg := config.FromContext(ctx)
 	switch providerID {
@@ -344,21 +425,31 @@ func (h *Handler) canCreateUser(ctx context.Context, providerID string) bool {
 		return cfg.OIDC.NewUsers
 	case "github":
 		return cfg.GitHub.NewUsers
+	case "saml":
+		return cfg.SAML.NewUsers
+	case "ldap":
+		return cfg.LDAP.NewUsers
 	}
 
 	return false
 }
 
+// relativePath returns the request path relative to the base of the given provider.
+func relativePath(id string, req *http.Request) string {
+	p := strings.TrimPrefix(req.URL.Path, "/v1/identity/providers/"+id)
+	p = strings.TrimPrefix(p, "/api/v2/identity/providers/"+id)
+	if p == "" {
+		return "/"
+	}
+	return p
+}
+
 func (h *Handler) handleProvider(id string, p IdentityProvider, refU *url.URL, w http.ResponseWriter, req *http.Request) {
 	ctx := req.Context()
 	sp := trace.FromContext(ctx)
 
 	var route RouteInfo
-	route.RelativePath = strings.TrimPrefix(req.URL.Path, "/v1/identity/providers/"+id)
-	route.RelativePath = strings.TrimPrefix(route.RelativePath, "/api/v2/identity/providers/"+id)
-	if route.RelativePath == "" {
-		route.RelativePath = "/"
-	}
+	route.RelativePath = relativePath(id, req)
 
 	u := *req.URL
 	u.RawQuery = "" // strip query params
@@ -382,6 +473,8 @@ func (h *Handler) handleProvider(id string, p IdentityProvider, refU *url.URL, w
 			log.Log(ctx, old)
 		}
 		q.Set("login_error", err.Error())
+		q.Del("login_challenge")
+		q.Del("challenge_enroll")
 		refU.RawQuery = q.Encode()
 		if noRedirect {
 			if err != old {
@@ -395,13 +488,40 @@ func (h *Handler) handleProvider(id string, p IdentityProvider, refU *url.URL, w
 		http.Redirect(w, req, refU.String(), http.StatusFound)
 	}
 
+	var c Challenge
+	if errors.As(err, &c) {
+		SetCookieAge(w, req, ChallengeCookieName, c.Token, challengeCookieAge)
+		q := refU.Query()
+		q.Set("login_challenge", id)
+		q.Del("challenge_enroll")
+		if c.Enroll {
+			q.Set("challenge_enroll", "1")
+		}
+		q.Del("login_error")
+		if c.Message != "" {
+			q.Set("login_error", c.Message)
+		}
+		refU.RawQuery = q.Encode()
+		if noRedirect {
+			w.WriteHeader(http.StatusUnauthorized)
+			io.WriteString(w, c.Error())
+			return
+		}
+		http.Redirect(w, req, refU.String(), http.StatusFound)
+		return
+	}
+	if _, cErr := req.Cookie(ChallengeCookieName); cErr == nil {
+		// login step completed or failed, either way the challenge is over
+		ClearCookie(w, req, ChallengeCookieName)
+	}
 	if err != nil {
 		errRedirect(err)
 		return
 	}
 
 	var userID string
-	err = h.userLookup.QueryRowContext(ctx, id, sub.SubjectID).Scan(&userID)
+	var disabled bool
+	err = h.userLookup.QueryRowContext(ctx, id, sub.SubjectID).Scan(&userID, &disabled)
 	if errors.Is(err, sql.ErrNoRows) {
 		err = nil
 	}
@@ -409,6 +529,10 @@ func (h *Handler) handleProvider(id string, p IdentityProvider, refU *url.URL, w
 		errRedirect(err)
 		return
 	}
+	if disabled {
+		errRedirect(Error("This account has been deactivated."))
+		return
+	}
 
 	var newUser bool
 	if userID == "" {
@@ -464,7 +588,15 @@ func (h *Handler) handleProvider(id string, p IdentityProvider, refU *url.URL, w
 		}
 	}
 
-	tok, err := h.CreateSession(ctx, req.UserAgent(), userID)
+	if sub.Groups != nil {
+		err = h.syncGroups(ctx, id, userID, sub.Groups)
+		if err != nil {
+			errRedirect(errors.Wrap(err, "sync groups"))
+			return
+		}
+	}
+
+	tok, err := h.CreateSession(ctx, req.UserAgent(), userID, clientIP(req))
 	if err != nil {
 		errRedirect(err)
 		return
@@ -498,13 +630,13 @@ func (h *Handler) handleProvider(id string, p IdentityProvider, refU *url.URL, w
 }
 
 // CreateSession will start a new session for the given UserID, returning a newly signed token.
-func (h *Handler) CreateSession(ctx context.Context, userAgent, userID string) (*authtoken.Token, error) {
+func (h *Handler) CreateSession(ctx context.Context, userAgent, userID, ipAddress string) (*authtoken.Token, error) {
 	tok := &authtoken.Token{
 		Version: 1,
 		Type:    authtoken.TypeSession,
 		ID:      uuid.New(),
 	}
-	_, err := h.startSession.ExecContext(ctx, tok.ID.String(), userAgent, userID)
+	_, err := h.startSession.ExecContext(ctx, tok.ID.String(), userAgent, userID, ipAddress)
 	if err != nil {
 		return nil, err
 	}
@@ -557,8 +689,17 @@ func (h *Handler) authWithToken(w http.ResponseWriter, req *http.Request, next h
 		ctx, err = h.cfg.IntKeyStore.Authorize(ctx, *tok, integrationkey.TypePrometheusAlertmanager)
 	case "/api/v2/calendar":
 		ctx, err = h.cfg.CalSubStore.Authorize(ctx, *tok)
+	case "/api/graphql":
+		if tok.Type != authtoken.TypeAPIKey {
+			// session tokens are handled by the user session flow
+			return false
+		}
+		ctx, err = h.cfg.APIKeyStore.Authorize(ctx, *tok)
 	default:
-		return false
+		if !strings.HasPrefix(req.URL.Path, "/api/v2/scim/") || tok.Type != authtoken.TypeAPIKey {
+			return false
+		}
+		ctx, err = h.cfg.APIKeyStore.Authorize(ctx, *tok)
 	}
 
 	if errutil.HTTPError(req.Context(), w, err) {
@@ -638,7 +779,9 @@ func (h *Handler) WrapHandler(wrapped http.Handler) http.Handler {
 
 		var userID string
 		var userRole permission.Role
-		err = h.fetchSession.QueryRowContext(ctx, tok.ID.String()).Scan(&userID, &userRole)
+		var teamIDs sqlutil.StringArray
+		idle, maxAge := sessionLifetime(config.FromContext(ctx))
+		err = h.fetchSession.QueryRowContext(ctx, tok.ID.String(), clientIP(req), idle, maxAge).Scan(&userID, &userRole, &teamIDs)
 		if errors.Is(err, sql.ErrNoRows) {
 			if fromCookie {
 				h.setSessionCookie(w, req, "")
@@ -660,6 +803,7 @@ func (h *Handler) WrapHandler(wrapped http.Handler) http.Handler {
 				ID:   tok.ID.String(),
 			},
 		)
+		ctx = permission.UserTeamsContext(ctx, teamIDs)
 		req = req.WithContext(ctx)
 
 		wrapped.ServeHTTP(w, req)
@@ -693,6 +837,20 @@ func (h *Handler) refererURL(w http.ResponseWriter, req *http.Request) (*url.URL
 	refU.RawQuery = q.Encode()
 	return refU, true
 }
+
+// externalCallbackRedirURL returns the URL to redirect to after an external callback.
+// The login_redir cookie is used if the browser sent it, otherwise the public URL.
+func (h *Handler) externalCallbackRedirURL(req *http.Request) *url.URL {
+	if c, err := req.Cookie("login_redir"); err == nil {
+		if u, err := url.Parse(c.Value); err == nil {
+			return u
+		}
+	}
+
+	u, _ := url.Parse(config.FromContext(req.Context()).CallbackURL("/"))
+	return u
+}
+
 func (h *Handler) serveProviderPost(id string, p IdentityProvider, refU *url.URL, w http.ResponseWriter, req *http.Request) {
 	SetCookie(w, req, "login_redir", refU.String())
 
@@ -1,9 +1,11 @@
 package auth
 
 import (
+	"github.com/target/goalert/apikey"
 	"github.com/target/goalert/calendarsubscription"
 	"github.com/target/goalert/integrationkey"
 	"github.com/target/goalert/keyring"
+	"github.com/target/goalert/team"
 	"github.com/target/goalert/user"
 )
 
@@ -14,4 +16,6 @@ type HandlerConfig struct {
 	APIKeyring     keyring.Keyring
 	IntKeyStore    integrationkey.Store
 	CalSubStore    *calendarsubscription.Store
+	APIKeyStore    *apikey.Store
+	TeamStore      *team.Store
 }
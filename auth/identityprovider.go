@@ -14,6 +14,18 @@ type IdentityProvider interface {
 	ExtractIdentity(*RouteInfo, http.ResponseWriter, *http.Request) (*Identity, error)
 }
 
+// An ExternalCallbackProvider is an IdentityProvider that receives callbacks posted
+// directly from a remote server (e.g., a SAML assertion consumer service).
+//
+// Such requests are cross-site, so they are exempt from referer validation and may
+// arrive without cookies. The provider is responsible for verifying them.
+type ExternalCallbackProvider interface {
+	IdentityProvider
+
+	// ExternalCallback returns true if the given relative path is an external callback.
+	ExternalCallback(relativePath string) bool
+}
+
 // Identity represents a user's proven identity.
 type Identity struct {
 	// SubjectID should be a provider-specific identifier for an individual.
@@ -21,6 +33,11 @@ type Identity struct {
 	Email         string
 	EmailVerified bool
 	Name          string
+
+	// Groups holds the names of the groups the individual is a member of. It should only
+	// be set if group mapping is configured for the provider, as it will be used to update
+	// the user's role and team membership on login.
+	Groups []string
 }
 
 // ProviderInfo holds the details for using a provider.
@@ -0,0 +1,258 @@
+package ldap
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/go-ldap/ldap/v3"
+	"github.com/pkg/errors"
+	"github.com/target/goalert/auth"
+	"github.com/target/goalert/config"
+	"github.com/target/goalert/util/log"
+	"go.opencensus.io/trace"
+)
+
+var _ auth.IdentityProvider = &Provider{}
+
+// timeout is the maximum time for connecting to, or waiting on a response from, the LDAP server.
+const timeout = 10 * time.Second
+
+// Provider implements the auth.IdentityProvider interface by authenticating
+// a username and password against an LDAP (or Active Directory) server.
+//
+// Users are located with a search (optionally bound as BindDN), and authenticated by
+// binding as the found DN, which is used as the subject ID.
+type Provider struct{}
+
+// NewProvider prepares a new Provider.
+func NewProvider(ctx context.Context) (*Provider, error) {
+	return &Provider{}, nil
+}
+
+func providerName(cfg config.Config) string {
+	if cfg.LDAP.OverrideName != "" {
+		return cfg.LDAP.OverrideName
+	}
+	return "LDAP"
+}
+
+// Info implements the auth.IdentityProvider interface.
+func (p *Provider) Info(ctx context.Context) auth.ProviderInfo {
+	cfg := config.FromContext(ctx)
+	return auth.ProviderInfo{
+		Title: providerName(cfg),
+		Fields: []auth.Field{
+			{ID: "username", Label: "Username", Required: true},
+			{ID: "password", Label: "Password", Password: true, Required: true},
+		},
+		Enabled: cfg.LDAP.Enable,
+	}
+}
+
+func userPass(req *http.Request) (string, string) {
+	if req.URL.User == nil {
+		return req.FormValue("username"), req.FormValue("password")
+	}
+
+	p, _ := req.URL.User.Password()
+	return req.URL.User.Username(), p
+}
+
+// userFilter returns the search filter for the given username.
+func userFilter(cfg config.Config, username string) string {
+	filter := cfg.LDAP.UserFilter
+	if filter == "" {
+		filter = "(uid={username})"
+	}
+
+	return strings.ReplaceAll(filter, "{username}", ldap.EscapeFilter(username))
+}
+
+// memberOfAny returns true if any of the memberOf DNs match any of the group DNs.
+func memberOfAny(memberOf, groups []string) bool {
+	for _, g := range groups {
+		groupDN, err := ldap.ParseDN(g)
+		if err != nil {
+			continue
+		}
+		for _, m := range memberOf {
+			dn, err := ldap.ParseDN(m)
+			if err != nil {
+				continue
+			}
+			if groupDN.EqualFold(dn) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func (p *Provider) dial(ctx context.Context) (*ldap.Conn, error) {
+	cfg := config.FromContext(ctx)
+
+	u, err := url.Parse(cfg.LDAP.URL)
+	if err != nil {
+		return nil, errors.Wrap(err, "parse URL")
+	}
+
+	tlsCfg := &tls.Config{
+		ServerName:         u.Hostname(),
+		InsecureSkipVerify: cfg.LDAP.SkipVerify,
+	}
+
+	conn, err := ldap.DialURL(cfg.LDAP.URL,
+		ldap.DialWithDialer(&net.Dialer{Timeout: timeout}),
+		ldap.DialWithTLSConfig(tlsCfg),
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "connect")
+	}
+	conn.SetTimeout(timeout)
+
+	if cfg.LDAP.StartTLS && strings.EqualFold(u.Scheme, "ldap") {
+		err = conn.StartTLS(tlsCfg)
+		if err != nil {
+			conn.Close()
+			return nil, errors.Wrap(err, "start TLS")
+		}
+	}
+
+	return conn, nil
+}
+
+// bindSearch binds as the configured BindDN, if any, to perform searches.
+func bindSearch(cfg config.Config, conn *ldap.Conn) error {
+	if cfg.LDAP.BindDN == "" {
+		return conn.UnauthenticatedBind("")
+	}
+
+	return errors.Wrap(conn.Bind(cfg.LDAP.BindDN, cfg.LDAP.BindPassword), "bind as BindDN")
+}
+
+// isMember returns true if the user is a member of any of the allowed groups.
+//
+// The user's memberOf attribute is checked first, falling back to searching each group
+// for a member or uniqueMember attribute referencing the user.
+func isMember(cfg config.Config, conn *ldap.Conn, user *ldap.Entry) (bool, error) {
+	if memberOfAny(user.GetEqualFoldAttributeValues("memberOf"), cfg.LDAP.AllowedGroups) {
+		return true, nil
+	}
+
+	dn := ldap.EscapeFilter(user.DN)
+	for _, group := range cfg.LDAP.AllowedGroups {
+		res, err := conn.Search(ldap.NewSearchRequest(
+			group, ldap.ScopeBaseObject, ldap.NeverDerefAliases, 1, int(timeout.Seconds()), false,
+			"(|(member="+dn+")(uniqueMember="+dn+"))",
+			[]string{"1.1"}, nil, // no attributes
+		))
+		if ldap.IsErrorWithCode(err, ldap.LDAPResultNoSuchObject) {
+			continue
+		}
+		if err != nil {
+			return false, errors.Wrapf(err, "search group '%s'", group)
+		}
+		if len(res.Entries) > 0 {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// ExtractIdentity implements the auth.IdentityProvider interface, providing identity based
+// on the given username and password fields.
+func (p *Provider) ExtractIdentity(route *auth.RouteInfo, w http.ResponseWriter, req *http.Request) (*auth.Identity, error) {
+	ctx := req.Context()
+	cfg := config.FromContext(ctx)
+	name := providerName(cfg)
+
+	username, password := userPass(req)
+	username = strings.TrimSpace(username)
+	if username == "" || password == "" {
+		// an empty password would be an unauthenticated bind, which always succeeds
+		return nil, auth.Error("invalid username/password")
+	}
+	ctx = log.WithField(ctx, "username", username)
+
+	ctx, sp := trace.StartSpan(ctx, "Auth.LDAP.Authenticate")
+	defer sp.End()
+
+	conn, err := p.dial(ctx)
+	if err != nil {
+		log.Log(ctx, errors.Wrap(err, "LDAP"))
+		return nil, auth.Error("Could not communicate with " + name + " server. You can try again")
+	}
+	defer conn.Close()
+
+	err = bindSearch(cfg, conn)
+	if err != nil {
+		log.Log(ctx, errors.Wrap(err, "LDAP"))
+		return nil, auth.Error("Could not login due to wrong configuration for " + name + ".")
+	}
+
+	nameAttr := cfg.LDAP.NameAttribute
+	if nameAttr == "" {
+		nameAttr = "displayName"
+	}
+	emailAttr := cfg.LDAP.EmailAttribute
+	if emailAttr == "" {
+		emailAttr = "mail"
+	}
+
+	res, err := conn.Search(ldap.NewSearchRequest(
+		cfg.LDAP.BaseDN, ldap.ScopeWholeSubtree, ldap.NeverDerefAliases, 2, int(timeout.Seconds()), false,
+		userFilter(cfg, username),
+		[]string{nameAttr, emailAttr, "memberOf"}, nil,
+	))
+	if err != nil && !ldap.IsErrorWithCode(err, ldap.LDAPResultSizeLimitExceeded) {
+		log.Log(ctx, errors.Wrap(err, "LDAP search user"))
+		return nil, auth.Error("Could not communicate with " + name + " server. You can try again")
+	}
+	if len(res.Entries) != 1 {
+		if len(res.Entries) > 1 {
+			log.Log(ctx, errors.New("LDAP search user: multiple entries found"))
+		}
+		auth.Delay(ctx)
+		return nil, auth.Error("unknown username/password")
+	}
+	user := res.Entries[0]
+
+	err = conn.Bind(user.DN, password)
+	if err != nil {
+		log.Debug(ctx, errors.Wrap(err, "LDAP login"))
+		auth.Delay(ctx)
+		return nil, auth.Error("unknown username/password")
+	}
+
+	if len(cfg.LDAP.AllowedGroups) > 0 {
+		// group lookups are done with the search credentials, not the user's
+		err = bindSearch(cfg, conn)
+		if err != nil {
+			log.Log(ctx, errors.Wrap(err, "LDAP"))
+			return nil, auth.Error("Could not login due to wrong configuration for " + name + ".")
+		}
+		ok, err := isMember(cfg, conn, user)
+		if err != nil {
+			log.Log(ctx, errors.Wrap(err, "LDAP check group membership"))
+			return nil, auth.Error("Could not communicate with " + name + " server. You can try again")
+		}
+		if !ok {
+			return nil, auth.Error("Not a member of an allowed group.")
+		}
+	}
+
+	email := user.GetEqualFoldAttributeValue(emailAttr)
+	return &auth.Identity{
+		SubjectID:     user.DN,
+		Name:          user.GetEqualFoldAttributeValue(nameAttr),
+		Email:         email,
+		EmailVerified: email != "",
+	}, nil
+}
@@ -0,0 +1,29 @@
+package ldap
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/target/goalert/config"
+)
+
+func TestUserFilter(t *testing.T) {
+	var cfg config.Config
+	assert.Equal(t, "(uid=jsmith)", userFilter(cfg, "jsmith"))
+	assert.Equal(t, `(uid=\2a\29\28uid=\2a)`, userFilter(cfg, "*)(uid=*"), "escaped")
+
+	cfg.LDAP.UserFilter = "(&(objectClass=user)(sAMAccountName={username}))"
+	assert.Equal(t, "(&(objectClass=user)(sAMAccountName=jsmith))", userFilter(cfg, "jsmith"))
+}
+
+func TestMemberOfAny(t *testing.T) {
+	memberOf := []string{
+		"CN=Staff,OU=Groups,DC=example,DC=com",
+		"CN=On-Call,OU=Groups,DC=example,DC=com",
+	}
+
+	assert.True(t, memberOfAny(memberOf, []string{"cn=on-call,ou=groups,dc=example,dc=com"}), "case-insensitive")
+	assert.True(t, memberOfAny(memberOf, []string{"cn=Admins,dc=example,dc=com", "CN=Staff, OU=Groups, DC=example, DC=com"}), "any group, whitespace")
+	assert.False(t, memberOfAny(memberOf, []string{"cn=Admins,dc=example,dc=com"}), "not a member")
+	assert.False(t, memberOfAny(nil, []string{"cn=Admins,dc=example,dc=com"}), "no groups")
+}
@@ -294,31 +294,33 @@ func (p *Provider) ExtractIdentity(route *auth.RouteInfo, w http.ResponseWriter,
 	}
 
 	var info interface{}
-	getInfo := func(name, search string) interface{} {
+	// getInfo returns the result of search against UserInfo; ok is false if
+	// the lookup failed.
+	getInfo := func(name, search string) (res interface{}, ok bool) {
 		if err != nil {
-			return nil
+			return nil, false
 		}
 		if info == nil {
 			info, err = p.userInfoData(ctx, oaCfg.TokenSource(ctx, oauth2Token))
 		}
 		if err != nil {
 			log.Log(ctx, err)
-			return nil
+			return nil, false
 		}
 		res, searchErr := jmespath.Search(search, info)
 		if searchErr != nil {
 			log.Log(ctx, errors.Wrapf(searchErr, "lookup %s in UserInfo", name))
-			return nil
+			return nil, false
 		}
 
-		return res
+		return res, true
 	}
 	infoFieldStr := func(name, search string, field *string) {
 		if search == "" {
 			return
 		}
 		*field = ""
-		res := getInfo(name, search)
+		res, _ := getInfo(name, search)
 		if res == nil {
 			return
 		}
@@ -334,7 +336,7 @@ func (p *Provider) ExtractIdentity(route *auth.RouteInfo, w http.ResponseWriter,
 			return
 		}
 		*field = false
-		res := getInfo(name, search)
+		res, _ := getInfo(name, search)
 		if res == nil {
 			return
 		}
@@ -349,9 +351,49 @@ func (p *Provider) ExtractIdentity(route *auth.RouteInfo, w http.ResponseWriter,
 	infoFieldBool("EmailVerified", cfg.OIDC.UserInfoEmailVerifiedPath, &id.EmailVerified)
 	infoFieldStr("Name", cfg.OIDC.UserInfoNamePath, &id.Name)
 
+	if len(cfg.OIDC.AdminGroups) > 0 || len(cfg.OIDC.TeamGroups) > 0 {
+		var groupClaims struct {
+			Groups interface{} `json:"groups"`
+		}
+		if err := idToken.Claims(&groupClaims); err != nil {
+			log.Log(ctx, errors.Wrap(err, "parse groups claim"))
+			return nil, auth.Error(fmt.Sprintf("Invalid response from %s server.", name))
+		}
+		groups, ok := groupClaims.Groups, true
+		if cfg.OIDC.UserInfoGroupsPath != "" {
+			groups, ok = getInfo("Groups", cfg.OIDC.UserInfoGroupsPath)
+			if err != nil {
+				// don't revoke access based on a failed lookup
+				return nil, auth.Error(fmt.Sprintf("Could not communicate with %s server. You can try again", name))
+			}
+		}
+		if ok {
+			// a failed search leaves Groups nil, skipping group sync
+			id.Groups = groupNames(groups)
+		}
+	}
+
 	return &id, nil
 }
 
+// groupNames returns the list of group names from a claim value, which may be
+// a list or a single string.
+func groupNames(v interface{}) []string {
+	groups := []string{}
+	switch v := v.(type) {
+	case string:
+		groups = append(groups, v)
+	case []interface{}:
+		for _, g := range v {
+			if s, ok := g.(string); ok {
+				groups = append(groups, s)
+			}
+		}
+	}
+
+	return groups
+}
+
 func (p *Provider) userInfoData(ctx context.Context, token oauth2.TokenSource) (interface{}, error) {
 	provider, err := p.provider(ctx)
 	if err != nil {
@@ -0,0 +1,12 @@
+package saml
+
+import (
+	"github.com/target/goalert/auth/nonce"
+	"github.com/target/goalert/keyring"
+)
+
+// Config provides necessary parameters for SAML authentication.
+type Config struct {
+	Keyring    keyring.Keyring
+	NonceStore *nonce.Store
+}
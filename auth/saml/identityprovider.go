@@ -0,0 +1,435 @@
+package saml
+
+import (
+	"bytes"
+	"context"
+	"crypto/rsa"
+	"crypto/subtle"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/crewjam/saml"
+	"github.com/crewjam/saml/samlsp"
+	"github.com/pkg/errors"
+	dsig "github.com/russellhaering/goxmldsig"
+	"github.com/target/goalert/auth"
+	"github.com/target/goalert/config"
+	"github.com/target/goalert/util/errutil"
+	"github.com/target/goalert/util/log"
+	"github.com/target/goalert/validation/validate"
+	"go.opencensus.io/plugin/ochttp"
+	"go.opencensus.io/trace"
+)
+
+var _ auth.ExternalCallbackProvider = &Provider{}
+
+var b64enc = base64.URLEncoding.WithPadding(base64.NoPadding)
+
+// requestCookieName holds the nonce of the AuthnRequest started by the current browser.
+const requestCookieName = "goalert_saml_request"
+
+// metadataTTL is how long identity provider metadata is cached before being refreshed.
+const metadataTTL = time.Hour
+
+type cachedMetadata struct {
+	md      *saml.EntityDescriptor
+	fetched time.Time
+}
+
+// Provider implements the auth.IdentityProvider interface by acting as a SAML 2.0
+// service provider to a remote identity provider.
+//
+// Only SP-initiated logins are supported; responses must be signed by the identity
+// provider and reference a request ID issued by this Provider.
+type Provider struct {
+	cfg Config
+
+	mx       sync.Mutex
+	metadata map[string]cachedMetadata
+}
+
+// NewProvider prepares a new Provider with the given config.
+func NewProvider(ctx context.Context, cfg Config) (*Provider, error) {
+	if cfg.Keyring == nil {
+		return nil, errors.New("Keyring missing")
+	}
+	if cfg.NonceStore == nil {
+		return nil, errors.New("NonceStore missing")
+	}
+
+	return &Provider{
+		cfg:      cfg,
+		metadata: make(map[string]cachedMetadata),
+	}, nil
+}
+
+func (p *Provider) idpMetadata(ctx context.Context) (*saml.EntityDescriptor, error) {
+	cfg := config.FromContext(ctx)
+	p.mx.Lock()
+	c, ok := p.metadata[cfg.SAML.IDPMetadataURL]
+	p.mx.Unlock()
+	if ok && time.Since(c.fetched) < metadataTTL {
+		return c.md, nil
+	}
+
+	u, err := url.Parse(cfg.SAML.IDPMetadataURL)
+	if err != nil {
+		return nil, err
+	}
+	if u.Scheme != "https" {
+		return nil, errors.New("IdP metadata URL must use https")
+	}
+
+	ctx, sp := trace.StartSpan(ctx, "Auth.SAML.FetchMetadata")
+	md, err := samlsp.FetchMetadata(ctx, &http.Client{Transport: &ochttp.Transport{}}, *u)
+	sp.End()
+	if err != nil && ok {
+		// keep using the last known metadata until the IdP is reachable again
+		log.Log(ctx, errors.Wrap(err, "refresh SAML IdP metadata"))
+		return c.md, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	p.mx.Lock()
+	p.metadata[cfg.SAML.IDPMetadataURL] = cachedMetadata{md: md, fetched: time.Now()}
+	p.mx.Unlock()
+	return md, nil
+}
+
+var pemRx = regexp.MustCompile(`^\s*(-----BEGIN [A-Z ]+-----)(.*?)(-----END [A-Z ]+-----)\s*$`)
+
+// normalizePEM restores line breaks to PEM data that was entered as a single line.
+func normalizePEM(s string) string {
+	if strings.Contains(strings.TrimSpace(s), "\n") {
+		return s
+	}
+	m := pemRx.FindStringSubmatch(s)
+	if m == nil {
+		return s
+	}
+
+	return m[1] + "\n" + strings.Join(strings.Fields(m[2]), "\n") + "\n" + m[3] + "\n"
+}
+
+// serviceProvider returns the service provider for the current config, without
+// identity provider metadata.
+func (p *Provider) serviceProvider(ctx context.Context) (*saml.ServiceProvider, error) {
+	cfg := config.FromContext(ctx)
+
+	keyPair, err := tls.X509KeyPair([]byte(normalizePEM(cfg.SAML.Certificate)), []byte(normalizePEM(cfg.SAML.PrivateKey)))
+	if err != nil {
+		return nil, errors.Wrap(err, "parse certificate and private key")
+	}
+	key, ok := keyPair.PrivateKey.(*rsa.PrivateKey)
+	if !ok {
+		return nil, errors.New("private key must be an RSA key")
+	}
+	cert, err := x509.ParseCertificate(keyPair.Certificate[0])
+	if err != nil {
+		return nil, errors.Wrap(err, "parse certificate")
+	}
+
+	hints := cfg.Hints()
+	metadataURL, err := url.Parse(hints.SAML.MetadataURL)
+	if err != nil {
+		return nil, errors.Wrap(err, "parse metadata URL")
+	}
+	acsURL, err := url.Parse(hints.SAML.ACSURL)
+	if err != nil {
+		return nil, errors.Wrap(err, "parse ACS URL")
+	}
+
+	return &saml.ServiceProvider{
+		EntityID:        cfg.SAML.EntityID,
+		Key:             key,
+		Certificate:     cert,
+		MetadataURL:     *metadataURL,
+		AcsURL:          *acsURL,
+		SignatureMethod: dsig.RSASHA256SignatureMethod,
+	}, nil
+}
+
+func providerName(cfg config.Config) string {
+	if cfg.SAML.OverrideName != "" {
+		return cfg.SAML.OverrideName
+	}
+	return "SAML"
+}
+
+// Info returns the appropriate auth.ProviderInfo based on configuration.
+//
+// As SAML requires no user input, only the Title is provided.
+func (p *Provider) Info(ctx context.Context) auth.ProviderInfo {
+	cfg := config.FromContext(ctx)
+	return auth.ProviderInfo{
+		Title:   providerName(cfg),
+		Enabled: cfg.SAML.Enable,
+	}
+}
+
+// ExternalCallback implements the auth.ExternalCallbackProvider interface. The assertion
+// consumer service is posted to by the browser from the identity provider's domain.
+func (p *Provider) ExternalCallback(relativePath string) bool { return relativePath == "/acs" }
+
+// ServeMetadata serves the service provider metadata for registration with the identity provider.
+func (p *Provider) ServeMetadata(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+	if !config.FromContext(ctx).SAML.Enable {
+		http.NotFound(w, req)
+		return
+	}
+
+	sp, err := p.serviceProvider(ctx)
+	if errutil.HTTPError(ctx, w, err) {
+		return
+	}
+
+	data, err := xml.MarshalIndent(sp.Metadata(), "", "  ")
+	if errutil.HTTPError(ctx, w, err) {
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/samlmetadata+xml")
+	w.Write(data)
+}
+
+// newRequestID returns a signed AuthnRequest ID for the given nonce.
+func (p *Provider) newRequestID(nonce [16]byte) (string, error) {
+	buf := bytes.NewBuffer(nil)
+
+	buf.Write(nonce[:])
+	buf.WriteByte('N')
+	binary.Write(buf, binary.BigEndian, time.Now().Unix())
+
+	sig, err := p.cfg.Keyring.Sign(buf.Bytes())
+	if err != nil {
+		return "", err
+	}
+	buf.Write(sig)
+
+	// IDs must not start with a digit, so always prefix them
+	return "id-" + b64enc.EncodeToString(buf.Bytes()), nil
+}
+
+// validateRequestID verifies an AuthnRequest ID issued by newRequestID, returning its nonce.
+func (p *Provider) validateRequestID(id string) (nonce [16]byte, ok bool) {
+	if !strings.HasPrefix(id, "id-") {
+		return nonce, false
+	}
+	data, err := b64enc.DecodeString(strings.TrimPrefix(id, "id-"))
+	if err != nil || len(data) < 25 {
+		return nonce, false
+	}
+	valid, _ := p.cfg.Keyring.Verify(data[:25], data[25:])
+	if !valid {
+		return nonce, false
+	}
+	if data[16] != 'N' {
+		return nonce, false
+	}
+
+	unix := int64(binary.BigEndian.Uint64(data[17:]))
+	t := time.Unix(unix, 0)
+	if time.Since(t) > time.Hour {
+		return nonce, false
+	}
+	if time.Until(t) > time.Minute*5 {
+		// too far in the future (clock drift)
+		return nonce, false
+	}
+
+	copy(nonce[:], data)
+	return nonce, true
+}
+
+// inResponseTo returns the (unverified) request ID referenced by an encoded SAML response.
+func inResponseTo(encoded string) (string, error) {
+	data, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return "", err
+	}
+
+	var resp saml.Response
+	err = xml.Unmarshal(data, &resp)
+	if err != nil {
+		return "", err
+	}
+
+	return resp.InResponseTo, nil
+}
+
+// attrValue returns the first value of the named attribute, matching either Name or FriendlyName.
+func attrValue(a *saml.Assertion, name string) string {
+	for _, stmt := range a.AttributeStatements {
+		for _, attr := range stmt.Attributes {
+			if attr.Name != name && attr.FriendlyName != name {
+				continue
+			}
+			for _, v := range attr.Values {
+				if v.Value != "" {
+					return strings.TrimSpace(v.Value)
+				}
+			}
+		}
+	}
+
+	return ""
+}
+
+// newIdentity maps a verified assertion to an auth.Identity using the configured attributes.
+func newIdentity(cfg config.Config, a *saml.Assertion) (*auth.Identity, error) {
+	if a.Subject == nil || a.Subject.NameID == nil || a.Subject.NameID.Value == "" {
+		return nil, errors.New("assertion missing NameID")
+	}
+	if a.Subject.NameID.Format == string(saml.TransientNameIDFormat) {
+		// a new ID every login would create a new user every login
+		return nil, errors.New("transient NameID format is not supported")
+	}
+
+	nameAttr := cfg.SAML.NameAttribute
+	if nameAttr == "" {
+		nameAttr = "displayName"
+	}
+	emailAttr := cfg.SAML.EmailAttribute
+	if emailAttr == "" {
+		emailAttr = "mail"
+	}
+
+	id := &auth.Identity{
+		SubjectID: a.Subject.NameID.Value,
+		Name:      attrValue(a, nameAttr),
+		Email:     attrValue(a, emailAttr),
+	}
+	if id.Name == "" {
+		id.Name = strings.TrimSpace(attrValue(a, "givenName") + " " + attrValue(a, "sn"))
+	}
+	if id.Email == "" && validate.Email("NameID", id.SubjectID) == nil {
+		id.Email = id.SubjectID
+	}
+
+	// assertions are signed by the IdP, so the email is as trustworthy as the rest of the identity
+	id.EmailVerified = id.Email != ""
+
+	return id, nil
+}
+
+// ExtractIdentity will return a redirect error for new auth requests, and provide a users identity
+// for assertions posted to the assertion consumer service.
+func (p *Provider) ExtractIdentity(route *auth.RouteInfo, w http.ResponseWriter, req *http.Request) (*auth.Identity, error) {
+	ctx := req.Context()
+	cfg := config.FromContext(ctx)
+	name := providerName(cfg)
+
+	switch route.RelativePath {
+	case "/", "/acs":
+	default:
+		return nil, auth.Error(fmt.Sprintf("Could not login due to wrong configuration for %s.", name))
+	}
+
+	sp, err := p.serviceProvider(ctx)
+	if err != nil {
+		log.Log(ctx, errors.Wrap(err, "init SAML service provider"))
+		return nil, auth.Error(fmt.Sprintf("Could not login due to wrong configuration for %s.", name))
+	}
+	sp.IDPMetadata, err = p.idpMetadata(ctx)
+	if err != nil {
+		log.Log(ctx, errors.Wrap(err, "fetch SAML IdP metadata"))
+		return nil, auth.Error(fmt.Sprintf("Could not communicate with %s server. You can try again", name))
+	}
+
+	if route.RelativePath == "/" {
+		ssoURL := sp.GetSSOBindingLocation(saml.HTTPRedirectBinding)
+		if ssoURL == "" {
+			log.Log(ctx, errors.New("SAML IdP does not support the HTTP-Redirect binding"))
+			return nil, auth.Error(fmt.Sprintf("Could not login due to wrong configuration for %s.", name))
+		}
+
+		authReq, err := sp.MakeAuthenticationRequest(ssoURL, saml.HTTPRedirectBinding, saml.HTTPPostBinding)
+		if err != nil {
+			log.Log(ctx, errors.Wrap(err, "make SAML authentication request"))
+			return nil, auth.Error("Failed to generate authentication request.")
+		}
+		nonce := p.cfg.NonceStore.New()
+		authReq.ID, err = p.newRequestID(nonce)
+		if err != nil {
+			log.Log(ctx, errors.Wrap(err, "generate new request ID"))
+			return nil, auth.Error("Failed to generate authentication request.")
+		}
+		// bind the request to this browser, the response is posted cross-site by the IdP
+		auth.SetCrossSiteCookie(w, req, requestCookieName, b64enc.EncodeToString(nonce[:]))
+
+		u, err := authReq.Redirect("", sp)
+		if err != nil {
+			log.Log(ctx, errors.Wrap(err, "sign SAML authentication request"))
+			return nil, auth.Error("Failed to generate authentication request.")
+		}
+
+		return nil, auth.RedirectURL(u.String())
+	}
+
+	err = req.ParseForm()
+	if err != nil {
+		return nil, auth.Error(fmt.Sprintf("Bad response from %s server.", name))
+	}
+
+	reqID, err := inResponseTo(req.PostForm.Get("SAMLResponse"))
+	if err != nil {
+		log.Log(ctx, errors.Wrap(err, "parse SAML response"))
+		return nil, auth.Error(fmt.Sprintf("Bad response from %s server.", name))
+	}
+	nonce, ok := p.validateRequestID(reqID)
+	if !ok {
+		// also rejects IdP-initiated logins, which have no request ID
+		return nil, auth.Error("There was a problem while checking the request. You can try again")
+	}
+
+	reqC, err := req.Cookie(requestCookieName)
+	if err != nil {
+		return nil, auth.Error("There was a problem recognizing this browser. You can try again")
+	}
+	auth.ClearCookie(w, req, requestCookieName)
+	cookieNonce, err := b64enc.DecodeString(reqC.Value)
+	if err != nil || subtle.ConstantTimeCompare(cookieNonce, nonce[:]) != 1 {
+		// the response is for a request started by a different browser (login CSRF)
+		return nil, auth.Error("There was a problem verifying this browser. You can try again")
+	}
+
+	assertion, err := sp.ParseResponse(req, []string{reqID})
+	if err != nil {
+		var respErr *saml.InvalidResponseError
+		if errors.As(err, &respErr) {
+			err = respErr.PrivateErr
+		}
+		log.Log(ctx, errors.Wrap(err, "validate SAML response"))
+		return nil, auth.Error(fmt.Sprintf("Invalid response from %s server.", name))
+	}
+
+	ok, err = p.cfg.NonceStore.Consume(ctx, nonce)
+	if err != nil {
+		log.Log(ctx, errors.Wrap(err, "consume nonce value"))
+		return nil, auth.Error("Could not login. You can try again")
+	}
+	if !ok {
+		return nil, auth.Error("Could not login. You can try again")
+	}
+
+	id, err := newIdentity(cfg, assertion)
+	if err != nil {
+		log.Log(ctx, errors.Wrap(err, "map SAML assertion"))
+		return nil, auth.Error(fmt.Sprintf("Invalid response from %s server.", name))
+	}
+
+	return id, nil
+}
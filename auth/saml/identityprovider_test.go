@@ -0,0 +1,66 @@
+package saml
+
+import (
+	"testing"
+
+	"github.com/crewjam/saml"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/target/goalert/config"
+)
+
+func TestNormalizePEM(t *testing.T) {
+	const multi = "-----BEGIN CERTIFICATE-----\nAAAA\nBBBB\n-----END CERTIFICATE-----\n"
+
+	assert.Equal(t, multi, normalizePEM(multi), "multi-line")
+	assert.Equal(t, multi, normalizePEM("-----BEGIN CERTIFICATE----- AAAA BBBB -----END CERTIFICATE-----"), "single-line")
+	assert.Equal(t, "garbage", normalizePEM("garbage"), "invalid")
+}
+
+func TestNewIdentity(t *testing.T) {
+	assertion := func(format, nameID string, attrs ...saml.Attribute) *saml.Assertion {
+		return &saml.Assertion{
+			Subject:             &saml.Subject{NameID: &saml.NameID{Format: format, Value: nameID}},
+			AttributeStatements: []saml.AttributeStatement{{Attributes: attrs}},
+		}
+	}
+	attr := func(name, friendly, value string) saml.Attribute {
+		return saml.Attribute{Name: name, FriendlyName: friendly, Values: []saml.AttributeValue{{Value: value}}}
+	}
+
+	var cfg config.Config
+	id, err := newIdentity(cfg, assertion(string(saml.PersistentNameIDFormat), "abc123",
+		attr("urn:oid:2.16.840.1.113730.3.1.241", "displayName", "Joe Smith"),
+		attr("mail", "", "joe@example.com"),
+	))
+	require.NoError(t, err)
+	assert.Equal(t, "abc123", id.SubjectID)
+	assert.Equal(t, "Joe Smith", id.Name)
+	assert.Equal(t, "joe@example.com", id.Email)
+	assert.True(t, id.EmailVerified)
+
+	// fallback to given name/surname and email NameID
+	id, err = newIdentity(cfg, assertion(string(saml.EmailAddressNameIDFormat), "joe@example.com",
+		attr("givenName", "", "Joe"),
+		attr("sn", "", "Smith"),
+	))
+	require.NoError(t, err)
+	assert.Equal(t, "Joe Smith", id.Name)
+	assert.Equal(t, "joe@example.com", id.Email)
+
+	cfg.SAML.NameAttribute = "cn"
+	cfg.SAML.EmailAttribute = "http://schemas.xmlsoap.org/ws/2005/05/identity/claims/emailaddress"
+	id, err = newIdentity(cfg, assertion("", "abc123",
+		attr("cn", "", "Joseph Smith"),
+		attr("http://schemas.xmlsoap.org/ws/2005/05/identity/claims/emailaddress", "", "jsmith@example.com"),
+	))
+	require.NoError(t, err)
+	assert.Equal(t, "Joseph Smith", id.Name)
+	assert.Equal(t, "jsmith@example.com", id.Email)
+
+	_, err = newIdentity(cfg, assertion(string(saml.TransientNameIDFormat), "_tmp"))
+	assert.Error(t, err, "transient NameID")
+
+	_, err = newIdentity(cfg, &saml.Assertion{})
+	assert.Error(t, err, "missing subject")
+}
@@ -0,0 +1,33 @@
+package auth
+
+import (
+	"net"
+	"net/http"
+
+	"github.com/target/goalert/config"
+)
+
+// defaultIdleSessionMinutes is used when no idle timeout is configured, and matches
+// the age of session cookies.
+const defaultIdleSessionMinutes = 30 * 24 * 60
+
+// sessionLifetime returns the idle timeout (in minutes) and max age (in hours, 0 for no limit)
+// of user sessions.
+func sessionLifetime(cfg config.Config) (idleMinutes, maxAgeHours int) {
+	idleMinutes = cfg.Auth.IdleSessionTimeoutMinutes
+	if idleMinutes == 0 || idleMinutes > defaultIdleSessionMinutes {
+		idleMinutes = defaultIdleSessionMinutes
+	}
+
+	return idleMinutes, cfg.Auth.MaxSessionAgeHours
+}
+
+// clientIP returns the IP address of the remote end of the request.
+func clientIP(req *http.Request) string {
+	host, _, err := net.SplitHostPort(req.RemoteAddr)
+	if err != nil {
+		return req.RemoteAddr
+	}
+
+	return host
+}
@@ -0,0 +1,28 @@
+package auth
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/target/goalert/config"
+)
+
+func TestSessionLifetime(t *testing.T) {
+	var cfg config.Config
+	idle, maxAge := sessionLifetime(cfg)
+	assert.Equal(t, defaultIdleSessionMinutes, idle, "default idle timeout")
+	assert.Equal(t, 0, maxAge, "no max age")
+
+	cfg.Auth.IdleSessionTimeoutMinutes = 15
+	cfg.Auth.MaxSessionAgeHours = 12
+	idle, maxAge = sessionLifetime(cfg)
+	assert.Equal(t, 15, idle)
+	assert.Equal(t, 12, maxAge)
+}
+
+func TestClientIP(t *testing.T) {
+	assert.Equal(t, "192.0.2.1", clientIP(&http.Request{RemoteAddr: "192.0.2.1:1234"}))
+	assert.Equal(t, "2001:db8::1", clientIP(&http.Request{RemoteAddr: "[2001:db8::1]:1234"}))
+	assert.Equal(t, "pipe", clientIP(&http.Request{RemoteAddr: "pipe"}))
+}
@@ -4,8 +4,8 @@ import (
 	"bytes"
 	"crypto/sha256"
 	"encoding/hex"
-	"html/template"
 	"strings"
+	"text/template"
 	"time"
 
 	"github.com/google/uuid"
@@ -17,9 +17,12 @@ import (
 
 // CalendarSubscription stores the information from user subscriptions
 type CalendarSubscription struct {
-	ID         string
-	Name       string
-	UserID     string
+	ID     string
+	Name   string
+	UserID string
+
+	// ScheduleID is the schedule the subscription is for. If empty, the subscription
+	// is a personal feed including shifts from all schedules.
 	ScheduleID string
 	LastAccess time.Time
 	Disabled   bool
@@ -34,31 +37,58 @@ type CalendarSubscription struct {
 
 type iCalRenderData struct {
 	ApplicationName string
-	Shifts          []oncall.Shift
+	Events          []iCalEvent
 	ReminderMinutes []int
 	Version         string
 	GeneratedAt     time.Time
-	EventUIDs       []string
 }
 
+type iCalEvent struct {
+	UID         string
+	Summary     string
+	Description string
+	Start       time.Time
+	End         time.Time
+
+	// NoReminders will omit reminder alarms for the event.
+	NoReminders bool
+}
+
+// truncatedDesc is the description of shifts that continue beyond what is displayed.
+const truncatedDesc = "The end time of this shift is unknown and will continue beyond what is displayed."
+
+var iCalTextEscaper = strings.NewReplacer(
+	`\`, `\\`,
+	";", `\;`,
+	",", `\,`,
+	"\r\n", `\n`,
+	"\n", `\n`,
+	"\r", `\n`,
+)
+
+// escapeText will escape a value for use as iCal TEXT. It is applied by the template to
+// all TEXT values, so event fields should not be escaped beforehand.
+func escapeText(s string) string { return iCalTextEscaper.Replace(s) }
+
 // RFC can be found at https://tools.ietf.org/html/rfc5545
-var iCalTemplate = template.Must(template.New("ical").Parse(strings.ReplaceAll(`BEGIN:VCALENDAR
-PRODID:-//{{.ApplicationName}}//{{.Version}}//EN
+var iCalTemplate = template.Must(template.New("ical").Funcs(template.FuncMap{"text": escapeText}).Parse(strings.ReplaceAll(`BEGIN:VCALENDAR
+PRODID:-//{{text .ApplicationName}}//{{text .Version}}//EN
 VERSION:2.0
 CALSCALE:GREGORIAN
 METHOD:PUBLISH
 {{- $mins := .ReminderMinutes }}
 {{- $genTime := .GeneratedAt }}
-{{- $eventUIDs := .EventUIDs}}
-{{- range $i, $s := .Shifts}}
+{{- range .Events}}
 BEGIN:VEVENT
-UID:{{index $eventUIDs $i}}
-SUMMARY:On-Call Shift{{if $s.Truncated}} Begins*
-DESCRIPTION:The end time of this shift is unknown and will continue beyond what is displayed.
+UID:{{.UID}}
+SUMMARY:{{text .Summary}}
+{{- if .Description}}
+DESCRIPTION:{{text .Description}}
 {{- end }}
 DTSTAMP:{{$genTime.UTC.Format "20060102T150405Z"}}
 DTSTART:{{.Start.UTC.Format "20060102T150405Z"}}
 DTEND:{{.End.UTC.Format "20060102T150405Z"}}
+{{- if not .NoReminders}}
 {{- range $mins}}
 BEGIN:VALARM
 ACTION:DISPLAY
@@ -66,6 +96,7 @@ DESCRIPTION:REMINDER
 TRIGGER:-PT{{.}}M
 END:VALARM
 {{- end}}
+{{- end}}
 END:VEVENT
 {{- end}}
 END:VCALENDAR
@@ -87,6 +118,9 @@ func (cs CalendarSubscription) Normalize() (*CalendarSubscription, error) {
 		validate.UUID("ID", cs.ID),
 		validate.UUID("UserID", cs.UserID),
 	)
+	if cs.ScheduleID != "" {
+		err = validate.Many(err, validate.UUID("ScheduleID", cs.ScheduleID))
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -94,23 +128,41 @@ func (cs CalendarSubscription) Normalize() (*CalendarSubscription, error) {
 	return &cs, nil
 }
 
+func eventUID(userID, scheduleID string, s oncall.Shift) string {
+	t := s.End
+	if s.Truncated {
+		t = s.Start
+	}
+	sum := sha256.Sum256([]byte(userID + scheduleID + t.Format(time.RFC3339)))
+	return hex.EncodeToString(sum[:])
+}
+
 func (cs CalendarSubscription) renderICalFromShifts(appName string, shifts []oncall.Shift, generatedAt time.Time) ([]byte, error) {
-	var eventUIDs []string
+	events := make([]iCalEvent, 0, len(shifts))
 	for _, s := range shifts {
-		t := s.End
+		e := iCalEvent{
+			UID:     eventUID(s.UserID, cs.ScheduleID, s),
+			Summary: "On-Call Shift",
+			Start:   s.Start,
+			End:     s.End,
+		}
 		if s.Truncated {
-			t = s.Start
+			e.Summary += " Begins*"
+			e.Description = truncatedDesc
 		}
-		sum := sha256.Sum256([]byte(s.UserID + cs.ScheduleID + t.Format(time.RFC3339)))
-		eventUIDs = append(eventUIDs, hex.EncodeToString(sum[:]))
+		events = append(events, e)
 	}
+
+	return cs.renderICal(appName, events, generatedAt)
+}
+
+func (cs CalendarSubscription) renderICal(appName string, events []iCalEvent, generatedAt time.Time) ([]byte, error) {
 	data := iCalRenderData{
 		ApplicationName: appName,
-		Shifts:          shifts,
+		Events:          events,
 		ReminderMinutes: cs.Config.ReminderMinutes,
 		Version:         version.GitVersion(),
 		GeneratedAt:     generatedAt,
-		EventUIDs:       eventUIDs,
 	}
 	buf := bytes.NewBuffer(nil)
 
@@ -6,7 +6,9 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/target/goalert/assignment"
 	"github.com/target/goalert/oncall"
+	"github.com/target/goalert/override"
 )
 
 func TestCalendarSubscription_RenderICalFromShifts(t *testing.T) {
@@ -72,3 +74,54 @@ func TestCalendarSubscription_RenderICalFromShifts(t *testing.T) {
 	}, "\r\n")
 	assert.Equal(t, expected, string(iCal))
 }
+
+func TestCalendarSubscription_PersonalEvents(t *testing.T) {
+	cs := CalendarSubscription{UserID: "user"}
+	day := func(d, h int) time.Time { return time.Date(2021, 9, d, h, 0, 0, 0, time.UTC) }
+
+	shifts := []scheduleShift{
+		{ScheduleID: "s2", Shift: oncall.Shift{UserID: "user", Start: day(3, 0), End: day(4, 0)}},
+		{ScheduleID: "s1", Shift: oncall.Shift{UserID: "user", Start: day(1, 8), End: day(1, 17)}},
+	}
+	info := personalFeedInfo{
+		ScheduleNames: map[string]string{"s1": "Primary, East", "s2": "Secondary"},
+		Policies:      map[string][]string{"s1": {"Ops", "Web"}},
+		UserNames:     map[string]string{"bob": "Bob"},
+		Overrides: []override.UserOverride{
+			{ID: "o1", AddUserID: "user", RemoveUserID: "bob", Start: day(1, 8), End: day(1, 12), Target: assignment.ScheduleTarget("s1")},
+			{ID: "o2", AddUserID: "bob", RemoveUserID: "user", Start: day(2, 0), End: day(2, 6), Target: assignment.ScheduleTarget("s2")},
+		},
+	}
+
+	events := cs.personalEvents(shifts, info)
+	assert.Len(t, events, 3)
+
+	assert.Equal(t, "On-Call Shift: Primary, East", events[0].Summary)
+	assert.Equal(t, "Schedule: Primary, East\nEscalation Policies: Ops, Web\nOn call by override, replacing Bob.", events[0].Description)
+	assert.False(t, events[0].NoReminders)
+
+	assert.Equal(t, "Off-Call Override: Secondary", events[1].Summary)
+	assert.Equal(t, "Schedule: Secondary\nCovered by Bob.", events[1].Description)
+	assert.Equal(t, day(2, 0), events[1].Start)
+	assert.True(t, events[1].NoReminders)
+
+	assert.Equal(t, "On-Call Shift: Secondary", events[2].Summary)
+	assert.Equal(t, "Schedule: Secondary", events[2].Description)
+}
+
+func TestCalendarSubscription_RenderICalEscape(t *testing.T) {
+	var cs CalendarSubscription
+	generatedAt := time.Date(2021, 9, 1, 0, 0, 0, 0, time.UTC)
+	iCal, err := cs.renderICal("Go<Alert>", []iCalEvent{{
+		UID:         "uid",
+		Summary:     `On-Call Shift: A;B, C\D & <E>`,
+		Description: "line 1\nline 2",
+		Start:       generatedAt,
+		End:         generatedAt.Add(time.Hour),
+	}}, generatedAt)
+	assert.NoError(t, err)
+
+	assert.Contains(t, string(iCal), "PRODID:-//Go<Alert>//")
+	assert.Contains(t, string(iCal), "\r\nSUMMARY:On-Call Shift: A\\;B\\, C\\\\D & <E>\r\n")
+	assert.Contains(t, string(iCal), "\r\nDESCRIPTION:line 1\\nline 2\r\n")
+}
@@ -1,9 +1,18 @@
 package calendarsubscription
 
 import (
+	"context"
+	"database/sql"
 	"net/http"
+	"sort"
 	"time"
 
+	"github.com/pkg/errors"
+	"github.com/target/goalert/assignment"
+	"github.com/target/goalert/oncall"
+	"github.com/target/goalert/override"
+	"github.com/target/goalert/util/sqlutil"
+
 	"github.com/target/goalert/config"
 	"github.com/target/goalert/permission"
 	"github.com/target/goalert/util/errutil"
@@ -30,25 +39,149 @@ func (s *Store) ServeICalData(w http.ResponseWriter, req *http.Request) {
 		return
 	}
 
-	shifts, err := s.oc.HistoryBySchedule(ctx, cs.ScheduleID, n, n.AddDate(0, 1, 0))
+	var calData []byte
+	if cs.ScheduleID == "" {
+		calData, err = s.renderPersonalICal(ctx, cfg.ApplicationName(), cs, n)
+	} else {
+		calData, err = s.renderScheduleICal(ctx, cfg.ApplicationName(), cs, n)
+	}
 	if errutil.HTTPError(ctx, w, err) {
 		return
 	}
 
-	// filter out other users
+	w.Header().Set("Content-Type", "text/calendar")
+	w.Write(calData)
+}
+
+// filterShifts will return only shifts for the given user.
+func filterShifts(shifts []oncall.Shift, userID string) []oncall.Shift {
 	filtered := shifts[:0]
 	for _, s := range shifts {
-		if s.UserID != cs.UserID {
+		if s.UserID != userID {
 			continue
 		}
 		filtered = append(filtered, s)
 	}
+	return filtered
+}
 
-	calData, err := cs.renderICalFromShifts(cfg.ApplicationName(), filtered, n)
-	if errutil.HTTPError(ctx, w, err) {
-		return
+func (s *Store) renderScheduleICal(ctx context.Context, appName string, cs *CalendarSubscription, n time.Time) ([]byte, error) {
+	shifts, err := s.oc.HistoryBySchedule(ctx, cs.ScheduleID, n, n.AddDate(0, 1, 0))
+	if err != nil {
+		return nil, err
 	}
 
-	w.Header().Set("Content-Type", "text/calendar")
-	w.Write(calData)
+	return cs.renderICalFromShifts(appName, filterShifts(shifts, cs.UserID), n)
+}
+
+// renderPersonalICal will render a feed of the user's shifts across all schedules.
+func (s *Store) renderPersonalICal(ctx context.Context, appName string, cs *CalendarSubscription, n time.Time) ([]byte, error) {
+	end := n.AddDate(0, 1, 0)
+	info := personalFeedInfo{
+		ScheduleNames: make(map[string]string),
+		Policies:      make(map[string][]string),
+		UserNames:     make(map[string]string),
+	}
+
+	rows, err := s.userScheds.QueryContext(ctx, cs.UserID, n)
+	if err != nil {
+		return nil, errors.Wrap(err, "lookup schedules for user")
+	}
+	defer rows.Close()
+	var schedIDs []string
+	for rows.Next() {
+		var id, name string
+		err = rows.Scan(&id, &name)
+		if err != nil {
+			return nil, errors.Wrap(err, "scan schedule")
+		}
+		schedIDs = append(schedIDs, id)
+		info.ScheduleNames[id] = name
+	}
+	if err = rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "scan schedules")
+	}
+	sort.Strings(schedIDs)
+
+	var shifts []scheduleShift
+	for _, id := range schedIDs {
+		schedShifts, err := s.oc.HistoryBySchedule(ctx, id, n, end)
+		if err != nil {
+			return nil, errors.Wrapf(err, "lookup shifts for schedule '%s'", id)
+		}
+		for _, shift := range filterShifts(schedShifts, cs.UserID) {
+			shifts = append(shifts, scheduleShift{Shift: shift, ScheduleID: id})
+		}
+	}
+
+	rows, err = s.schedPolicies.QueryContext(ctx, sqlutil.UUIDArray(schedIDs))
+	if err != nil {
+		return nil, errors.Wrap(err, "lookup escalation policies")
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var schedID, name string
+		err = rows.Scan(&schedID, &name)
+		if err != nil {
+			return nil, errors.Wrap(err, "scan escalation policy")
+		}
+		info.Policies[schedID] = append(info.Policies[schedID], name)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "scan escalation policies")
+	}
+
+	rows, err = s.userOverrides.QueryContext(ctx, sqlutil.UUIDArray(schedIDs), cs.UserID, n, end)
+	if err != nil {
+		return nil, errors.Wrap(err, "lookup overrides")
+	}
+	defer rows.Close()
+	var userIDs []string
+	for rows.Next() {
+		var o override.UserOverride
+		var add, rem sql.NullString
+		var schedID string
+		err = rows.Scan(&o.ID, &add, &rem, &o.Start, &o.End, &schedID)
+		if err != nil {
+			return nil, errors.Wrap(err, "scan override")
+		}
+		o.AddUserID = add.String
+		o.RemoveUserID = rem.String
+		o.Target = assignment.ScheduleTarget(schedID)
+		info.Overrides = append(info.Overrides, o)
+		userIDs = append(userIDs, o.AddUserID, o.RemoveUserID)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "scan overrides")
+	}
+
+	rows, err = s.userNames.QueryContext(ctx, sqlutil.UUIDArray(nonEmpty(userIDs)))
+	if err != nil {
+		return nil, errors.Wrap(err, "lookup user names")
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var id, name string
+		err = rows.Scan(&id, &name)
+		if err != nil {
+			return nil, errors.Wrap(err, "scan user name")
+		}
+		info.UserNames[id] = name
+	}
+	if err = rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "scan user names")
+	}
+
+	return cs.renderICal(appName, cs.personalEvents(shifts, info), n)
+}
+
+func nonEmpty(ids []string) []string {
+	result := ids[:0]
+	for _, id := range ids {
+		if id == "" {
+			continue
+		}
+		result = append(result, id)
+	}
+	return result
 }
@@ -0,0 +1,103 @@
+package calendarsubscription
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/target/goalert/oncall"
+	"github.com/target/goalert/override"
+)
+
+// scheduleShift is an on-call shift for a specific schedule.
+type scheduleShift struct {
+	oncall.Shift
+	ScheduleID string
+}
+
+// personalFeedInfo contains the additional information used to describe events in a personal feed.
+type personalFeedInfo struct {
+	// ScheduleNames maps schedule IDs to names.
+	ScheduleNames map[string]string
+
+	// Policies maps schedule IDs to the names of escalation policies that use them.
+	Policies map[string][]string
+
+	// UserNames maps user IDs to names, used to describe overrides.
+	UserNames map[string]string
+
+	// Overrides contains all overrides adding or removing the subscription user.
+	Overrides []override.UserOverride
+}
+
+func overlaps(aStart, aEnd, bStart, bEnd time.Time) bool {
+	return aStart.Before(bEnd) && bStart.Before(aEnd)
+}
+
+// personalEvents will return events for shifts across all schedules, as well as any
+// overrides that remove the user from on-call.
+func (cs CalendarSubscription) personalEvents(shifts []scheduleShift, info personalFeedInfo) []iCalEvent {
+	events := make([]iCalEvent, 0, len(shifts))
+	for _, s := range shifts {
+		name := info.ScheduleNames[s.ScheduleID]
+		e := iCalEvent{
+			UID:     eventUID(s.UserID, s.ScheduleID, s.Shift),
+			Summary: "On-Call Shift: " + name,
+			Start:   s.Start,
+			End:     s.End,
+		}
+		if s.Truncated {
+			e.Summary = "On-Call Shift Begins*: " + name
+		}
+
+		desc := []string{"Schedule: " + name}
+		if pols := info.Policies[s.ScheduleID]; len(pols) > 0 {
+			desc = append(desc, "Escalation Policies: "+strings.Join(pols, ", "))
+		}
+		for _, o := range info.Overrides {
+			if o.Target.TargetID() != s.ScheduleID || o.AddUserID != cs.UserID {
+				continue
+			}
+			if !overlaps(o.Start, o.End, s.Start, s.End) {
+				continue
+			}
+			if o.RemoveUserID == "" {
+				desc = append(desc, "On call by override.")
+				continue
+			}
+			desc = append(desc, "On call by override, replacing "+info.UserNames[o.RemoveUserID]+".")
+		}
+		if s.Truncated {
+			desc = append(desc, truncatedDesc)
+		}
+		e.Description = strings.Join(desc, "\n")
+
+		events = append(events, e)
+	}
+
+	for _, o := range info.Overrides {
+		if o.RemoveUserID != cs.UserID {
+			continue
+		}
+		schedID := o.Target.TargetID()
+		name := info.ScheduleNames[schedID]
+		desc := []string{"Schedule: " + name}
+		if o.AddUserID == "" {
+			desc = append(desc, "Removed from on-call by override.")
+		} else {
+			desc = append(desc, "Covered by "+info.UserNames[o.AddUserID]+".")
+		}
+		events = append(events, iCalEvent{
+			UID:         eventUID(cs.UserID, schedID+o.ID, oncall.Shift{End: o.End}),
+			Summary:     "Off-Call Override: " + name,
+			Description: strings.Join(desc, "\n"),
+			Start:       o.Start,
+			End:         o.End,
+			NoReminders: true,
+		})
+	}
+
+	sort.SliceStable(events, func(i, j int) bool { return events[i].Start.Before(events[j].Start) })
+
+	return events
+}
@@ -31,6 +31,11 @@ type Store struct {
 	authUser   *sql.Stmt
 	now        *sql.Stmt
 
+	userScheds    *sql.Stmt
+	schedPolicies *sql.Stmt
+	userOverrides *sql.Stmt
+	userNames     *sql.Stmt
+
 	keys keyring.Keyring
 	oc   oncall.Store
 }
@@ -85,6 +90,54 @@ func NewStore(ctx context.Context, db *sql.DB, apiKeyring keyring.Keyring, oc on
 			FROM user_calendar_subscriptions
 			WHERE id = $1 AND user_id = $2
 		`),
+
+		userScheds: p.P(`
+			SELECT id, name
+			FROM schedules
+			WHERE id IN (
+				SELECT schedule_id FROM schedule_rules WHERE tgt_user_id = $1
+				UNION
+				SELECT rule.schedule_id
+				FROM schedule_rules rule
+				JOIN rotation_participants part ON part.rotation_id = rule.tgt_rotation_id
+				WHERE part.user_id = $1
+				UNION
+				SELECT tgt_schedule_id FROM user_overrides WHERE (add_user_id = $1 OR remove_user_id = $1) AND end_time > $2
+				UNION
+				SELECT schedule_id FROM schedule_on_call_users WHERE user_id = $1 AND end_time ISNULL
+				UNION
+				SELECT data.schedule_id
+				FROM
+					schedule_data data,
+					jsonb_array_elements(CASE jsonb_typeof(data.data->'V1'->'TemporarySchedules')
+						WHEN 'array' THEN data.data->'V1'->'TemporarySchedules'
+						ELSE '[]'
+					END) temp,
+					jsonb_array_elements(CASE jsonb_typeof(temp->'Shifts')
+						WHEN 'array' THEN temp->'Shifts'
+						ELSE '[]'
+					END) shift
+				WHERE shift->>'UserID' = $1::text AND (shift->>'End')::timestamptz > $2
+			)
+		`),
+		schedPolicies: p.P(`
+			SELECT DISTINCT act.schedule_id, pol.name
+			FROM escalation_policy_actions act
+			JOIN escalation_policy_steps step ON step.id = act.escalation_policy_step_id
+			JOIN escalation_policies pol ON pol.id = step.escalation_policy_id
+			WHERE act.schedule_id = any($1)
+			ORDER BY pol.name
+		`),
+		userOverrides: p.P(`
+			SELECT id, add_user_id, remove_user_id, start_time, end_time, tgt_schedule_id
+			FROM user_overrides
+			WHERE
+				tgt_schedule_id = any($1) AND
+				(add_user_id = $2 OR remove_user_id = $2) AND
+				end_time > $3 AND
+				start_time < $4
+		`),
+		userNames: p.P(`SELECT id, name FROM users WHERE id = any($1)`),
 	}, p.Err
 }
 
@@ -97,12 +150,14 @@ func wrapTx(ctx context.Context, tx *sql.Tx, stmt *sql.Stmt) *sql.Stmt {
 
 func (cs *CalendarSubscription) scanFrom(scanFn func(...interface{}) error) error {
 	var lastAccess sql.NullTime
+	var schedID sql.NullString
 	var cfgData []byte
-	err := scanFn(&cs.ID, &cs.Name, &cs.UserID, &cs.Disabled, &cs.ScheduleID, &cfgData, &lastAccess)
+	err := scanFn(&cs.ID, &cs.Name, &cs.UserID, &cs.Disabled, &schedID, &cfgData, &lastAccess)
 	if err != nil {
 		return err
 	}
 
+	cs.ScheduleID = schedID.String
 	cs.LastAccess = lastAccess.Time
 	err = json.Unmarshal(cfgData, &cs.Config)
 	return err
@@ -177,7 +232,7 @@ func (s *Store) CreateTx(ctx context.Context, tx *sql.Tx, cs *CalendarSubscripti
 	}
 
 	var now time.Time
-	row := wrapTx(ctx, tx, s.create).QueryRowContext(ctx, n.ID, n.Name, n.UserID, n.Disabled, n.ScheduleID, cfgData)
+	row := wrapTx(ctx, tx, s.create).QueryRowContext(ctx, n.ID, n.Name, n.UserID, n.Disabled, sql.NullString{String: n.ScheduleID, Valid: n.ScheduleID != ""}, cfgData)
 	err = row.Scan(&now)
 	if err != nil {
 		return nil, err
@@ -34,11 +34,24 @@ type Config struct {
 		AlertCleanupDays    int `public:"true" info:"Closed alerts will be deleted after this many days (0 means disable cleanup)."`
 		APIKeyExpireDays    int `public:"true" info:"Unused calendar API keys will be disabled after this many days (0 means disable cleanup)."`
 		ScheduleCleanupDays int `public:"true" info:"Schedule on-call history will be deleted after this many days (0 means disable cleanup)."`
+		AuditLogCleanupDays int `public:"true" info:"Audit log entries will be deleted after this many days (0 means disable cleanup)."`
+
+		UnusedIntegrationKeyDays int `public:"true" info:"Integration keys that have not been used for this many days will be flagged (0 means disable)."`
+	}
+
+	SafetyNet struct {
+		Enable         bool   `public:"true" info:"Escalate immediately past steps that are unable to notify anyone (or whose notifications all failed), and notify the safety net once the final step is reached without any successful notification."`
+		SlackChannelID string `info:"Slack channel ID to notify when an escalation policy does not successfully notify anyone. The channel must already be added to an escalation policy."`
+		NotifyAdmins   bool   `info:"Notify all admins when an escalation policy does not successfully notify anyone."`
 	}
 
 	Auth struct {
 		RefererURLs  []string `info:"Allowed referer URLs for auth and redirects."`
 		DisableBasic bool     `public:"true" info:"Disallow username/password login."`
+		RequireMFA   bool     `public:"true" info:"Require multi-factor authentication (TOTP) for username/password login. Users without it will be asked to set it up on their next login."`
+
+		IdleSessionTimeoutMinutes int `info:"Sessions will end after this many minutes without activity (0 means 30 days, otherwise at least 5)."`
+		MaxSessionAgeHours        int `info:"Sessions will end this many hours after login, regardless of activity (0 means no limit)."`
 	}
 
 	GitHub struct {
@@ -52,6 +65,9 @@ type Config struct {
 		AllowedUsers []string `info:"Allow any of the listed GitHub usernames to authenticate. Use '*' to allow any user."`
 		AllowedOrgs  []string `info:"Allow any member of any listed GitHub org (or team, using the format 'org/team') to authenticate."`
 
+		AdminOrgs []string `info:"Members of any listed GitHub org (or team, using the format 'org/team') will be granted the admin role on login, and others will have it revoked. If left blank, roles are managed manually."`
+		TeamOrgs  []string `info:"Sync GoAlert team membership on login, using the format 'org/team=Team Name'."`
+
 		EnterpriseURL string `info:"GitHub URL (without /api) when used with GitHub Enterprise."`
 	}
 
@@ -69,6 +85,53 @@ type Config struct {
 		UserInfoEmailPath         string `info:"JMESPath expression to find email address in UserInfo. If set, the email claim will be ignored in favor of this. (suggestion: email)."`
 		UserInfoEmailVerifiedPath string `info:"JMESPath expression to find email verification state in UserInfo. If set, the email_verified claim will be ignored in favor of this. (suggestion: email_verified)."`
 		UserInfoNamePath          string `info:"JMESPath expression to find full name in UserInfo. If set, the name claim will be ignored in favor of this. (suggestion: name || cn || join(' ', [firstname, lastname]))"`
+		UserInfoGroupsPath        string `info:"JMESPath expression to find the list of group names in UserInfo. If set, the groups claim will be ignored in favor of this. (suggestion: groups)"`
+
+		AdminGroups []string `info:"Members of any listed group will be granted the admin role on login, and others will have it revoked. If left blank, roles are managed manually."`
+		TeamGroups  []string `info:"Sync GoAlert team membership on login, using the format 'group=Team Name'."`
+	}
+
+	SAML struct {
+		Enable bool `public:"true" info:"Enable SAML 2.0 authentication."`
+
+		NewUsers     bool   `info:"Allow new user creation via SAML authentication."`
+		OverrideName string `info:"Set the name/label on the login page to something other than SAML."`
+
+		IDPMetadataURL string `info:"URL of the identity provider's SAML metadata."`
+		EntityID       string `info:"Service provider entity ID. If left blank, the service provider metadata URL will be used."`
+		Certificate    string `info:"PEM-encoded X.509 certificate used to sign authentication requests."`
+		PrivateKey     string `password:"true" info:"PEM-encoded RSA private key for the Certificate."`
+
+		NameAttribute  string `info:"Assertion attribute (Name or FriendlyName) containing the user's full name. If left blank, displayName will be used."`
+		EmailAttribute string `info:"Assertion attribute (Name or FriendlyName) containing the user's email address. If left blank, mail will be used, falling back to the NameID if it is an email address."`
+	}
+
+	LDAP struct {
+		Enable bool `public:"true" info:"Enable LDAP authentication."`
+
+		NewUsers     bool   `info:"Allow new user creation via LDAP authentication."`
+		OverrideName string `info:"Set the name/label on the login page to something other than LDAP."`
+
+		URL        string `info:"LDAP server URL (e.g., ldaps://ldap.example.com or ldap://ldap.example.com)."`
+		StartTLS   bool   `info:"Upgrade ldap:// connections to TLS using StartTLS."`
+		SkipVerify bool   `info:"Disables certificate validation for TLS/StartTLS (insecure)."`
+
+		BindDN       string `info:"DN to bind as when searching for users. If left blank, an anonymous search is performed."`
+		BindPassword string `password:"true" info:"Password for the BindDN."`
+
+		BaseDN         string `info:"Base DN to search for users."`
+		UserFilter     string `info:"Search filter to find a user, with {username} replaced by the login username. If left blank, (uid={username}) will be used. For Active Directory use (sAMAccountName={username})."`
+		NameAttribute  string `info:"User attribute containing the user's full name. If left blank, displayName will be used."`
+		EmailAttribute string `info:"User attribute containing the user's email address. If left blank, mail will be used."`
+
+		AllowedGroups []string `info:"Only allow members of any of the listed group DNs to authenticate. If empty, any user found may authenticate."`
+	}
+
+	SCIM struct {
+		Enable bool `public:"true" info:"Enable the SCIM 2.0 user provisioning API. Requests must be authorized with an admin-scoped API key."`
+
+		AuthProviderID   string `info:"ID of the auth provider provisioned users login with (e.g., oidc, saml, or ldap). If set, an auth subject is created for each provisioned user."`
+		SubjectAttribute string `info:"SCIM attribute used as the auth subject ID, either externalId or userName. If left blank, externalId will be used."`
 	}
 
 	Mailgun struct {
@@ -379,11 +442,22 @@ func (cfg Config) Validate() error {
 		}
 		return validate.OAuthScope(fname, val, "openid")
 	}
+	validateTeamMapping := func(fname, format string, vals []string) error {
+		var err error
+		for i, val := range vals {
+			parts := strings.SplitN(val, "=", 2)
+			if len(parts) != 2 || strings.TrimSpace(parts[0]) == "" || strings.TrimSpace(parts[1]) == "" {
+				err = validate.Many(err, validation.NewFieldError(fmt.Sprintf("%s[%d]", fname, i), "must be in the format '"+format+"'"))
+			}
+		}
+		return err
+	}
 
 	err = validate.Many(
 		err,
 		validate.Text("General.NotificationDisclaimer", cfg.General.NotificationDisclaimer, 0, 500),
 		validateKey("Mailgun.APIKey", cfg.Mailgun.APIKey),
+		validateKey("SafetyNet.SlackChannelID", cfg.SafetyNet.SlackChannelID),
 		validateKey("Slack.ClientID", cfg.Slack.ClientID),
 		validateKey("Slack.ClientSecret", cfg.Slack.ClientSecret),
 		validateKey("Twilio.AccountSID", cfg.Twilio.AccountSID),
@@ -394,10 +468,16 @@ func (cfg Config) Validate() error {
 		validate.Range("Maintenance.AlertCleanupDays", cfg.Maintenance.AlertCleanupDays, 0, 9000),
 		validate.Range("Maintenance.APIKeyExpireDays", cfg.Maintenance.APIKeyExpireDays, 0, 9000),
 		validate.Range("Maintenance.ScheduleCleanupDays", cfg.Maintenance.ScheduleCleanupDays, 0, 9000),
+		validate.Range("Maintenance.AuditLogCleanupDays", cfg.Maintenance.AuditLogCleanupDays, 0, 9000),
+		validate.Range("Maintenance.UnusedIntegrationKeyDays", cfg.Maintenance.UnusedIntegrationKeyDays, 0, 9000),
+		validate.Range("Auth.MaxSessionAgeHours", cfg.Auth.MaxSessionAgeHours, 0, 8760),
 		validateScopes("OIDC.Scopes", cfg.OIDC.Scopes),
 		validatePath("OIDC.UserInfoEmailPath", cfg.OIDC.UserInfoEmailPath),
 		validatePath("OIDC.UserInfoEmailVerifiedPath", cfg.OIDC.UserInfoEmailVerifiedPath),
 		validatePath("OIDC.UserInfoNamePath", cfg.OIDC.UserInfoNamePath),
+		validatePath("OIDC.UserInfoGroupsPath", cfg.OIDC.UserInfoGroupsPath),
+		validateTeamMapping("OIDC.TeamGroups", "group=Team Name", cfg.OIDC.TeamGroups),
+		validateTeamMapping("GitHub.TeamOrgs", "org/team=Team Name", cfg.GitHub.TeamOrgs),
 		validateKey("Slack.SigningSecret", cfg.Slack.SigningSecret),
 	)
 
@@ -407,6 +487,32 @@ func (cfg Config) Validate() error {
 	if cfg.OIDC.Scopes != "" {
 		err = validate.Many(err, validateScopes("OIDC.Scopes", cfg.OIDC.Scopes))
 	}
+	if cfg.Auth.IdleSessionTimeoutMinutes != 0 {
+		// session activity is only recorded once per minute, so shorter timeouts would end active sessions
+		err = validate.Many(err, validate.Range("Auth.IdleSessionTimeoutMinutes", cfg.Auth.IdleSessionTimeoutMinutes, 5, 43200))
+	}
+	if cfg.SAML.IDPMetadataURL != "" {
+		err = validate.Many(err, validate.AbsoluteURL("SAML.IDPMetadataURL", cfg.SAML.IDPMetadataURL))
+		if u, _ := url.Parse(cfg.SAML.IDPMetadataURL); u != nil && u.Scheme != "https" {
+			// metadata contains the IdP signing certificate, so it must not be fetched over plain HTTP
+			err = validate.Many(err, validation.NewFieldError("SAML.IDPMetadataURL", "must use https"))
+		}
+	}
+	if cfg.LDAP.URL != "" {
+		err = validate.Many(err, validate.AbsoluteURL("LDAP.URL", cfg.LDAP.URL))
+		if cfg.LDAP.StartTLS && strings.HasPrefix(strings.ToLower(cfg.LDAP.URL), "ldaps://") {
+			err = validate.Many(err, validation.NewFieldError("LDAP.StartTLS", "cannot be used with ldaps:// URLs"))
+		}
+	}
+	if cfg.LDAP.UserFilter != "" && !strings.Contains(cfg.LDAP.UserFilter, "{username}") {
+		err = validate.Many(err, validation.NewFieldError("LDAP.UserFilter", "must contain {username}"))
+	}
+	if cfg.SCIM.AuthProviderID != "" {
+		err = validate.Many(err, validate.OneOf("SCIM.AuthProviderID", cfg.SCIM.AuthProviderID, "basic", "github", "oidc", "saml", "ldap"))
+	}
+	if cfg.SCIM.SubjectAttribute != "" {
+		err = validate.Many(err, validate.OneOf("SCIM.SubjectAttribute", cfg.SCIM.SubjectAttribute, "externalId", "userName"))
+	}
 	if cfg.GitHub.EnterpriseURL != "" {
 		err = validate.Many(err, validate.AbsoluteURL("GitHub.EnterpriseURL", cfg.GitHub.EnterpriseURL))
 	}
@@ -455,6 +561,15 @@ func (cfg Config) Validate() error {
 			"ClientID", cfg.OIDC.ClientID,
 			"ClientSecret", cfg.OIDC.ClientSecret,
 		),
+		validateEnable("SAML", cfg.SAML.Enable,
+			"IDPMetadataURL", cfg.SAML.IDPMetadataURL,
+			"Certificate", cfg.SAML.Certificate,
+			"PrivateKey", cfg.SAML.PrivateKey,
+		),
+		validateEnable("LDAP", cfg.LDAP.Enable,
+			"URL", cfg.LDAP.URL,
+			"BaseDN", cfg.LDAP.BaseDN,
+		),
 		validateEnable("SMTP", cfg.SMTP.Enable,
 			"From", cfg.SMTP.From,
 			"Address", cfg.SMTP.Address,
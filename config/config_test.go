@@ -97,3 +97,54 @@ func TestValidReferer(t *testing.T) {
 		assert.False(t, cfg.ValidReferer("https://req.com", "https://req.com/bar"), "auth URL set (no same host)")
 	})
 }
+
+func TestConfig_Redacted(t *testing.T) {
+	var cfg Config
+	cfg.General.ApplicationName = "Test"
+	cfg.Twilio.AuthToken = "secret"
+	cfg.SMTP.Password = "secret"
+
+	r := cfg.redacted()
+	assert.Equal(t, "Test", r.General.ApplicationName)
+	assert.Equal(t, redactedValue, r.Twilio.AuthToken)
+	assert.Equal(t, redactedValue, r.SMTP.Password)
+	assert.Empty(t, r.OIDC.ClientSecret, "empty values are left unset")
+	assert.Equal(t, "secret", cfg.Twilio.AuthToken, "original unchanged")
+}
+
+func TestConfig_RedactedChange(t *testing.T) {
+	var prev, cfg Config
+	prev.Twilio.AuthToken = "old"
+	cfg.Twilio.AuthToken = "new"
+	prev.SMTP.Password = "same"
+	cfg.SMTP.Password = "same"
+	cfg.OIDC.ClientSecret = "added"
+
+	before, after := redactedChange(prev, cfg)
+	assert.Equal(t, redactedValue, before.Twilio.AuthToken)
+	assert.Equal(t, redactedChangedValue, after.Twilio.AuthToken)
+	assert.Equal(t, redactedValue, before.SMTP.Password)
+	assert.Equal(t, redactedValue, after.SMTP.Password, "unchanged")
+	assert.Empty(t, before.OIDC.ClientSecret)
+	assert.Equal(t, redactedValue, after.OIDC.ClientSecret)
+}
+
+func TestConfig_Validate_IdleSessionTimeout(t *testing.T) {
+	var cfg Config
+	assert.NoError(t, cfg.Validate(), "disabled")
+
+	cfg.Auth.IdleSessionTimeoutMinutes = 5
+	assert.NoError(t, cfg.Validate())
+
+	cfg.Auth.IdleSessionTimeoutMinutes = 1
+	assert.Error(t, cfg.Validate(), "shorter than activity is recorded")
+}
+
+func TestConfig_Validate_SAMLMetadataURL(t *testing.T) {
+	var cfg Config
+	cfg.SAML.IDPMetadataURL = "https://idp.example.com/metadata"
+	assert.NoError(t, cfg.Validate())
+
+	cfg.SAML.IDPMetadataURL = "http://idp.example.com/metadata"
+	assert.Error(t, cfg.Validate(), "plain HTTP")
+}
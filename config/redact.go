@@ -0,0 +1,50 @@
+package config
+
+import "reflect"
+
+// redactedValue replaces any non-empty `password` field when redacting a config.
+const redactedValue = "***"
+
+// redactedChangedValue replaces a `password` field that was changed to a new non-empty value.
+const redactedChangedValue = "*** (changed)"
+
+// redacted returns a copy of the config with all `password` fields replaced, so it
+// may be recorded (e.g., in the audit log) without exposing secrets.
+func (cfg Config) redacted() Config {
+	redactStruct(reflect.ValueOf(&cfg).Elem(), reflect.Value{})
+	return cfg
+}
+
+// redactedChange returns redacted copies of prev and cfg. Secrets that were replaced
+// by a different value are marked as changed in cfg, so the change remains visible.
+func redactedChange(prev, cfg Config) (Config, Config) {
+	redactStruct(reflect.ValueOf(&cfg).Elem(), reflect.ValueOf(prev))
+	return prev.redacted(), cfg
+}
+
+// redactStruct will redact all `password` fields of v. If prev is valid, fields that
+// differ from prev are marked as changed.
+func redactStruct(v, prev reflect.Value) {
+	t := v.Type()
+	for i := 0; i < v.NumField(); i++ {
+		f := v.Field(i)
+		if !f.CanSet() {
+			continue
+		}
+		var pf reflect.Value
+		if prev.IsValid() {
+			pf = prev.Field(i)
+		}
+
+		switch {
+		case f.Kind() == reflect.Struct:
+			redactStruct(f, pf)
+		case f.Kind() == reflect.String && t.Field(i).Tag.Get("password") == "true" && f.String() != "":
+			if pf.IsValid() && pf.String() != "" && pf.String() != f.String() {
+				f.SetString(redactedChangedValue)
+				continue
+			}
+			f.SetString(redactedValue)
+		}
+	}
+}
@@ -13,6 +13,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/target/goalert/audit"
 	"github.com/target/goalert/keyring"
 	"github.com/target/goalert/permission"
 	"github.com/target/goalert/util"
@@ -34,6 +35,7 @@ type Store struct {
 	latestConfig *sql.Stmt
 	setConfig    *sql.Stmt
 	lock         *sql.Stmt
+	auditLog     *sql.Stmt
 
 	closeCh chan struct{}
 }
@@ -49,6 +51,7 @@ func NewStore(ctx context.Context, db *sql.DB, keys keyring.Keys, fallbackURL st
 		latestConfig: p.P(`select id, data, schema from config where schema <= $1 order by id desc limit 1`),
 		setConfig:    p.P(`insert into config (id, schema, data) values (DEFAULT, $1, $2) returning (id)`),
 		lock:         p.P(`lock config in exclusive mode`),
+		auditLog:     p.P(`insert into audit_logs (object_type, object_id, action, before, after) values ($1, $2, $3, $4, $5)`),
 		keys:         keys,
 		closeCh:      make(chan struct{}),
 	}
@@ -219,6 +222,16 @@ func (s *Store) SetConfigData(ctx context.Context, tx *sql.Tx, data []byte) (int
 		return 0, errors.Wrap(err, "validate config")
 	}
 
+	prevID, _, prevData, err := s.ConfigData(ctx, tx)
+	if err != nil {
+		return 0, errors.Wrap(err, "load previous config")
+	}
+	var prev Config
+	err = json.Unmarshal(prevData, &prev)
+	if err != nil {
+		return 0, errors.Wrap(err, "unmarshal previous config")
+	}
+
 	data, err = s.keys.Encrypt("CONFIG", data)
 	if err != nil {
 		return 0, errors.Wrap(err, "encrypt config")
@@ -230,9 +243,43 @@ func (s *Store) SetConfigData(ctx context.Context, tx *sql.Tx, data []byte) (int
 		return 0, err
 	}
 
+	err = s.logChange(ctx, tx, prevID, prev, cfg)
+	if err != nil {
+		return 0, errors.Wrap(err, "audit log")
+	}
+
 	return id, nil
 }
 
+// auditObjectID is the object ID of all config changes in the audit log, as each change
+// creates a new config row.
+const auditObjectID = "config"
+
+// logChange records a config change in the audit log, with secrets redacted.
+func (s *Store) logChange(ctx context.Context, tx *sql.Tx, prevID int, prev, cfg Config) error {
+	action := audit.ActionCreate
+	var before interface{}
+	redacted := cfg.redacted()
+	if prevID != 0 {
+		action = audit.ActionUpdate
+		var redactedPrev Config
+		redactedPrev, redacted = redactedChange(prev, cfg)
+		data, err := json.Marshal(redactedPrev)
+		if err != nil {
+			return err
+		}
+		before = data
+	}
+
+	after, err := json.Marshal(redacted)
+	if err != nil {
+		return err
+	}
+
+	_, err = wrapTx(ctx, tx, s.auditLog).ExecContext(ctx, audit.ObjectTypeConfig, auditObjectID, action, before, after)
+	return err
+}
+
 func (s *Store) reloadTx(ctx context.Context, tx *sql.Tx) (*Config, int, error) {
 	id, schemaVersion, data, err := s.ConfigData(ctx, tx)
 	if err != nil {
@@ -265,6 +312,10 @@ func (s *Store) UpdateConfig(ctx context.Context, fn func(Config) (Config, error
 		return err
 	}
 	defer tx.Rollback()
+	err = audit.SetActorTx(ctx, tx)
+	if err != nil {
+		return err
+	}
 	id, err := s.updateConfigTx(ctx, tx, fn)
 	if err != nil {
 		return err
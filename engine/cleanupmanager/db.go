@@ -26,6 +26,7 @@ type DB struct {
 	cleanupSessions *sql.Stmt
 
 	cleanupAlertLogs *sql.Stmt
+	cleanupAuditLogs *sql.Stmt
 
 	cleanupOverrides   *sql.Stmt
 	cleanupSchedOnCall *sql.Stmt
@@ -69,8 +70,19 @@ func NewDB(ctx context.Context, db *sql.DB) (*DB, error) {
 			for update skip locked
 			limit 100
 		`),
-		setSchedData:    p.P(`update schedule_data set last_cleanup_at = now(), data = $2 where schedule_id = $1`),
-		cleanupSessions: p.P(`DELETE FROM auth_user_sessions WHERE id = any(select id from auth_user_sessions where last_access_at < (now() - '30 days'::interval) LIMIT 100 for update skip locked)`),
+		setSchedData: p.P(`update schedule_data set last_cleanup_at = now(), data = $2 where schedule_id = $1`),
+
+		cleanupSessions: p.P(`
+			DELETE FROM auth_user_sessions
+			WHERE id = any(
+				select id from auth_user_sessions
+				where
+					coalesce(last_access_at, created_at) < (now() - $1::interval) or
+					($2::int > 0 and created_at < now() - $2::int * '1 hour'::interval)
+				LIMIT 100
+				for update skip locked
+			)
+		`),
 
 		cleanupAlertLogs: p.P(`
 			with
@@ -88,6 +100,8 @@ func NewDB(ctx context.Context, db *sql.DB) (*DB, error) {
 			select id from scope offset 999
 		`),
 
+		cleanupAuditLogs: p.P(`DELETE FROM audit_logs WHERE id = ANY(SELECT id FROM audit_logs WHERE timestamp < (now() - $1::interval) ORDER BY id LIMIT 1000 FOR UPDATE SKIP LOCKED)`),
+
 		cleanupOverrides:   p.P(`DELETE FROM user_overrides WHERE id = ANY(SELECT id FROM user_overrides WHERE end_time < (now() - $1::interval) LIMIT 100 FOR UPDATE SKIP LOCKED)`),
 		cleanupSchedOnCall: p.P(`DELETE FROM schedule_on_call_users WHERE id = ANY(SELECT id FROM schedule_on_call_users WHERE end_time < (now() - $1::interval) LIMIT 100 FOR UPDATE SKIP LOCKED)`),
 		cleanupEPOnCall:    p.P(`DELETE FROM ep_step_on_call_users WHERE id = ANY(SELECT id FROM ep_step_on_call_users WHERE end_time < (now() - $1::interval) LIMIT 100 FOR UPDATE SKIP LOCKED)`),
@@ -46,12 +46,20 @@ func (db *DB) update(ctx context.Context) error {
 		return err
 	}
 
-	_, err = tx.StmtContext(ctx, db.cleanupSessions).ExecContext(ctx)
+	cfg := config.FromContext(ctx)
+
+	var idle pgtype.Interval
+	idle.Days = 30
+	if cfg.Auth.IdleSessionTimeoutMinutes > 0 {
+		idle.Days = 0
+		idle.Microseconds = int64(cfg.Auth.IdleSessionTimeoutMinutes) * int64(time.Minute/time.Microsecond)
+	}
+	idle.Status = pgtype.Present
+	_, err = tx.StmtContext(ctx, db.cleanupSessions).ExecContext(ctx, &idle, cfg.Auth.MaxSessionAgeHours)
 	if err != nil {
 		return fmt.Errorf("cleanup sessions: %w", err)
 	}
 
-	cfg := config.FromContext(ctx)
 	if cfg.Maintenance.AlertCleanupDays > 0 {
 		var dur pgtype.Interval
 		dur.Days = int32(cfg.Maintenance.AlertCleanupDays)
@@ -89,6 +97,15 @@ func (db *DB) update(ctx context.Context) error {
 			return fmt.Errorf("cleanup escalation policy on-call: %w", err)
 		}
 	}
+	if cfg.Maintenance.AuditLogCleanupDays > 0 {
+		var dur pgtype.Interval
+		dur.Days = int32(cfg.Maintenance.AuditLogCleanupDays)
+		dur.Status = pgtype.Present
+		_, err = tx.StmtContext(ctx, db.cleanupAuditLogs).ExecContext(ctx, &dur)
+		if err != nil {
+			return fmt.Errorf("cleanup audit logs: %w", err)
+		}
+	}
 
 	rows, err := tx.StmtContext(ctx, db.schedData).QueryContext(ctx)
 	if err != nil {
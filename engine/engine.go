@@ -307,13 +307,12 @@ func (p *Engine) SetSendResult(ctx context.Context, res *notification.SendResult
 	return err
 }
 
-// ReceiveSubject will process a notification result.
-func (p *Engine) ReceiveSubject(ctx context.Context, providerID, subjectID, callbackID string, result notification.Result) error {
-	ctx, sp := trace.StartSpan(ctx, "Engine.ReceiveSubject")
-	defer sp.End()
+// subjectCallbackContext will return the callback for callbackID along with a context for the user
+// matching the provider/subject.
+func (p *Engine) subjectCallbackContext(ctx context.Context, providerID, subjectID, callbackID string) (context.Context, *callback, error) {
 	cb, err := p.b.FindOne(ctx, callbackID)
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
 	if cb.ServiceID != "" {
 		ctx = log.WithField(ctx, "ServiceID", cb.ServiceID)
@@ -327,10 +326,10 @@ func (p *Engine) ReceiveSubject(ctx context.Context, providerID, subjectID, call
 		usr, err = p.cfg.UserStore.FindOneBySubject(ctx, providerID, subjectID)
 	})
 	if err != nil {
-		return fmt.Errorf("failed to find user: %w", err)
+		return nil, nil, fmt.Errorf("failed to find user: %w", err)
 	}
 	if usr == nil {
-		return notification.ErrUnknownSubject
+		return nil, nil, notification.ErrUnknownSubject
 	}
 
 	ctx = permission.UserSourceContext(ctx, usr.ID, usr.Role, &permission.SourceInfo{
@@ -338,33 +337,15 @@ func (p *Engine) ReceiveSubject(ctx context.Context, providerID, subjectID, call
 		ID:   callbackID,
 	})
 
-	var newStatus alert.Status
-	switch result {
-	case notification.ResultAcknowledge:
-		newStatus = alert.StatusActive
-	case notification.ResultResolve:
-		newStatus = alert.StatusClosed
-	default:
-		return errors.New("unknown result type")
-	}
-
-	if cb.AlertID != 0 {
-		return errors.Wrap(p.am.UpdateStatus(ctx, cb.AlertID, newStatus), "update alert")
-	}
-	if cb.ServiceID != "" {
-		return errors.Wrap(p.am.UpdateStatusByService(ctx, cb.ServiceID, newStatus), "update all alerts")
-	}
-
-	return errors.New("unknown callback type")
+	return ctx, cb, nil
 }
 
-// Receive will process a notification result.
-func (p *Engine) Receive(ctx context.Context, callbackID string, result notification.Result) error {
-	ctx, sp := trace.StartSpan(ctx, "Engine.Receive")
-	defer sp.End()
+// callbackContext will return the callback for callbackID along with a context for the user
+// that owns the contact method.
+func (p *Engine) callbackContext(ctx context.Context, callbackID string) (context.Context, *callback, error) {
 	cb, err := p.b.FindOne(ctx, callbackID)
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
 	if cb.ServiceID != "" {
 		ctx = log.WithField(ctx, "ServiceID", cb.ServiceID)
@@ -386,13 +367,17 @@ func (p *Engine) Receive(ctx context.Context, callbackID string, result notifica
 		}
 	})
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
 	ctx = permission.UserSourceContext(ctx, usr.ID, usr.Role, &permission.SourceInfo{
 		Type: permission.SourceTypeNotificationCallback,
 		ID:   callbackID,
 	})
 
+	return ctx, cb, nil
+}
+
+func (p *Engine) processResult(ctx context.Context, cb *callback, result notification.Result) error {
 	var newStatus alert.Status
 	switch result {
 	case notification.ResultAcknowledge:
@@ -413,6 +398,66 @@ func (p *Engine) Receive(ctx context.Context, callbackID string, result notifica
 	return errors.New("unknown callback type")
 }
 
+func (p *Engine) processSnooze(ctx context.Context, cb *callback, dur time.Duration) error {
+	if cb.AlertID == 0 {
+		return errors.New("snooze is only supported for alert notifications")
+	}
+
+	return errors.Wrap(p.am.Snooze(ctx, cb.AlertID, time.Now().Add(dur)), "snooze alert")
+}
+
+// ReceiveSubject will process a notification result.
+func (p *Engine) ReceiveSubject(ctx context.Context, providerID, subjectID, callbackID string, result notification.Result) error {
+	ctx, sp := trace.StartSpan(ctx, "Engine.ReceiveSubject")
+	defer sp.End()
+
+	ctx, cb, err := p.subjectCallbackContext(ctx, providerID, subjectID, callbackID)
+	if err != nil {
+		return err
+	}
+
+	return p.processResult(ctx, cb, result)
+}
+
+// Receive will process a notification result.
+func (p *Engine) Receive(ctx context.Context, callbackID string, result notification.Result) error {
+	ctx, sp := trace.StartSpan(ctx, "Engine.Receive")
+	defer sp.End()
+
+	ctx, cb, err := p.callbackContext(ctx, callbackID)
+	if err != nil {
+		return err
+	}
+
+	return p.processResult(ctx, cb, result)
+}
+
+// ReceiveSubjectSnooze will process a snooze request from a provider/subject.
+func (p *Engine) ReceiveSubjectSnooze(ctx context.Context, providerID, subjectID, callbackID string, dur time.Duration) error {
+	ctx, sp := trace.StartSpan(ctx, "Engine.ReceiveSubjectSnooze")
+	defer sp.End()
+
+	ctx, cb, err := p.subjectCallbackContext(ctx, providerID, subjectID, callbackID)
+	if err != nil {
+		return err
+	}
+
+	return p.processSnooze(ctx, cb, dur)
+}
+
+// ReceiveSnooze will process a snooze request.
+func (p *Engine) ReceiveSnooze(ctx context.Context, callbackID string, dur time.Duration) error {
+	ctx, sp := trace.StartSpan(ctx, "Engine.ReceiveSnooze")
+	defer sp.End()
+
+	ctx, cb, err := p.callbackContext(ctx, callbackID)
+	if err != nil {
+		return err
+	}
+
+	return p.processSnooze(ctx, cb, dur)
+}
+
 // Start will enable all associated contact methods of `value` with type `t`. This should
 // be invoked if a user, for example, responds with `START` via sms.
 func (p *Engine) Start(ctx context.Context, d notification.Dest) error {
@@ -18,20 +18,87 @@ type DB struct {
 	lockStmt     *sql.Stmt
 	updateOnCall *sql.Stmt
 
+	ackTimeout *sql.Stmt
+	retrigger  *sql.Stmt
+
+	snoozeExpired *sql.Stmt
+	snoozeNotify  *sql.Stmt
+
 	newPolicies      *sql.Stmt
 	deletedSteps     *sql.Stmt
 	normalEscalation *sql.Stmt
+	fallback         *sql.Stmt
+
+	sequentialEscalation *sql.Stmt
+
+	skipUnreachable *sql.Stmt
+	safetyNet       *sql.Stmt
 
 	log alertlog.Store
 }
 
+// stepUsersCTE selects the on-call users of each step in to_escalate,
+// ordered by the position of the step target they are on call for
+// (then by name), used for round-robin and sequential target modes.
+const stepUsersCTE = `_step_users as (
+	select
+		on_call.ep_step_id,
+		on_call.user_id,
+		row_number() over (partition by on_call.ep_step_id order by tgt.position, u.name, u.id) - 1 pos,
+		count(*) over (partition by on_call.ep_step_id) total
+	from ep_step_on_call_users on_call
+	join users u on u.id = on_call.user_id
+	join lateral (
+		-- first target of the step the user is on call for
+		select coalesce(min(act.position), 0) position
+		from escalation_policy_actions act
+		left join rotation_state rState on rState.rotation_id = act.rotation_id
+		left join rotation_participants part on part.id = rState.rotation_participant_id
+		left join schedule_on_call_users sched on sched.schedule_id = act.schedule_id and sched.end_time isnull
+		where
+			act.escalation_policy_step_id = on_call.ep_step_id and
+			coalesce(act.user_id, part.user_id, sched.user_id) = on_call.user_id
+	) tgt on true
+	where
+		on_call.end_time isnull and
+		on_call.ep_step_id in (select ep_step_id from to_escalate)
+)`
+
+// stepCyclesCTE selects the users to notify for each alert in to_escalate
+// according to the step target mode, advancing round-robin counters as needed.
+const stepCyclesCTE = stepUsersCTE + `, _rr as (
+	select
+		esc.alert_id,
+		esc.ep_step_id,
+		coalesce(rr.next_index, 0) + row_number() over (partition by esc.ep_step_id order by esc.alert_id) - 1 idx
+	from to_escalate esc
+	left join ep_step_round_robin rr on rr.ep_step_id = esc.ep_step_id
+	where esc.target_mode = 'round_robin'
+), _rr_update as (
+	insert into ep_step_round_robin (ep_step_id, next_index)
+	select ep_step_id, max(idx) + 1
+	from _rr
+	group by ep_step_id
+	on conflict (ep_step_id) do update
+	set next_index = excluded.next_index
+), _step_cycles as (
+	select esc.alert_id, u.user_id, esc.ep_step_id
+	from to_escalate esc
+	join _step_users u on u.ep_step_id = esc.ep_step_id
+	left join _rr rr on rr.alert_id = esc.alert_id
+	where
+		esc.target_mode = 'all' or
+		(esc.target_mode = 'sequential' and u.pos = 0) or
+		(esc.target_mode = 'round_robin' and u.pos = rr.idx % u.total)
+)`
+
 // Name returns the name of the module.
 func (db *DB) Name() string { return "Engine.EscalationManager" }
 
 // NewDB creates a new DB.
 func NewDB(ctx context.Context, db *sql.DB, log alertlog.Store) (*DB, error) {
 	lock, err := processinglock.NewLock(ctx, db, processinglock.Config{
-		Version: 3,
+		Version: 12,
 		Type:    processinglock.TypeEscalation,
 	})
 	if err != nil {
@@ -91,24 +158,201 @@ func NewDB(ctx context.Context, db *sql.DB, log alertlog.Store) (*DB, error) {
 				pol.step_count = 0
 		`),
 
+		ackTimeout: p.P(`
+			with to_retrigger as (
+				select
+					state.alert_id,
+					state.ack_timeout_count + 1 timeout_count,
+					svc.ack_timeout_minutes,
+					svc.ack_timeout_limit
+				from escalation_policy_state state
+				join services svc on svc.id = state.service_id and svc.ack_timeout_minutes > 0
+				join alerts a on a.id = state.alert_id and a.status = 'active'
+				where
+					state.snooze_until isnull and
+					state.ack_time + (cast(svc.ack_timeout_minutes as text)||' minutes')::interval < now() and
+					state.ack_timeout_count < svc.ack_timeout_limit
+				for update skip locked
+				limit 500
+			)
+			update escalation_policy_state state
+			set
+				ack_timeout_count = r.timeout_count,
+				last_escalation = null,
+				next_escalation = null,
+				loop_count = 0,
+				step_target_index = 0,
+				fallback_notified = false,
+				force_escalation = false
+			from to_retrigger r
+			where state.alert_id = r.alert_id
+			returning state.alert_id, r.ack_timeout_minutes, r.timeout_count, r.ack_timeout_limit
+		`),
+		retrigger: p.P(`
+			update alerts
+			set status = 'triggered'
+			where
+				id = any($1) and
+				status = 'active'
+		`),
+
+		snoozeExpired: p.P(`
+			update escalation_policy_state state
+			set snooze_until = null
+			where alert_id in (
+				select state.alert_id
+				from escalation_policy_state state
+				join alerts a on a.id = state.alert_id and a.status = 'active'
+				where state.snooze_until < now()
+				for update skip locked
+				limit 500
+			)
+			returning alert_id
+		`),
+		snoozeNotify: p.P(`
+			with to_escalate as (
+				select
+					alert_id,
+					step.id ep_step_id,
+					step.step_number,
+					step.delay,
+					step.target_mode,
+					a.service_id,
+					step.escalation_policy_id
+				from escalation_policy_state state
+				join alerts a on a.id = state.alert_id
+				join escalation_policy_steps step on step.id = state.escalation_policy_step_id
+				where state.alert_id = any($1)
+			), ` + stepCyclesCTE + `, _cycles as (
+				insert into notification_policy_cycles (alert_id, user_id)
+				select alert_id, user_id
+				from _step_cycles
+			), _channels as (
+				insert into outgoing_messages (message_type, alert_id, service_id, escalation_policy_id, channel_id)
+				select
+					cast('alert_notification' as enum_outgoing_messages_type),
+					esc.alert_id,
+					esc.service_id,
+					esc.escalation_policy_id,
+					act.channel_id
+				from to_escalate esc
+				join escalation_policy_actions act on
+					act.channel_id notnull and
+					act.escalation_policy_step_id = esc.ep_step_id
+			)
+			update escalation_policy_state state
+			set
+				last_escalation = now(),
+				next_escalation = now() + (cast(esc.delay as text)||' minutes')::interval,
+				step_target_index = 0,
+				force_escalation = false
+			from to_escalate esc
+			where state.alert_id = esc.alert_id
+		`),
+
+		skipUnreachable: p.P(`
+			update escalation_policy_state state
+			set next_escalation = now()
+			where alert_id in (
+				select state.alert_id
+				from escalation_policy_state state
+				join alerts a on a.id = state.alert_id and a.status = 'triggered'
+				where
+					state.escalation_policy_step_id notnull and
+					state.next_escalation > now() and
+					-- only skip to later steps, repeating is left to the step delay
+					exists (
+						select 1
+						from escalation_policy_steps step
+						where
+							step.escalation_policy_id = state.escalation_policy_id and
+							step.step_number > state.escalation_policy_step_number
+					) and
+					(
+						not fn_ep_step_reachable(state.escalation_policy_step_id) or
+						-- every notification sent for the current step failed
+						fn_ep_step_failed(state.alert_id, state.last_escalation)
+					)
+				for update skip locked
+				limit 500
+			)
+		`),
+		safetyNet: p.P(`
+			with to_notify as (
+				select
+					state.alert_id,
+					state.escalation_policy_step_number step_number,
+					state.escalation_policy_id,
+					a.service_id
+				from escalation_policy_state state
+				join alerts a on a.id = state.alert_id and a.status = 'triggered'
+				where
+					not state.safety_net_notified and
+					state.escalation_policy_step_id notnull and
+					-- only once the last step has been reached
+					not exists (
+						select 1
+						from escalation_policy_steps step
+						where
+							step.escalation_policy_id = state.escalation_policy_id and
+							step.step_number > state.escalation_policy_step_number
+					) and
+					-- no notification for the alert succeeded, and none are pending
+					fn_ep_alert_failed(state.alert_id)
+				for update skip locked
+				limit 100
+			), _chan as (
+				-- only channels already known to GoAlert (e.g., added to a policy) are notified
+				select id
+				from notification_channels
+				where
+					$2 != '' and
+					type = 'SLACK' and
+					value = $2
+				limit 1
+			), _channels as (
+				insert into outgoing_messages (message_type, alert_id, service_id, escalation_policy_id, channel_id)
+				select
+					cast('alert_notification' as enum_outgoing_messages_type),
+					n.alert_id,
+					n.service_id,
+					n.escalation_policy_id,
+					chan.id
+				from to_notify n, _chan chan
+			), _admins as (
+				insert into notification_policy_cycles (alert_id, user_id)
+				select n.alert_id, u.id
+				from to_notify n
+				join users u on $1 and u.role = 'admin'
+			)
+			update escalation_policy_state state
+			set safety_net_notified = true
+			from to_notify n
+			where state.alert_id = n.alert_id
+			returning
+				n.alert_id,
+				n.step_number,
+				not exists (select 1 from _chan) and
+				not exists (select 1 from users where $1 and role = 'admin')
+		`),
+
 		newPolicies: p.P(`
 			with to_escalate as (
-				select alert_id, step.id ep_step_id, step.delay, step.escalation_policy_id, a.service_id
+				select alert_id, step.id ep_step_id, step.delay, step.step_number, step.target_mode, step.escalation_policy_id, a.service_id
 				from escalation_policy_state state
-				join escalation_policy_steps step on
-					step.escalation_policy_id = state.escalation_policy_id and
-					step.step_number = 0
 				join alerts a on a.id = state.alert_id and (a.status = 'triggered' or state.force_escalation)
+				join lateral (
+					-- first matching step, or the first step if none match
+					select id, delay, step_number, target_mode, escalation_policy_id
+					from escalation_policy_steps step
+					where step.escalation_policy_id = state.escalation_policy_id
+					order by fn_ep_step_matches(step.id, a.id) desc, step.step_number
+					limit 1
+				) step on true
 				where state.last_escalation isnull
 				for update skip locked
 				limit 1000
-			), _step_cycles as (
-				select esc.alert_id, on_call.user_id, esc.ep_step_id
-				from to_escalate esc
-				join ep_step_on_call_users on_call on
-					on_call.end_time isnull and
-					on_call.ep_step_id = esc.ep_step_id
-			), _cycles as (
+			), ` + stepCyclesCTE + `, _cycles as (
 				insert into notification_policy_cycles (alert_id, user_id)
 				select alert_id, user_id from _step_cycles
 			), _step_channels as (
@@ -137,14 +381,16 @@ func NewDB(ctx context.Context, db *sql.DB, log alertlog.Store) (*DB, error) {
 				set
 					last_escalation = now(),
 					next_escalation = now() + (cast(esc.delay as text)||' minutes')::interval,
+					escalation_policy_step_number = esc.step_number,
 					escalation_policy_step_id = esc.ep_step_id,
+					step_target_index = 0,
 					force_escalation = false
 				from
 					to_escalate esc
 				where
 					state.alert_id = esc.alert_id
 			)
-			select distinct esc.alert_id, step isnull and chan isnull
+			select distinct esc.alert_id, esc.target_mode, step isnull and chan isnull
 			from to_escalate esc
 			left join _step_cycles step on step.alert_id = esc.alert_id
 			left join _step_channels chan on chan.alert_id = esc.alert_id
@@ -157,30 +403,32 @@ func NewDB(ctx context.Context, db *sql.DB, log alertlog.Store) (*DB, error) {
 					step.id ep_step_id,
 					step.step_number,
 					step.delay,
-					state.escalation_policy_step_number >= ep.step_count repeated,
+					step.target_mode,
+					step.step_number < state.escalation_policy_step_number repeated,
 					a.service_id,
 					step.escalation_policy_id
 				from escalation_policy_state state
 				join alerts a on a.id = state.alert_id and (a.status = 'triggered' or state.force_escalation)
-				join escalation_policies ep on ep.id = state.escalation_policy_id
-				join escalation_policy_steps step on
-					step.escalation_policy_id = state.escalation_policy_id and
-					step.step_number = CASE
-						WHEN state.escalation_policy_step_number >= ep.step_count THEN 0
-						ELSE state.escalation_policy_step_number
-						END
+				join lateral (
+					-- first matching step at or after the deleted one, wrapping around;
+					-- forced escalations fall back to the step at the same position if none match
+					select id, step_number, delay, target_mode, escalation_policy_id
+					from escalation_policy_steps step
+					where
+						step.escalation_policy_id = state.escalation_policy_id and
+						(state.force_escalation or fn_ep_step_matches(step.id, a.id))
+					order by
+						fn_ep_step_matches(step.id, a.id) desc,
+						step.step_number < state.escalation_policy_step_number,
+						step.step_number
+					limit 1
+				) step on true
 				where
 					state.last_escalation notnull and
 					escalation_policy_step_id isnull
 				for update skip locked
 				limit 100
-			), _step_cycles as (
-				select esc.alert_id, on_call.user_id, esc.ep_step_id
-				from to_escalate esc
-				join ep_step_on_call_users on_call on
-					on_call.end_time isnull and
-					on_call.ep_step_id = esc.ep_step_id
-			), _cycles as (
+			), ` + stepCyclesCTE + `, _cycles as (
 				insert into notification_policy_cycles (alert_id, user_id)
 				select alert_id, user_id
 				from _step_cycles
@@ -212,13 +460,14 @@ func NewDB(ctx context.Context, db *sql.DB, log alertlog.Store) (*DB, error) {
 					next_escalation = now() + (cast(esc.delay as text)||' minutes')::interval,
 					escalation_policy_step_number = esc.step_number,
 					escalation_policy_step_id = esc.ep_step_id,
+					step_target_index = 0,
 					force_escalation = false
 				from
 					to_escalate esc
 				where
 					state.alert_id = esc.alert_id
 			)
-			select distinct esc.alert_id, esc.repeated, esc.step_number, step isnull and chan isnull
+			select distinct esc.alert_id, esc.target_mode, esc.repeated, esc.step_number, step isnull and chan isnull
 			from to_escalate esc
 			left join _step_cycles step on step.alert_id = esc.alert_id
 			left join _step_channels chan on chan.alert_id = esc.alert_id
@@ -230,38 +479,53 @@ func NewDB(ctx context.Context, db *sql.DB, log alertlog.Store) (*DB, error) {
 					nextStep.id ep_step_id,
 					nextStep.delay,
 					nextStep.step_number,
+					nextStep.target_mode,
 					force_escalation forced,
 					oldStep.delay old_delay,
-					oldStep.step_number + 1 >= ep.step_count repeated,
+					next_escalation < last_escalation + (cast(oldStep.delay as text)||' minutes')::interval unreachable,
+					nextStep.step_number <= oldStep.step_number repeated,
 					nextStep.escalation_policy_id,
 					a.service_id
 				from escalation_policy_state state
 				join alerts a on a.id = state.alert_id and (a.status = 'triggered' or state.force_escalation)
 				join escalation_policies ep on ep.id = state.escalation_policy_id
 				join escalation_policy_steps oldStep on oldStep.id = escalation_policy_step_id
-				join escalation_policy_steps nextStep on
-					nextStep.escalation_policy_id = state.escalation_policy_id and
-					nextStep.step_number = CASE
-						WHEN oldStep.step_number + 1 < ep.step_count THEN
-							oldStep.step_number + 1
-						WHEN force_escalation OR ep.repeat = -1 THEN 0
-						WHEN state.loop_count < ep.repeat THEN 0
-						ELSE -1
-					END
+				join lateral (
+					-- next matching step, wrapping around if the policy may repeat;
+					-- forced escalations never stay on the current step (unless it is the
+					-- only one) and fall back to the next step by position if none match
+					select id, delay, step_number, target_mode, escalation_policy_id
+					from escalation_policy_steps step
+					where
+						step.escalation_policy_id = state.escalation_policy_id and
+						(
+							step.step_number > oldStep.step_number or
+							force_escalation or
+							ep.repeat = -1 or
+							state.loop_count < ep.repeat
+						) and
+						(force_escalation or fn_ep_step_matches(step.id, a.id)) and
+						(not force_escalation or step.id <> oldStep.id or ep.step_count = 1)
+					order by
+						fn_ep_step_matches(step.id, a.id) desc,
+						step.step_number <= oldStep.step_number,
+						step.step_number
+					limit 1
+				) nextStep on true
 				where
 					state.last_escalation notnull and
 					escalation_policy_step_id notnull and
-					(next_escalation < now() or force_escalation)
+					(next_escalation < now() or force_escalation) and
+					(
+						-- repeats wait for the policy backoff, increasing with each loop
+						nextStep.step_number > oldStep.step_number or
+						force_escalation or
+						next_escalation + (cast(least(ep.repeat_backoff_minutes * (state.loop_count + 1), 1440) as text)||' minutes')::interval < now()
+					)
 				order by next_escalation - now()
 				for update skip locked
 				limit 500
-			), _step_cycles as (
-				select esc.alert_id, on_call.user_id, esc.ep_step_id
-				from to_escalate esc
-				join ep_step_on_call_users on_call on
-					on_call.end_time isnull and
-					on_call.ep_step_id = esc.ep_step_id
-			), _cycles as (
+			), ` + stepCyclesCTE + `, _cycles as (
 				insert into notification_policy_cycles (alert_id, user_id)
 				select alert_id, user_id
 				from _step_cycles
@@ -293,6 +557,7 @@ func NewDB(ctx context.Context, db *sql.DB, log alertlog.Store) (*DB, error) {
 					next_escalation = now() + (cast(esc.delay as text)||' minutes')::interval,
 					escalation_policy_step_number = esc.step_number,
 					escalation_policy_step_id = esc.ep_step_id,
+					step_target_index = 0,
 					loop_count = CASE WHEN esc.repeated THEN loop_count + 1 ELSE loop_count END,
 					force_escalation = false
 				from
@@ -300,10 +565,105 @@ func NewDB(ctx context.Context, db *sql.DB, log alertlog.Store) (*DB, error) {
 				where
 					state.alert_id = esc.alert_id
 			)
-			select distinct esc.alert_id, esc.repeated, esc.step_number, esc.old_delay, esc.forced, step isnull and chan isnull
+			select distinct esc.alert_id, esc.target_mode, esc.repeated, esc.step_number, esc.old_delay, esc.forced, esc.unreachable, step isnull and chan isnull
 			from to_escalate esc
 			left join _step_cycles step on step.alert_id = esc.alert_id
 			left join _step_channels chan on chan.alert_id = esc.alert_id
 		`),
+
+		fallback: p.P(`
+			with to_escalate as (
+				select
+					state.alert_id,
+					state.escalation_policy_step_number step_number,
+					ep.fallback_user_id,
+					ep.fallback_schedule_id,
+					ep.fallback_rotation_id
+				from escalation_policy_state state
+				join alerts a on a.id = state.alert_id and a.status = 'triggered'
+				join escalation_policies ep on
+					ep.id = state.escalation_policy_id and
+					num_nonnulls(ep.fallback_user_id, ep.fallback_schedule_id, ep.fallback_rotation_id) > 0
+				join escalation_policy_steps oldStep on oldStep.id = state.escalation_policy_step_id
+				where
+					not state.fallback_notified and
+					not state.force_escalation and
+					state.next_escalation < now() and
+					(ep.repeat = -1 or state.loop_count >= ep.repeat) and
+					not exists (
+						select 1
+						from escalation_policy_steps step
+						where
+							step.escalation_policy_id = state.escalation_policy_id and
+							step.step_number > oldStep.step_number and
+							fn_ep_step_matches(step.id, a.id)
+					)
+				for update skip locked
+				limit 500
+			), _fallback_users as (
+				select esc.alert_id, coalesce(esc.fallback_user_id, part.user_id, sched.user_id) user_id
+				from to_escalate esc
+				left join rotation_state rState on rState.rotation_id = esc.fallback_rotation_id
+				left join rotation_participants part on part.id = rState.rotation_participant_id
+				left join schedule_on_call_users sched on
+					sched.schedule_id = esc.fallback_schedule_id and
+					sched.end_time isnull
+				where coalesce(esc.fallback_user_id, part.user_id, sched.user_id) notnull
+			), _cycles as (
+				insert into notification_policy_cycles (alert_id, user_id)
+				select alert_id, user_id
+				from _fallback_users
+			), _update as (
+				update escalation_policy_state state
+				set fallback_notified = true
+				from to_escalate esc
+				where state.alert_id = esc.alert_id
+			)
+			select distinct esc.alert_id, esc.step_number, fb isnull
+			from to_escalate esc
+			left join _fallback_users fb on fb.alert_id = esc.alert_id
+		`),
+
+		sequentialEscalation: p.P(`
+			with to_escalate as (
+				select
+					state.alert_id,
+					step.id ep_step_id,
+					step.step_number,
+					state.step_target_index + 1 target_index
+				from escalation_policy_state state
+				join alerts a on a.id = state.alert_id and a.status = 'triggered'
+				join escalation_policy_steps step on
+					step.id = state.escalation_policy_step_id and
+					step.target_mode = 'sequential'
+				where
+					state.last_escalation notnull and
+					not state.force_escalation and
+					state.last_escalation + (cast((state.step_target_index + 1) * step.sequential_delay_minutes as text)||' minutes')::interval <= now() and
+					state.step_target_index + 1 < (
+						select count(*)
+						from ep_step_on_call_users on_call
+						where
+							on_call.ep_step_id = step.id and
+							on_call.end_time isnull
+					)
+				for update skip locked
+				limit 500
+			), ` + stepUsersCTE + `, _cycles as (
+				insert into notification_policy_cycles (alert_id, user_id)
+				select esc.alert_id, u.user_id
+				from to_escalate esc
+				join _step_users u on
+					u.ep_step_id = esc.ep_step_id and
+					u.pos = esc.target_index
+			), _update as (
+				update escalation_policy_state state
+				set step_target_index = esc.target_index
+				from to_escalate esc
+				where state.alert_id = esc.alert_id
+			)
+			select alert_id, step_number, target_index
+			from to_escalate
+		`),
 	}, p.Err
 }
@@ -5,8 +5,10 @@ import (
 	"database/sql"
 
 	alertlog "github.com/target/goalert/alert/log"
+	"github.com/target/goalert/config"
 	"github.com/target/goalert/permission"
 	"github.com/target/goalert/util/log"
+	"github.com/target/goalert/util/sqlutil"
 
 	"github.com/pkg/errors"
 )
@@ -48,10 +50,20 @@ func (db *DB) update(ctx context.Context, all bool, alertID *int) error {
 		return errors.Wrap(err, "end policies with no steps")
 	}
 
+	err = db.processAckTimeouts(ctx)
+	if err != nil {
+		return errors.Wrap(err, "re-trigger alerts after ack timeout")
+	}
+
+	err = db.processSnoozes(ctx)
+	if err != nil {
+		return errors.Wrap(err, "re-trigger snoozed alerts")
+	}
+
 	err = db.processEscalations(ctx, db.newPolicies, func(rows *sql.Rows) (int, *alertlog.EscalationMetaData, error) {
 		var id int
 		var meta alertlog.EscalationMetaData
-		err := rows.Scan(&id, &meta.NoOneOnCall)
+		err := rows.Scan(&id, &meta.TargetMode, &meta.NoOneOnCall)
 		return id, &meta, err
 	})
 	if err != nil {
@@ -61,34 +73,174 @@ func (db *DB) update(ctx context.Context, all bool, alertID *int) error {
 	err = db.processEscalations(ctx, db.deletedSteps, func(rows *sql.Rows) (int, *alertlog.EscalationMetaData, error) {
 		var id int
 		var meta alertlog.EscalationMetaData
-		err := rows.Scan(&id, &meta.Repeat, &meta.NewStepIndex, &meta.NoOneOnCall)
+		err := rows.Scan(&id, &meta.TargetMode, &meta.Repeat, &meta.NewStepIndex, &meta.NoOneOnCall)
 		return id, &meta, err
 	})
 	if err != nil {
 		return errors.Wrap(err, "escalate policies with deleted steps")
 	}
 
+	// fallback is processed first, as policies that repeat until acknowledged will move on to the next loop
+	err = db.processEscalations(ctx, db.fallback, func(rows *sql.Rows) (int, *alertlog.EscalationMetaData, error) {
+		var id int
+		meta := alertlog.EscalationMetaData{Fallback: true}
+		err := rows.Scan(&id, &meta.NewStepIndex, &meta.NoOneOnCall)
+		return id, &meta, err
+	})
+	if err != nil {
+		return errors.Wrap(err, "notify policy fallback")
+	}
+
+	cfg := config.FromContext(ctx)
+	if cfg.SafetyNet.Enable {
+		_, err = db.lock.Exec(ctx, db.skipUnreachable)
+		if err != nil {
+			return errors.Wrap(err, "skip unreachable steps")
+		}
+	}
+
 	err = db.processEscalations(ctx, db.normalEscalation, func(rows *sql.Rows) (int, *alertlog.EscalationMetaData, error) {
 		var id int
 		var meta alertlog.EscalationMetaData
-		err := rows.Scan(&id, &meta.Repeat, &meta.NewStepIndex, &meta.OldDelayMinutes, &meta.Forced, &meta.NoOneOnCall)
+		err := rows.Scan(&id, &meta.TargetMode, &meta.Repeat, &meta.NewStepIndex, &meta.OldDelayMinutes, &meta.Forced, &meta.Unreachable, &meta.NoOneOnCall)
 		return id, &meta, err
 	})
 	if err != nil {
 		return errors.Wrap(err, "escalate forced or expired")
 	}
 
+	if cfg.SafetyNet.Enable && (cfg.SafetyNet.NotifyAdmins || cfg.SafetyNet.SlackChannelID != "") {
+		err = db.processEscalations(ctx, db.safetyNet, func(rows *sql.Rows) (int, *alertlog.EscalationMetaData, error) {
+			var id int
+			meta := alertlog.EscalationMetaData{SafetyNet: true}
+			err := rows.Scan(&id, &meta.NewStepIndex, &meta.NoOneOnCall)
+			return id, &meta, err
+		}, cfg.SafetyNet.NotifyAdmins, cfg.SafetyNet.SlackChannelID)
+		if err != nil {
+			return errors.Wrap(err, "notify safety net")
+		}
+	}
+
+	err = db.processEscalations(ctx, db.sequentialEscalation, func(rows *sql.Rows) (int, *alertlog.EscalationMetaData, error) {
+		var id int
+		meta := alertlog.EscalationMetaData{TargetMode: "sequential"}
+		err := rows.Scan(&id, &meta.NewStepIndex, &meta.TargetIndex)
+		return id, &meta, err
+	})
+	if err != nil {
+		return errors.Wrap(err, "advance sequential steps")
+	}
+
 	return nil
 }
 
-func (db *DB) processEscalations(ctx context.Context, stmt *sql.Stmt, scan func(*sql.Rows) (int, *alertlog.EscalationMetaData, error)) error {
+// processAckTimeouts will re-trigger acknowledged alerts that have exceeded the service ack timeout,
+// resetting their escalation state so they are escalated as new.
+func (db *DB) processAckTimeouts(ctx context.Context) error {
 	tx, err := db.lock.BeginTx(ctx, nil)
 	if err != nil {
 		return err
 	}
 	defer tx.Rollback()
 
-	rows, err := tx.StmtContext(ctx, stmt).QueryContext(ctx)
+	rows, err := tx.StmtContext(ctx, db.ackTimeout).QueryContext(ctx)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	var alertIDs []int
+	batch := make(map[alertlog.AckTimeoutMetaData][]int)
+	for rows.Next() {
+		var id int
+		var meta alertlog.AckTimeoutMetaData
+		err = rows.Scan(&id, &meta.TimeoutMinutes, &meta.Count, &meta.Limit)
+		if err != nil {
+			return err
+		}
+		alertIDs = append(alertIDs, id)
+		batch[meta] = append(batch[meta], id)
+	}
+	if err = rows.Err(); err != nil {
+		return err
+	}
+	if len(alertIDs) == 0 {
+		return nil
+	}
+
+	_, err = tx.StmtContext(ctx, db.retrigger).ExecContext(ctx, sqlutil.IntArray(alertIDs))
+	if err != nil {
+		return errors.Wrap(err, "update alert status")
+	}
+
+	for meta, ids := range batch {
+		err = db.log.LogManyTx(ctx, tx, ids, alertlog.TypeAckTimeout, meta)
+		if err != nil {
+			return errors.Wrap(err, "log ack timeout")
+		}
+	}
+
+	return tx.Commit()
+}
+
+// processSnoozes will re-trigger snoozed alerts that have reached their snooze time,
+// notifying the current step again.
+func (db *DB) processSnoozes(ctx context.Context) error {
+	tx, err := db.lock.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	rows, err := tx.StmtContext(ctx, db.snoozeExpired).QueryContext(ctx)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	var alertIDs []int
+	for rows.Next() {
+		var id int
+		err = rows.Scan(&id)
+		if err != nil {
+			return err
+		}
+		alertIDs = append(alertIDs, id)
+	}
+	if err = rows.Err(); err != nil {
+		return err
+	}
+	if len(alertIDs) == 0 {
+		return nil
+	}
+
+	// alerts must be triggered first, as acknowledging clears the next escalation time
+	_, err = tx.StmtContext(ctx, db.retrigger).ExecContext(ctx, sqlutil.IntArray(alertIDs))
+	if err != nil {
+		return errors.Wrap(err, "update alert status")
+	}
+
+	_, err = tx.StmtContext(ctx, db.snoozeNotify).ExecContext(ctx, sqlutil.IntArray(alertIDs))
+	if err != nil {
+		return errors.Wrap(err, "notify current step")
+	}
+
+	err = db.log.LogManyTx(ctx, tx, alertIDs, alertlog.TypeSnoozeExpired, nil)
+	if err != nil {
+		return errors.Wrap(err, "log snooze expired")
+	}
+
+	return tx.Commit()
+}
+
+func (db *DB) processEscalations(ctx context.Context, stmt *sql.Stmt, scan func(*sql.Rows) (int, *alertlog.EscalationMetaData, error), args ...interface{}) error {
+	tx, err := db.lock.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	rows, err := tx.StmtContext(ctx, stmt).QueryContext(ctx, args...)
 	if err != nil {
 		return err
 	}
@@ -103,6 +255,9 @@ func (db *DB) processEscalations(ctx context.Context, stmt *sql.Stmt, scan func(
 		}
 		batch[*esc] = append(batch[*esc], id)
 	}
+	if err = rows.Err(); err != nil {
+		return err
+	}
 
 	for meta, ids := range batch {
 		err = db.log.LogManyTx(ctx, tx, ids, alertlog.TypeEscalated, meta)
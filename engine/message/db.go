@@ -72,7 +72,7 @@ type DB struct {
 func NewDB(ctx context.Context, db *sql.DB, a alertlog.Store, pausable lifecycle.Pausable) (*DB, error) {
 	lock, err := processinglock.NewLock(ctx, db, processinglock.Config{
 		Type:    processinglock.TypeMessage,
-		Version: 9,
+		Version: 10,
 	})
 	if err != nil {
 		return nil, err
@@ -332,7 +332,8 @@ func NewDB(ctx context.Context, db *sql.DB, a alertlog.Store, pausable lifecycle
 				msg.created_at,
 				msg.sent_at,
 				msg.status_alert_ids,
-				msg.schedule_id
+				msg.schedule_id,
+				msg.shift_time
 			from outgoing_messages msg
 			left join user_contact_methods cm on cm.id = msg.contact_method_id
 			left join notification_channels chan on chan.id = msg.channel_id
@@ -366,7 +367,7 @@ func (db *DB) currentQueue(ctx context.Context, tx *sql.Tx, now time.Time) (*que
 		var dstType notification.ScannableDestType
 		var alertID, logID sql.NullInt64
 		var statusAlertIDs sqlutil.IntArray
-		var createdAt, sentAt sql.NullTime
+		var createdAt, sentAt, shiftTime sql.NullTime
 		err = rows.Scan(
 			&msg.ID,
 			&msg.Type,
@@ -383,6 +384,7 @@ func (db *DB) currentQueue(ctx context.Context, tx *sql.Tx, now time.Time) (*que
 			&sentAt,
 			&statusAlertIDs,
 			&scheduleID,
+			&shiftTime,
 		)
 		if err != nil {
 			return nil, errors.Wrap(err, "scan row")
@@ -398,6 +400,7 @@ func (db *DB) currentQueue(ctx context.Context, tx *sql.Tx, now time.Time) (*que
 		msg.Dest.Value = destValue.String
 		msg.StatusAlertIDs = statusAlertIDs
 		msg.ScheduleID = scheduleID.String
+		msg.ShiftTime = shiftTime.Time
 
 		msg.Dest.Type = dstType.DestType()
 		if msg.Dest.Type == notification.DestTypeUnknown {
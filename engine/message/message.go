@@ -18,6 +18,7 @@ type Message struct {
 	UserID     string
 	ServiceID  string
 	ScheduleID string
+	ShiftTime  time.Time
 	CreatedAt  time.Time
 	SentAt     time.Time
 
@@ -14,6 +14,8 @@ var typePriority = map[notification.MessageType]int{
 	notification.MessageTypeTest:         2,
 
 	notification.MessageTypeScheduleOnCallUsers: 3,
+	notification.MessageTypeShiftStartReminder:  3,
+	notification.MessageTypeShiftEnded:          3,
 
 	// First alert will jump the list with priority 0, so this only
 	// represents additional alerts to the service after the first.
@@ -14,6 +14,8 @@ type DB struct {
 
 	overrides   *sql.Stmt
 	rules       *sql.Stmt
+	rotParts    *sql.Stmt
+	timeOff     *sql.Stmt
 	currentTime *sql.Stmt
 	getOnCall   *sql.Stmt
 	endOnCall   *sql.Stmt
@@ -24,6 +26,11 @@ type DB struct {
 	schedTZ *sql.Stmt
 
 	scheduleOnCallNotification *sql.Stmt
+
+	rotations          *sql.Stmt
+	shiftReminders     *sql.Stmt
+	shiftStartReminder *sql.Stmt
+	shiftEnded         *sql.Stmt
 }
 
 // Name returns the name of the module.
@@ -33,7 +40,7 @@ func (db *DB) Name() string { return "Engine.ScheduleManager" }
 func NewDB(ctx context.Context, db *sql.DB) (*DB, error) {
 	lock, err := processinglock.NewLock(ctx, db, processinglock.Config{
 		Type:    processinglock.TypeSchedule,
-		Version: 3,
+		Version: 5,
 	})
 	if err != nil {
 		return nil, err
@@ -49,7 +56,7 @@ func NewDB(ctx context.Context, db *sql.DB) (*DB, error) {
 				remove_user_id,
 				tgt_schedule_id
 			from user_overrides
-			where now() between start_time and end_time
+			where $1 between start_time and end_time
 		`),
 		data:       p.P(`select schedule_id, data from schedule_data where data notnull for update`),
 		updateData: p.P(`update schedule_data set data = $2 where schedule_id = $1`),
@@ -68,13 +75,25 @@ func NewDB(ctx context.Context, db *sql.DB) (*DB, error) {
 				],
 				start_time,
 				end_time,
-				coalesce(rule.tgt_user_id, part.user_id)
+				coalesce(rule.tgt_user_id, part.user_id),
+				rule.tgt_rotation_id,
+				coalesce(part.position, 0)
 			from schedule_rules rule
 			left join rotation_state rState on rState.rotation_id = rule.tgt_rotation_id
 			left join rotation_participants part on part.id = rState.rotation_participant_id
 			where
 				coalesce(rule.tgt_user_id, part.user_id) notnull
 		`),
+		rotParts: p.P(`
+			select rotation_id, user_id
+			from rotation_participants
+			order by rotation_id, position
+		`),
+		timeOff: p.P(`
+			select distinct user_id
+			from user_time_off
+			where $1 >= start_time and $1 < end_time
+		`),
 		getOnCall: p.P(`
 			select schedule_id, user_id
 			from schedule_on_call_users
@@ -96,6 +115,46 @@ func NewDB(ctx context.Context, db *sql.DB) (*DB, error) {
 		scheduleOnCallNotification: p.P(`
 			insert into outgoing_messages (id, message_type, channel_id, schedule_id) values ($1, 'schedule_on_call_notification', $2, $3)
 		`),
+		rotations: p.P(`
+			select
+				rot.id,
+				rot.type,
+				rot.start_time,
+				rot.shift_length,
+				rot.time_zone,
+				state.position,
+				state.shift_start
+			from rotations rot
+			join rotation_state state on state.rotation_id = rot.id
+		`),
+		shiftReminders: p.P(`
+			select
+				id,
+				user_id,
+				coalesce(shift_start_reminder_minutes, 0),
+				shift_end_notification
+			from user_contact_methods
+			where
+				not disabled and
+				(shift_start_reminder_minutes notnull or shift_end_notification)
+		`),
+		shiftStartReminder: p.P(`
+			insert into outgoing_messages (id, message_type, contact_method_id, user_id, schedule_id, shift_time)
+			select $1, 'shift_start_reminder', $2::uuid, $3, $4::uuid, $5
+			where not exists (
+				select 1
+				from outgoing_messages
+				where
+					message_type = 'shift_start_reminder' and
+					contact_method_id = $2 and
+					schedule_id = $4 and
+					shift_time > now()
+			)
+		`),
+		shiftEnded: p.P(`
+			insert into outgoing_messages (id, message_type, contact_method_id, user_id, schedule_id, shift_time)
+			values ($1, 'shift_end_notification', $2, $3, $4, now())
+		`),
 		currentTime: p.P(`select now()`),
 	}, p.Err
 }
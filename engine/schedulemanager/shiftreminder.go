@@ -0,0 +1,189 @@
+package schedulemanager
+
+import (
+	"context"
+	"database/sql"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/target/goalert/oncall"
+	"github.com/target/goalert/util"
+)
+
+// shiftReminder is the shift reminder configuration for a single contact method.
+type shiftReminder struct {
+	ContactMethodID string
+	UserID          string
+
+	// StartMinutes is the number of minutes before a shift starts to send a reminder, 0 if disabled.
+	StartMinutes int
+	NotifyEnd    bool
+}
+
+func (db *DB) loadShiftReminders(ctx context.Context, tx *sql.Tx) ([]shiftReminder, error) {
+	rows, err := tx.StmtContext(ctx, db.shiftReminders).QueryContext(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "get shift reminders")
+	}
+	defer rows.Close()
+
+	var result []shiftReminder
+	for rows.Next() {
+		var r shiftReminder
+		err = rows.Scan(&r.ContactMethodID, &r.UserID, &r.StartMinutes, &r.NotifyEnd)
+		if err != nil {
+			return nil, errors.Wrap(err, "scan shift reminder")
+		}
+		result = append(result, r)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "get shift reminders")
+	}
+
+	return result, nil
+}
+
+func (db *DB) loadRotations(ctx context.Context, tx *sql.Tx, rotParts map[string][]string) (map[string]*oncall.ResolvedRotation, error) {
+	rows, err := tx.StmtContext(ctx, db.rotations).QueryContext(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "get rotations")
+	}
+	defer rows.Close()
+
+	rots := make(map[string]*oncall.ResolvedRotation)
+	for rows.Next() {
+		var rot oncall.ResolvedRotation
+		var tzName string
+		err = rows.Scan(&rot.ID, &rot.Type, &rot.Start, &rot.ShiftLength, &tzName, &rot.CurrentIndex, &rot.CurrentStart)
+		if err != nil {
+			return nil, errors.Wrap(err, "scan rotation")
+		}
+		loc, err := util.LoadLocation(tzName)
+		if err != nil {
+			return nil, errors.Wrapf(err, "load TZ info '%s' for rotation '%s'", tzName, rot.ID)
+		}
+		rot.Start = rot.Start.In(loc)
+		rot.Users = rotParts[rot.ID]
+		rots[rot.ID] = &rot
+	}
+	if err = rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "get rotations")
+	}
+
+	return rots, nil
+}
+
+// rulesAt will return a copy of rules with rotation participants advanced to the given time.
+func rulesAt(t time.Time, rules []userRule, rots map[string]*oncall.ResolvedRotation) []userRule {
+	result := make([]userRule, 0, len(rules))
+	for _, r := range rules {
+		if !r.RotationID.Valid {
+			result = append(result, r)
+			continue
+		}
+
+		rot := rots[r.RotationID.String]
+		if rot == nil {
+			continue
+		}
+
+		// copy so the current state is not modified
+		tmp := *rot
+		r.UserID = tmp.UserID(t)
+		r.Position = tmp.CurrentIndex
+		if r.UserID == "" {
+			continue
+		}
+		result = append(result, r)
+	}
+
+	return result
+}
+
+// upcomingShifts will return schedule IDs for each user that will be on call at the future
+// time, but are not on call currently.
+func upcomingShifts(current, future map[onCall]bool) map[string][]string {
+	result := make(map[string][]string)
+	for oc := range future {
+		if current[oc] {
+			continue
+		}
+		result[oc.UserID] = append(result[oc.UserID], oc.ScheduleID)
+	}
+	for _, ids := range result {
+		sort.Strings(ids)
+	}
+
+	return result
+}
+
+// sendShiftReminders will queue shift start reminders and shift end notifications
+// to contact methods that have them enabled.
+func (db *DB) sendShiftReminders(ctx context.Context, tx *sql.Tx, now time.Time, calc onCallCalc, reminders []shiftReminder, oldOnCall, newOnCall map[onCall]bool) error {
+	if len(reminders) == 0 {
+		return nil
+	}
+
+	ended := make(map[string][]string)
+	for oc := range oldOnCall {
+		if newOnCall[oc] {
+			continue
+		}
+		ended[oc.UserID] = append(ended[oc.UserID], oc.ScheduleID)
+	}
+
+	byMinutes := make(map[int][]shiftReminder)
+	endStmt := tx.StmtContext(ctx, db.shiftEnded)
+	for _, r := range reminders {
+		if r.StartMinutes > 0 {
+			byMinutes[r.StartMinutes] = append(byMinutes[r.StartMinutes], r)
+		}
+		if !r.NotifyEnd {
+			continue
+		}
+		for _, schedID := range ended[r.UserID] {
+			_, err := endStmt.ExecContext(ctx, uuid.New(), r.ContactMethodID, r.UserID, schedID)
+			if err != nil {
+				return errors.Wrap(err, "queue shift end notification")
+			}
+		}
+	}
+	if len(byMinutes) == 0 {
+		return nil
+	}
+
+	rots, err := db.loadRotations(ctx, tx, calc.rotParts)
+	if err != nil {
+		return err
+	}
+
+	startStmt := tx.StmtContext(ctx, db.shiftStartReminder)
+	for mins, rems := range byMinutes {
+		t := now.Add(time.Duration(mins) * time.Minute)
+
+		future := calc
+		future.rules = rulesAt(t, calc.rules, rots)
+		future.overrides, err = db.overridesAt(ctx, tx, t)
+		if err != nil {
+			return err
+		}
+		future.unavailable, err = db.unavailableAt(ctx, tx, t)
+		if err != nil {
+			return err
+		}
+
+		upcoming := upcomingShifts(newOnCall, future.onCallAt(t))
+		for _, r := range rems {
+			for _, schedID := range upcoming[r.UserID] {
+				_, err = startStmt.ExecContext(ctx, uuid.New(), r.ContactMethodID, r.UserID, schedID, t)
+				if err != nil {
+					return errors.Wrap(err, "queue shift start reminder")
+				}
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,65 @@
+package schedulemanager
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/target/goalert/oncall"
+	"github.com/target/goalert/schedule/rotation"
+	"github.com/target/goalert/schedule/rule"
+)
+
+func TestRulesAt(t *testing.T) {
+	start := time.Date(2021, 9, 1, 0, 0, 0, 0, time.UTC)
+	rots := map[string]*oncall.ResolvedRotation{
+		"rot": {
+			Rotation: rotation.Rotation{
+				ID:          "rot",
+				Type:        rotation.TypeHourly,
+				Start:       start,
+				ShiftLength: 1,
+			},
+			CurrentIndex: 0,
+			CurrentStart: start,
+			Users:        []string{"a", "b", "c"},
+		},
+	}
+	rules := []userRule{
+		{Rule: rule.Rule{ScheduleID: "s1"}, UserID: "d"},
+		{Rule: rule.Rule{ScheduleID: "s2"}, UserID: "a", RotationID: sql.NullString{Valid: true, String: "rot"}},
+		{Rule: rule.Rule{ScheduleID: "s3"}, UserID: "x", RotationID: sql.NullString{Valid: true, String: "missing"}},
+	}
+
+	res := rulesAt(start.Add(90*time.Minute), rules, rots)
+	assert.Len(t, res, 2)
+	assert.Equal(t, "d", res[0].UserID)
+	assert.Equal(t, "b", res[1].UserID)
+	assert.Equal(t, 1, res[1].Position)
+
+	// original state should be unchanged
+	assert.Equal(t, 0, rots["rot"].CurrentIndex)
+	assert.Equal(t, "a", rules[1].UserID)
+
+	res = rulesAt(start.Add(4*time.Hour), rules, rots)
+	assert.Equal(t, "b", res[1].UserID)
+}
+
+func TestUpcomingShifts(t *testing.T) {
+	current := map[onCall]bool{
+		{UserID: "a", ScheduleID: "s1"}: true,
+		{UserID: "b", ScheduleID: "s1"}: true,
+	}
+	future := map[onCall]bool{
+		{UserID: "a", ScheduleID: "s1"}: true,
+		{UserID: "a", ScheduleID: "s2"}: true,
+		{UserID: "c", ScheduleID: "s2"}: true,
+		{UserID: "c", ScheduleID: "s1"}: true,
+	}
+
+	assert.Equal(t, map[string][]string{
+		"a": {"s2"},
+		"c": {"s1", "s2"},
+	}, upcomingShifts(current, future))
+}
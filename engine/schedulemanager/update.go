@@ -69,29 +69,13 @@ func (db *DB) update(ctx context.Context) error {
 		}
 		scheduleData[id] = &sData
 	}
-
-	rows, err = tx.Stmt(db.overrides).QueryContext(ctx)
-	if err != nil {
-		return errors.Wrap(err, "get active overrides")
+	if err = rows.Err(); err != nil {
+		return errors.Wrap(err, "get schedule data")
 	}
-	defer rows.Close()
 
-	var overrides []override.UserOverride
-	for rows.Next() {
-		var o override.UserOverride
-		var schedTgt sql.NullString
-		var add, rem sql.NullString
-		err = rows.Scan(&add, &rem, &schedTgt)
-		if err != nil {
-			return errors.Wrap(err, "scan override")
-		}
-		o.AddUserID = add.String
-		o.RemoveUserID = rem.String
-		if !schedTgt.Valid {
-			continue
-		}
-		o.Target = assignment.ScheduleTarget(schedTgt.String)
-		overrides = append(overrides, o)
+	overrides, err := db.overridesAt(ctx, tx, now)
+	if err != nil {
+		return err
 	}
 
 	rows, err = tx.Stmt(db.rules).QueryContext(ctx)
@@ -100,11 +84,6 @@ func (db *DB) update(ctx context.Context) error {
 	}
 	defer rows.Close()
 
-	type userRule struct {
-		rule.Rule
-		UserID string
-	}
-
 	var rules []userRule
 	for rows.Next() {
 		var r userRule
@@ -114,6 +93,8 @@ func (db *DB) update(ctx context.Context) error {
 			&r.Start,
 			&r.End,
 			&r.UserID,
+			&r.RotationID,
+			&r.Position,
 		)
 		if err != nil {
 			return errors.Wrap(err, "scan rule")
@@ -121,6 +102,39 @@ func (db *DB) update(ctx context.Context) error {
 
 		rules = append(rules, r)
 	}
+	if err = rows.Err(); err != nil {
+		return errors.Wrap(err, "get rules")
+	}
+
+	unavailable, err := db.unavailableAt(ctx, tx, now)
+	if err != nil {
+		return err
+	}
+
+	reminders, err := db.loadShiftReminders(ctx, tx)
+	if err != nil {
+		return err
+	}
+
+	rotParts := make(map[string][]string)
+	if len(unavailable) > 0 || len(reminders) > 0 {
+		rows, err = tx.StmtContext(ctx, db.rotParts).QueryContext(ctx)
+		if err != nil {
+			return errors.Wrap(err, "get rotation participants")
+		}
+		defer rows.Close()
+		for rows.Next() {
+			var rotID, userID string
+			err = rows.Scan(&rotID, &userID)
+			if err != nil {
+				return errors.Wrap(err, "scan rotation participant")
+			}
+			rotParts[rotID] = append(rotParts[rotID], userID)
+		}
+		if err = rows.Err(); err != nil {
+			return errors.Wrap(err, "get rotation participants")
+		}
+	}
 
 	rows, err = tx.StmtContext(ctx, db.schedTZ).QueryContext(ctx)
 	if err != nil {
@@ -139,6 +153,9 @@ func (db *DB) update(ctx context.Context) error {
 			return fmt.Errorf("load TZ info '%s' for schedule '%s': %w", tzName, id, err)
 		}
 	}
+	if err = rows.Err(); err != nil {
+		return fmt.Errorf("fetch schedule TZ info: %w", err)
+	}
 
 	rows, err = tx.Stmt(db.getOnCall).QueryContext(ctx)
 	if err != nil {
@@ -146,10 +163,6 @@ func (db *DB) update(ctx context.Context) error {
 	}
 	defer rows.Close()
 
-	type onCall struct {
-		UserID     string
-		ScheduleID string
-	}
 	oldOnCall := make(map[onCall]bool)
 	var oc onCall
 	for rows.Next() {
@@ -159,55 +172,20 @@ func (db *DB) update(ctx context.Context) error {
 		}
 		oldOnCall[oc] = true
 	}
-
-	// Calculate new state
-	newOnCall := make(map[onCall]bool, len(rules))
-
-	tempSched := make(map[string]struct{})
-	for id, data := range scheduleData {
-		ok, users := data.TempOnCall(now)
-		if !ok {
-			continue
-		}
-
-		for _, uid := range users {
-			newOnCall[onCall{ScheduleID: id, UserID: uid}] = true
-		}
-		tempSched[id] = struct{}{}
-	}
-
-	for _, r := range rules {
-		if _, ok := tempSched[r.ScheduleID]; ok {
-			// temp schedule active for this ID, skip
-			continue
-		}
-		if r.IsActive(now.In(tz[r.ScheduleID])) {
-			newOnCall[onCall{ScheduleID: r.ScheduleID, UserID: r.UserID}] = true
-		}
+	if err = rows.Err(); err != nil {
+		return errors.Wrap(err, "get on call")
 	}
 
-	for _, o := range overrides {
-		if _, ok := tempSched[o.Target.TargetID()]; ok {
-			// temp schedule active for this ID, skip
-			continue
-		}
-		if o.AddUserID != "" && o.RemoveUserID == "" {
-			// ADD override
-			newOnCall[onCall{ScheduleID: o.Target.TargetID(), UserID: o.AddUserID}] = true
-			continue
-		}
-		if o.AddUserID == "" && o.RemoveUserID != "" {
-			// REMOVE override
-			delete(newOnCall, onCall{ScheduleID: o.Target.TargetID(), UserID: o.RemoveUserID})
-			continue
-		}
-
-		if newOnCall[onCall{ScheduleID: o.Target.TargetID(), UserID: o.RemoveUserID}] {
-			// REPLACE override
-			delete(newOnCall, onCall{ScheduleID: o.Target.TargetID(), UserID: o.RemoveUserID})
-			newOnCall[onCall{ScheduleID: o.Target.TargetID(), UserID: o.AddUserID}] = true
-		}
+	// Calculate new state
+	calc := onCallCalc{
+		scheduleData: scheduleData,
+		tz:           tz,
+		rules:        rules,
+		overrides:    overrides,
+		unavailable:  unavailable,
+		rotParts:     rotParts,
 	}
+	newOnCall := calc.onCallAt(now)
 
 	start := tx.Stmt(db.startOnCall)
 
@@ -234,6 +212,11 @@ func (db *DB) update(ctx context.Context) error {
 		}
 	}
 
+	err = db.sendShiftReminders(ctx, tx, now, calc, reminders, oldOnCall, newOnCall)
+	if err != nil {
+		return err
+	}
+
 	// Notify changed schedules
 	needsOnCallNotification := make(map[string][]uuid.UUID)
 	for schedID := range changedSchedules {
@@ -296,6 +279,157 @@ func (db *DB) update(ctx context.Context) error {
 	return tx.Commit()
 }
 
+type userRule struct {
+	rule.Rule
+	UserID     string
+	RotationID sql.NullString
+	Position   int
+}
+
+type onCall struct {
+	UserID     string
+	ScheduleID string
+}
+
+// onCallCalc holds the information needed to calculate on-call users for all schedules.
+type onCallCalc struct {
+	scheduleData map[string]*schedule.Data
+	tz           map[string]*time.Location
+	rules        []userRule
+	overrides    []override.UserOverride
+	unavailable  map[string]bool
+	rotParts     map[string][]string
+}
+
+// onCallAt will return the set of on-call users for each schedule at the given time.
+func (c onCallCalc) onCallAt(t time.Time) map[onCall]bool {
+	result := make(map[onCall]bool, len(c.rules))
+
+	tempSched := make(map[string]struct{})
+	for id, data := range c.scheduleData {
+		ok, users := data.TempOnCall(t)
+		if !ok {
+			continue
+		}
+
+		for _, uid := range users {
+			result[onCall{ScheduleID: id, UserID: uid}] = true
+		}
+		tempSched[id] = struct{}{}
+	}
+
+	for _, r := range c.rules {
+		if _, ok := tempSched[r.ScheduleID]; ok {
+			// temp schedule active for this ID, skip
+			continue
+		}
+		if !r.IsActive(t.In(c.tz[r.ScheduleID])) {
+			continue
+		}
+		userID := r.UserID
+		if r.RotationID.Valid && c.unavailable[userID] {
+			// skip to the next available participant
+			userID = nextAvailable(c.rotParts[r.RotationID.String], r.Position, c.unavailable)
+			if userID == "" {
+				continue
+			}
+		}
+		result[onCall{ScheduleID: r.ScheduleID, UserID: userID}] = true
+	}
+
+	for _, o := range c.overrides {
+		if _, ok := tempSched[o.Target.TargetID()]; ok {
+			// temp schedule active for this ID, skip
+			continue
+		}
+		if o.AddUserID != "" && o.RemoveUserID == "" {
+			// ADD override
+			result[onCall{ScheduleID: o.Target.TargetID(), UserID: o.AddUserID}] = true
+			continue
+		}
+		if o.AddUserID == "" && o.RemoveUserID != "" {
+			// REMOVE override
+			delete(result, onCall{ScheduleID: o.Target.TargetID(), UserID: o.RemoveUserID})
+			continue
+		}
+
+		if result[onCall{ScheduleID: o.Target.TargetID(), UserID: o.RemoveUserID}] {
+			// REPLACE override
+			delete(result, onCall{ScheduleID: o.Target.TargetID(), UserID: o.RemoveUserID})
+			result[onCall{ScheduleID: o.Target.TargetID(), UserID: o.AddUserID}] = true
+		}
+	}
+
+	return result
+}
+
+func (db *DB) overridesAt(ctx context.Context, tx *sql.Tx, t time.Time) ([]override.UserOverride, error) {
+	rows, err := tx.StmtContext(ctx, db.overrides).QueryContext(ctx, t)
+	if err != nil {
+		return nil, errors.Wrap(err, "get active overrides")
+	}
+	defer rows.Close()
+
+	var overrides []override.UserOverride
+	for rows.Next() {
+		var o override.UserOverride
+		var schedTgt sql.NullString
+		var add, rem sql.NullString
+		err = rows.Scan(&add, &rem, &schedTgt)
+		if err != nil {
+			return nil, errors.Wrap(err, "scan override")
+		}
+		o.AddUserID = add.String
+		o.RemoveUserID = rem.String
+		if !schedTgt.Valid {
+			continue
+		}
+		o.Target = assignment.ScheduleTarget(schedTgt.String)
+		overrides = append(overrides, o)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "get active overrides")
+	}
+
+	return overrides, nil
+}
+
+func (db *DB) unavailableAt(ctx context.Context, tx *sql.Tx, t time.Time) (map[string]bool, error) {
+	rows, err := tx.StmtContext(ctx, db.timeOff).QueryContext(ctx, t)
+	if err != nil {
+		return nil, errors.Wrap(err, "get active time off")
+	}
+	defer rows.Close()
+
+	unavailable := make(map[string]bool)
+	for rows.Next() {
+		var userID string
+		err = rows.Scan(&userID)
+		if err != nil {
+			return nil, errors.Wrap(err, "scan time off")
+		}
+		unavailable[userID] = true
+	}
+	if err = rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "get active time off")
+	}
+
+	return unavailable, nil
+}
+
+// nextAvailable will return the first participant after the one at pos that is not unavailable,
+// or an empty string if there are none.
+func nextAvailable(participants []string, pos int, unavailable map[string]bool) string {
+	for i := 1; i < len(participants); i++ {
+		userID := participants[(pos+i)%len(participants)]
+		if !unavailable[userID] {
+			return userID
+		}
+	}
+
+	return ""
+}
+
 func equalTimePtr(a, b *time.Time) bool {
 	if (a == nil) != (b == nil) {
 		return false
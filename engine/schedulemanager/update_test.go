@@ -64,3 +64,12 @@ func TestNextOnCallNotification(t *testing.T) {
 	check("now", &clock, &filter, time.Date(2021, 7, 14, 11, 0, 0, 0, time.UTC))
 
 }
+
+func TestNextAvailable(t *testing.T) {
+	parts := []string{"a", "b", "c", "a"}
+
+	assert.Equal(t, "c", nextAvailable(parts, 0, map[string]bool{"a": true, "b": true}))
+	assert.Equal(t, "b", nextAvailable(parts, 3, map[string]bool{"a": true}))
+	assert.Equal(t, "", nextAvailable(parts, 1, map[string]bool{"a": true, "b": true, "c": true}))
+	assert.Equal(t, "", nextAvailable([]string{"a"}, 0, map[string]bool{"a": true}))
+}
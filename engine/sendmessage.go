@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"time"
 
 	"github.com/pkg/errors"
 	alertlog "github.com/target/goalert/alert/log"
@@ -165,6 +166,26 @@ func (p *Engine) sendMessage(ctx context.Context, msg *message.Message) (*notifi
 			ScheduleID:   msg.ScheduleID,
 			Users:        onCallUsers,
 		}
+	case notification.MessageTypeShiftStartReminder, notification.MessageTypeShiftEnded:
+		sched, err := p.cfg.ScheduleStore.FindOne(ctx, msg.ScheduleID)
+		if err != nil {
+			return nil, errors.Wrap(err, "lookup schedule by id")
+		}
+
+		var startsIn time.Duration
+		if msg.Type == notification.MessageTypeShiftStartReminder {
+			startsIn = time.Until(msg.ShiftTime)
+		}
+
+		notifMsg = notification.ShiftReminder{
+			Dest:         msg.Dest,
+			CallbackID:   msg.ID,
+			ScheduleID:   msg.ScheduleID,
+			ScheduleName: sched.Name,
+			ScheduleURL:  p.cfg.ConfigSource.Config().CallbackURL("/schedules/" + msg.ScheduleID),
+			Ended:        msg.Type == notification.MessageTypeShiftEnded,
+			StartsIn:     startsIn,
+		}
 	default:
 		log.Log(ctx, errors.New("SEND NOT IMPLEMENTED FOR MESSAGE TYPE"))
 		return &notification.SendResult{ID: msg.ID, Status: notification.Status{State: notification.StateFailedPerm}}, nil
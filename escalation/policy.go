@@ -1,14 +1,34 @@
 package escalation
 
 import (
+	"database/sql"
+
+	"github.com/target/goalert/assignment"
 	"github.com/target/goalert/validation/validate"
 )
 
+// RepeatUntilAcknowledged can be used as a policy Repeat value to repeat
+// the policy until the alert is acknowledged.
+const RepeatUntilAcknowledged = -1
+
 type Policy struct {
-	ID             string `json:"id"`
-	Name           string `json:"name"`
-	Description    string `json:"description"`
-	Repeat         int    `json:"repeat"`
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Repeat      int    `json:"repeat"`
+
+	// RepeatBackoffMinutes is added to the wait before each repeat of the policy,
+	// multiplied by the number of times it has repeated (up to a day).
+	RepeatBackoffMinutes int `json:"repeat_backoff_minutes"`
+
+	// Fallback, if set, is notified once after the final step of the last
+	// repeat expires, or after the first loop when repeating until acknowledged.
+	Fallback assignment.Target `json:"-"`
+
+	// TeamID is the team that owns the policy, or empty if it is not owned by a team.
+	// Only members of the owning team may modify an owned policy or its steps.
+	TeamID string `json:"team_id,omitempty"`
+
 	isUserFavorite bool
 }
 
@@ -16,8 +36,22 @@ func (p Policy) Normalize() (*Policy, error) {
 	err := validate.Many(
 		validate.IDName("Name", p.Name),
 		validate.Text("Description", p.Description, 1, 255),
-		validate.Range("Repeat", p.Repeat, 0, 5),
+		validate.Range("Repeat", p.Repeat, RepeatUntilAcknowledged, 5),
+		validate.Range("RepeatBackoffMinutes", p.RepeatBackoffMinutes, 0, 1440),
 	)
+	if p.Fallback != nil {
+		err = validate.Many(err,
+			validate.OneOf("Fallback.Type", p.Fallback.TargetType(),
+				assignment.TargetTypeUser,
+				assignment.TargetTypeSchedule,
+				assignment.TargetTypeRotation,
+			),
+			validate.UUID("Fallback.ID", p.Fallback.TargetID()),
+		)
+	}
+	if p.TeamID != "" {
+		err = validate.Many(err, validate.UUID("TeamID", p.TeamID))
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -29,3 +63,7 @@ func (p Policy) Normalize() (*Policy, error) {
 func (p Policy) IsUserFavorite() bool {
 	return p.isUserFavorite
 }
+
+func (p Policy) teamID() sql.NullString {
+	return sql.NullString{String: p.TeamID, Valid: p.TeamID != ""}
+}
@@ -2,6 +2,8 @@ package escalation
 
 import (
 	"testing"
+
+	"github.com/target/goalert/assignment"
 )
 
 func TestPolicy_Normalize(t *testing.T) {
@@ -23,9 +25,13 @@ func TestPolicy_Normalize(t *testing.T) {
 
 	valid := []Policy{
 		{Name: "SampleEscPolicy", Description: "Sample Escalation Policy", Repeat: 1},
+		{Name: "SampleEscPolicy", Description: "Sample Escalation Policy", Repeat: RepeatUntilAcknowledged, RepeatBackoffMinutes: 30},
+		{Name: "SampleEscPolicy", Description: "Sample Escalation Policy", Fallback: assignment.UserTarget("a81facc0-4764-012d-7bfb-002500d5d678")},
 	}
 	invalid := []Policy{
 		{Name: "SampleEscPolicy", Description: "Sample Escalation Policy", Repeat: -5},
+		{Name: "SampleEscPolicy", Description: "Sample Escalation Policy", RepeatBackoffMinutes: 1441},
+		{Name: "SampleEscPolicy", Description: "Sample Escalation Policy", Fallback: assignment.NotificationChannelTarget("a81facc0-4764-012d-7bfb-002500d5d678")},
 	}
 	for _, p := range valid {
 		test(true, p)
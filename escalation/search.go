@@ -45,6 +45,11 @@ var searchTemplate = template.Must(template.New("search").Funcs(search.Helpers()
 		pol.name,
 		pol.description,
 		pol.repeat,
+		pol.repeat_backoff_minutes,
+		pol.fallback_user_id,
+		pol.fallback_schedule_id,
+		pol.fallback_rotation_id,
+		coalesce(pol.team_id::text, ''),
 		fav IS DISTINCT FROM NULL
 	FROM escalation_policies pol
 	{{if not .FavoritesOnly }}
@@ -148,11 +153,13 @@ func (s *Store) Search(ctx context.Context, opts *SearchOptions) ([]Policy, erro
 
 	var result []Policy
 	var p Policy
+	var fb fallbackColumns
 	for rows.Next() {
-		err = rows.Scan(&p.ID, &p.Name, &p.Description, &p.Repeat, &p.isUserFavorite)
+		err = rows.Scan(&p.ID, &p.Name, &p.Description, &p.Repeat, &p.RepeatBackoffMinutes, &fb.usr, &fb.sched, &fb.rot, &p.TeamID, &p.isUserFavorite)
 		if err != nil {
 			return nil, err
 		}
+		p.Fallback = fb.target()
 		result = append(result, p)
 	}
 
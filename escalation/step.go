@@ -2,6 +2,7 @@ package escalation
 
 import (
 	"github.com/target/goalert/assignment"
+	"github.com/target/goalert/validation"
 	"github.com/target/goalert/validation/validate"
 	"time"
 )
@@ -16,6 +17,16 @@ type ActiveStep struct {
 	StepNumber      int
 }
 
+// StepTargetMode determines which of a step's on-call users are notified.
+type StepTargetMode string
+
+// Step target modes
+const (
+	StepTargetModeAll        StepTargetMode = "all"         // notify all users at once
+	StepTargetModeRoundRobin StepTargetMode = "round_robin" // notify one user per alert, rotating between alerts
+	StepTargetModeSequential StepTargetMode = "sequential"  // notify one user at a time, until the step escalates or the alert is acknowledged
+)
+
 type Step struct {
 	ID           string `json:"id"`
 	PolicyID     string `json:"escalation_policy_id"`
@@ -23,19 +34,48 @@ type Step struct {
 	StepNumber   int    `json:"step_number"`
 
 	Targets []assignment.Target
+
+	// Condition, if set, restricts which alerts the step applies to.
+	Condition *StepCondition
+
+	// TargetMode determines which on-call users are notified, defaults to StepTargetModeAll.
+	TargetMode StepTargetMode
+
+	// SequentialDelayMinutes is the time between notifying each user with StepTargetModeSequential,
+	// and must be less than DelayMinutes.
+	SequentialDelayMinutes int
 }
 
 func (s Step) Delay() time.Duration {
 	return time.Duration(s.DelayMinutes) * time.Minute
 }
 func (s Step) Normalize() (*Step, error) {
+	if s.TargetMode == "" {
+		s.TargetMode = StepTargetModeAll
+	}
+	if s.SequentialDelayMinutes == 0 {
+		s.SequentialDelayMinutes = 1
+	}
 	err := validate.Many(
 		validate.UUID("PolicyID", s.PolicyID),
 		validate.Range("DelayMinutes", s.DelayMinutes, 1, 9000),
+		validate.OneOf("TargetMode", s.TargetMode, StepTargetModeAll, StepTargetModeRoundRobin, StepTargetModeSequential),
+		validate.Range("SequentialDelayMinutes", s.SequentialDelayMinutes, 1, 60),
 	)
+	if err == nil && s.TargetMode == StepTargetModeSequential && s.SequentialDelayMinutes >= s.DelayMinutes {
+		// otherwise the step would escalate before notifying the next user
+		err = validation.NewFieldError("SequentialDelayMinutes", "must be less than the step delay")
+	}
 	if err != nil {
 		return nil, err
 	}
 
+	if s.Condition != nil {
+		s.Condition, err = s.Condition.Normalize()
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return &s, nil
 }
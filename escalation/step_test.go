@@ -23,10 +23,16 @@ func TestStep_Normalize(t *testing.T) {
 
 	valid := []Step{
 		{PolicyID: "a81facc0-4764-012d-7bfb-002500d5d678", DelayMinutes: 1},
+		{PolicyID: "a81facc0-4764-012d-7bfb-002500d5d678", DelayMinutes: 1, TargetMode: StepTargetModeRoundRobin},
+		{PolicyID: "a81facc0-4764-012d-7bfb-002500d5d678", DelayMinutes: 5, TargetMode: StepTargetModeSequential, SequentialDelayMinutes: 2},
 	}
 
 	invalid := []Step{
 		{PolicyID: "a81facc0-4764-012d-7bfb-002500d5d678", DelayMinutes: 9001},
+		{PolicyID: "a81facc0-4764-012d-7bfb-002500d5d678", DelayMinutes: 1, TargetMode: "random"},
+		{PolicyID: "a81facc0-4764-012d-7bfb-002500d5d678", DelayMinutes: 1, TargetMode: StepTargetModeSequential, SequentialDelayMinutes: 61},
+		{PolicyID: "a81facc0-4764-012d-7bfb-002500d5d678", DelayMinutes: 5, TargetMode: StepTargetModeSequential, SequentialDelayMinutes: 5},
+		{PolicyID: "a81facc0-4764-012d-7bfb-002500d5d678", DelayMinutes: 1, TargetMode: StepTargetModeSequential},
 	}
 	for _, s := range valid {
 		test(true, s)
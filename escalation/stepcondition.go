@@ -0,0 +1,119 @@
+package escalation
+
+import (
+	"regexp"
+	"time"
+
+	"github.com/target/goalert/alert"
+	"github.com/target/goalert/util/timeutil"
+	"github.com/target/goalert/validation"
+	"github.com/target/goalert/validation/validate"
+)
+
+// StepCondition restricts which alerts an escalation step applies to.
+//
+// Steps with conditions that do not match an alert are skipped during escalation.
+type StepCondition struct {
+	// Source, if set, requires alerts to originate from the given source.
+	Source alert.Source
+
+	// SummaryRegex, if set, requires the alert summary to match the regular expression.
+	// It is evaluated by both Go and Postgres, so only syntax accepted by validate.SQLRegexp is allowed.
+	SummaryRegex string
+
+	// Hours, if set, restricts the step to a time-of-day window.
+	Hours *StepHours
+}
+
+// StepHours is a recurring time-of-day window, like business hours.
+type StepHours struct {
+	TimeZone *time.Location
+
+	// Start and End are the local clock times of the window. If End is
+	// not after Start, the window ends the following day.
+	Start timeutil.Clock
+	End   timeutil.Clock
+
+	// Weekdays are the days the window starts on.
+	Weekdays timeutil.WeekdayFilter
+
+	// Outside, if true, applies the step outside of the window (e.g., after-hours)
+	// instead of within it.
+	Outside bool
+}
+
+// Normalize will validate the condition, returning nil if it matches all alerts.
+func (c StepCondition) Normalize() (*StepCondition, error) {
+	var err error
+	if c.Source != "" {
+		err = validate.OneOf("Condition.Source", c.Source,
+			alert.SourceEmail,
+			alert.SourceGrafana,
+			alert.SourceSite24x7,
+			alert.SourcePrometheusAlertmanager,
+			alert.SourceManual,
+			alert.SourceGeneric,
+		)
+	}
+	if c.SummaryRegex != "" {
+		err = validate.Many(err,
+			validate.Text("Condition.SummaryRegex", c.SummaryRegex, 1, 255),
+			validate.SQLRegexp("Condition.SummaryRegex", c.SummaryRegex),
+		)
+	}
+	if c.Hours != nil {
+		if c.Hours.TimeZone == nil {
+			err = validate.Many(err, validation.NewFieldError("Condition.Hours.TimeZone", "must be specified"))
+		}
+		if c.Hours.Weekdays.IsNever() {
+			err = validate.Many(err, validation.NewFieldError("Condition.Hours.Weekdays", "must include at least one day"))
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if c.Source == "" && c.SummaryRegex == "" && c.Hours == nil {
+		return nil, nil
+	}
+
+	return &c, nil
+}
+
+// Matches will return true if the condition applies to an alert with the
+// given source and summary at time t.
+func (c *StepCondition) Matches(src alert.Source, summary string, t time.Time) bool {
+	if c == nil {
+		return true
+	}
+	if c.Source != "" && c.Source != src {
+		return false
+	}
+	if c.SummaryRegex != "" {
+		// `.` matches newlines in Postgres
+		re, err := regexp.Compile("(?s)" + c.SummaryRegex)
+		if err != nil || !re.MatchString(summary) {
+			return false
+		}
+	}
+	if c.Hours != nil && c.Hours.Contains(t) == c.Hours.Outside {
+		return false
+	}
+
+	return true
+}
+
+// Contains will return true if t is within the window.
+func (h StepHours) Contains(t time.Time) bool {
+	t = t.In(h.TimeZone)
+	clock := timeutil.NewClockFromTime(t)
+	day := t.Weekday()
+
+	if h.Start < h.End {
+		return h.Weekdays.Day(day) && clock >= h.Start && clock < h.End
+	}
+
+	// window spans midnight
+	return (h.Weekdays.Day(day) && clock >= h.Start) ||
+		(h.Weekdays.Day(day-1) && clock < h.End)
+}
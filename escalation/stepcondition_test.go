@@ -0,0 +1,102 @@
+package escalation
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/target/goalert/alert"
+	"github.com/target/goalert/util/timeutil"
+)
+
+func TestStepCondition_Normalize(t *testing.T) {
+	c, err := StepCondition{}.Normalize()
+	assert.NoError(t, err)
+	assert.Nil(t, c, "empty condition")
+
+	c, err = StepCondition{Source: alert.SourceGrafana, SummaryRegex: "^db-"}.Normalize()
+	assert.NoError(t, err)
+	assert.NotNil(t, c)
+
+	_, err = StepCondition{Source: "foo"}.Normalize()
+	assert.Error(t, err, "invalid source")
+
+	_, err = StepCondition{SummaryRegex: "foo(bar"}.Normalize()
+	assert.Error(t, err, "invalid regex")
+
+	_, err = StepCondition{SummaryRegex: `\bdb\b`}.Normalize()
+	assert.Error(t, err, "regex not supported by Postgres")
+
+	_, err = StepCondition{Hours: &StepHours{Weekdays: timeutil.EveryDay()}}.Normalize()
+	assert.Error(t, err, "missing time zone")
+
+	_, err = StepCondition{Hours: &StepHours{TimeZone: time.UTC}}.Normalize()
+	assert.Error(t, err, "no weekdays")
+}
+
+func TestStepCondition_Matches(t *testing.T) {
+	// 2021-09-06 is a Monday
+	at := func(day, hour int) time.Time { return time.Date(2021, 9, day, hour, 0, 0, 0, time.UTC) }
+	businessHours := &StepHours{
+		TimeZone: time.UTC,
+		Start:    timeutil.NewClock(9, 0),
+		End:      timeutil.NewClock(17, 0),
+		Weekdays: timeutil.WeekdayFilter{0, 1, 1, 1, 1, 1, 0},
+	}
+
+	var c *StepCondition
+	assert.True(t, c.Matches(alert.SourceManual, "foo", at(6, 12)), "nil condition")
+
+	c = &StepCondition{Source: alert.SourceGrafana}
+	assert.True(t, c.Matches(alert.SourceGrafana, "foo", at(6, 12)))
+	assert.False(t, c.Matches(alert.SourceManual, "foo", at(6, 12)))
+
+	c = &StepCondition{SummaryRegex: "^db-[0-9]+"}
+	assert.True(t, c.Matches(alert.SourceManual, "db-01 down", at(6, 12)))
+	assert.False(t, c.Matches(alert.SourceManual, "web-01 down", at(6, 12)))
+
+	c = &StepCondition{SummaryRegex: "down.*disk"}
+	assert.True(t, c.Matches(alert.SourceManual, "db-01 down\nout of disk", at(6, 12)), "dot matches newline")
+
+	c = &StepCondition{Hours: businessHours}
+	assert.True(t, c.Matches(alert.SourceManual, "foo", at(6, 9)), "start of business hours")
+	assert.False(t, c.Matches(alert.SourceManual, "foo", at(6, 17)), "end of business hours")
+	assert.False(t, c.Matches(alert.SourceManual, "foo", at(5, 12)), "sunday")
+
+	afterHours := *businessHours
+	afterHours.Outside = true
+	c = &StepCondition{Hours: &afterHours}
+	assert.False(t, c.Matches(alert.SourceManual, "foo", at(6, 12)))
+	assert.True(t, c.Matches(alert.SourceManual, "foo", at(6, 20)))
+	assert.True(t, c.Matches(alert.SourceManual, "foo", at(5, 12)), "sunday")
+}
+
+func TestStepHours_Contains(t *testing.T) {
+	at := func(day, hour int) time.Time { return time.Date(2021, 9, day, hour, 0, 0, 0, time.UTC) }
+
+	// overnight window, starting Fridays only
+	h := StepHours{
+		TimeZone: time.UTC,
+		Start:    timeutil.NewClock(22, 0),
+		End:      timeutil.NewClock(6, 0),
+		Weekdays: timeutil.WeekdayFilter{0, 0, 0, 0, 0, 1, 0},
+	}
+	assert.False(t, h.Contains(at(10, 21)))
+	assert.True(t, h.Contains(at(10, 22)))
+	assert.True(t, h.Contains(at(11, 5)), "saturday morning")
+	assert.False(t, h.Contains(at(11, 6)))
+	assert.False(t, h.Contains(at(11, 23)), "saturday night")
+
+	ny, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip("time zone data unavailable")
+	}
+	h = StepHours{
+		TimeZone: ny,
+		Start:    timeutil.NewClock(9, 0),
+		End:      timeutil.NewClock(17, 0),
+		Weekdays: timeutil.EveryDay(),
+	}
+	assert.False(t, h.Contains(at(6, 12)), "8am in New York")
+	assert.True(t, h.Contains(at(6, 13)), "9am in New York")
+}
@@ -3,7 +3,10 @@ package escalation
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
+	"fmt"
 
+	"github.com/target/goalert/alert"
 	alertlog "github.com/target/goalert/alert/log"
 	"github.com/target/goalert/assignment"
 	"github.com/target/goalert/notification/slack"
@@ -12,6 +15,8 @@ import (
 	"github.com/target/goalert/util"
 	"github.com/target/goalert/util/log"
 	"github.com/target/goalert/util/sqlutil"
+	"github.com/target/goalert/util/timeutil"
+	"github.com/target/goalert/validation"
 	"github.com/target/goalert/validation/validate"
 
 	"github.com/google/uuid"
@@ -41,18 +46,29 @@ type Store struct {
 	createPolicy              *sql.Stmt
 	updatePolicy              *sql.Stmt
 	deletePolicy              *sql.Stmt
+	findTeamIDs               *sql.Stmt
 
 	findOneStepForUpdate *sql.Stmt
 	findAllSteps         *sql.Stmt
 	findAllOnCallSteps   *sql.Stmt
 	createStep           *sql.Stmt
 	updateStepDelay      *sql.Stmt
+	updateStepCondition  *sql.Stmt
+	updateStepTargetMode *sql.Stmt
 	updateStepNumber     *sql.Stmt
 	deleteStep           *sql.Stmt
 
 	addStepTarget      *sql.Stmt
 	deleteStepTarget   *sql.Stmt
 	findAllStepTargets *sql.Stmt
+	copyStepTargets    *sql.Stmt
+	setStepTargetPos   *sql.Stmt
+
+	createTemplate   *sql.Stmt
+	findOneTemplate  *sql.Stmt
+	findAllTemplates *sql.Stmt
+	deleteTemplate   *sql.Stmt
+	templateOwners   *sql.Stmt
 }
 
 func NewStore(ctx context.Context, db *sql.DB, cfg Config) (*Store, error) {
@@ -79,6 +95,11 @@ func NewStore(ctx context.Context, db *sql.DB, cfg Config) (*Store, error) {
 				e.name,
 				e.description,
 				e.repeat,
+				e.repeat_backoff_minutes,
+				e.fallback_user_id,
+				e.fallback_schedule_id,
+				e.fallback_rotation_id,
+				coalesce(e.team_id::text, ''),
 				fav is distinct from null
 			FROM
 				escalation_policies e
@@ -86,13 +107,25 @@ func NewStore(ctx context.Context, db *sql.DB, cfg Config) (*Store, error) {
 				fav.tgt_escalation_policy_id = e.id AND fav.user_id = $2
 			WHERE e.id = $1
 		`),
-		findOnePolicyForUpdate: p.P(`SELECT id, name, description, repeat FROM escalation_policies WHERE id = $1 FOR UPDATE`),
+		findOnePolicyForUpdate: p.P(`
+			SELECT
+				id, name, description, repeat, repeat_backoff_minutes, fallback_user_id, fallback_schedule_id, fallback_rotation_id,
+				coalesce(team_id::text, '')
+			FROM escalation_policies
+			WHERE id = $1
+			FOR UPDATE
+		`),
 		findManyPolicies: p.P(`
             SELECT
                 e.id,
                 e.name,
                 e.description,
                 e.repeat,
+                e.repeat_backoff_minutes,
+                e.fallback_user_id,
+                e.fallback_schedule_id,
+                e.fallback_rotation_id,
+                coalesce(e.team_id::text, ''),
                 fav is distinct from null
             FROM
                 escalation_policies e
@@ -105,7 +138,12 @@ func NewStore(ctx context.Context, db *sql.DB, cfg Config) (*Store, error) {
 				step.escalation_policy_id,
 				pol.name,
 				pol.description,
-				pol.repeat
+				pol.repeat,
+				pol.repeat_backoff_minutes,
+				pol.fallback_user_id,
+				pol.fallback_schedule_id,
+				pol.fallback_rotation_id,
+				coalesce(pol.team_id::text, '')
 			FROM
 				escalation_policy_actions as act
 			JOIN
@@ -115,14 +153,82 @@ func NewStore(ctx context.Context, db *sql.DB, cfg Config) (*Store, error) {
 			WHERE
 				act.schedule_id = $1
 		`),
-		createPolicy: p.P(`INSERT INTO escalation_policies (id, name, description, repeat) VALUES ($1, $2, $3, $4)`),
-		updatePolicy: p.P(`UPDATE escalation_policies SET name = $2, description = $3, repeat = $4 WHERE id = $1`),
+		createPolicy: p.P(`
+			INSERT INTO escalation_policies
+				(id, name, description, repeat, repeat_backoff_minutes, fallback_user_id, fallback_schedule_id, fallback_rotation_id, team_id)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		`),
+		updatePolicy: p.P(`
+			UPDATE escalation_policies
+			SET
+				name = $2,
+				description = $3,
+				repeat = $4,
+				repeat_backoff_minutes = $5,
+				fallback_user_id = $6,
+				fallback_schedule_id = $7,
+				fallback_rotation_id = $8,
+				team_id = $9
+			WHERE id = $1
+		`),
 		deletePolicy: p.P(`DELETE FROM escalation_policies WHERE id = any($1)`),
+		findTeamIDs: p.P(`
+			SELECT array(
+				SELECT DISTINCT coalesce(team_id::text, '')
+				FROM escalation_policies
+				WHERE
+					id = any($1) OR
+					id IN (SELECT escalation_policy_id FROM escalation_policy_steps WHERE id = any($1))
+			)
+		`),
 
 		addStepTarget: p.P(`
-			INSERT INTO escalation_policy_actions (id, escalation_policy_step_id, user_id, schedule_id, rotation_id, channel_id)
-			VALUES ($1, $2, $3, $4, $5, $6)
+			INSERT INTO escalation_policy_actions (id, escalation_policy_step_id, user_id, schedule_id, rotation_id, channel_id, position)
+			SELECT $1, $2, $3, $4, $5, $6, coalesce(max(position) + 1, 0)
+			FROM escalation_policy_actions
+			WHERE escalation_policy_step_id = $2
+		`),
+		copyStepTargets: p.P(`
+			INSERT INTO escalation_policy_actions (id, escalation_policy_step_id, user_id, schedule_id, rotation_id, channel_id, position)
+			SELECT gen_random_uuid(), $2, user_id, schedule_id, rotation_id, channel_id, position
+			FROM escalation_policy_actions
+			WHERE escalation_policy_step_id = $1
+		`),
+		setStepTargetPos: p.P(`
+			UPDATE escalation_policy_actions
+			SET position = $6
+			WHERE
+				escalation_policy_step_id = $1 AND
+				(
+					user_id = $2 OR
+					schedule_id = $3 OR
+					rotation_id = $4 OR
+					channel_id = $5
+				)
+		`),
+
+		createTemplate: p.P(`
+			INSERT INTO escalation_policy_templates (id, name, description, repeat, repeat_backoff_minutes, steps, team_id, created_by_user_id)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
 		`),
+		findOneTemplate: p.P(`
+			SELECT id, name, description, repeat, repeat_backoff_minutes, steps, coalesce(team_id::text, ''), coalesce(created_by_user_id::text, '')
+			FROM escalation_policy_templates
+			WHERE id = $1
+		`),
+		findAllTemplates: p.P(`
+			SELECT id, name, description, repeat, repeat_backoff_minutes, steps, coalesce(team_id::text, ''), coalesce(created_by_user_id::text, '')
+			FROM escalation_policy_templates
+			ORDER BY lower(name)
+		`),
+		deleteTemplate: p.P(`DELETE FROM escalation_policy_templates WHERE id = any($1)`),
+		templateOwners: p.P(`
+			SELECT coalesce(team_id::text, ''), coalesce(created_by_user_id::text, '')
+			FROM escalation_policy_templates
+			WHERE id = any($1)
+			FOR UPDATE
+		`),
+
 		deleteStepTarget: p.P(`
 			DELETE FROM escalation_policy_actions
 			WHERE
@@ -155,12 +261,13 @@ func NewStore(ctx context.Context, db *sql.DB, cfg Config) (*Store, error) {
 				on act.channel_id = chan.id
 			WHERE
 				escalation_policy_step_id = $1
+			ORDER BY act.position
 		`),
 
-		findOneStepForUpdate: p.P(`SELECT id, escalation_policy_id, delay, step_number FROM escalation_policy_steps WHERE id = $1 FOR UPDATE`),
-		findAllSteps:         p.P(`SELECT id, escalation_policy_id, delay, step_number FROM escalation_policy_steps WHERE escalation_policy_id = $1 ORDER BY step_number`),
+		findOneStepForUpdate: p.P(`SELECT ` + stepColumns + ` FROM escalation_policy_steps step WHERE id = $1 FOR UPDATE`),
+		findAllSteps:         p.P(`SELECT ` + stepColumns + ` FROM escalation_policy_steps step WHERE escalation_policy_id = $1 ORDER BY step_number`),
 		findAllOnCallSteps: p.P(`
-			SELECT step.id, step.escalation_policy_id, step.delay, step.step_number
+			SELECT ` + stepColumns + `
 			FROM ep_step_on_call_users oc
 			JOIN escalation_policy_steps step ON step.id = oc.ep_step_id
 			WHERE oc.user_id = $1 AND oc.end_time isnull
@@ -169,16 +276,52 @@ func NewStore(ctx context.Context, db *sql.DB, cfg Config) (*Store, error) {
 
 		createStep: p.P(`
 			INSERT INTO escalation_policy_steps
-				(id, escalation_policy_id, delay, step_number)
-			VALUES ($1, $2, $3, DEFAULT)
+				(
+					id, escalation_policy_id, delay, step_number,
+					condition_source, condition_summary_regex, condition_time_zone,
+					condition_start_time, condition_end_time, condition_weekdays, condition_outside_hours,
+					target_mode, sequential_delay_minutes
+				)
+			VALUES ($1, $2, $3, DEFAULT, $4, $5, $6, $7, $8, $9, $10, $11, $12)
 			RETURNING step_number
 		`),
-		updateStepDelay:  p.P(`UPDATE escalation_policy_steps SET delay = $2 WHERE id = $1`),
-		updateStepNumber: p.P(`UPDATE escalation_policy_steps SET step_number = $2 WHERE id = $1`),
-		deleteStep:       p.P(`DELETE FROM escalation_policy_steps WHERE id = $1 RETURNING escalation_policy_id`),
+		updateStepCondition: p.P(`
+			UPDATE escalation_policy_steps
+			SET
+				condition_source = $2,
+				condition_summary_regex = $3,
+				condition_time_zone = $4,
+				condition_start_time = $5,
+				condition_end_time = $6,
+				condition_weekdays = $7,
+				condition_outside_hours = $8
+			WHERE id = $1
+			RETURNING escalation_policy_id
+		`),
+		updateStepTargetMode: p.P(`UPDATE escalation_policy_steps SET target_mode = $2, sequential_delay_minutes = $3 WHERE id = $1 RETURNING escalation_policy_id`),
+		updateStepDelay:      p.P(`UPDATE escalation_policy_steps SET delay = $2 WHERE id = $1`),
+		updateStepNumber:     p.P(`UPDATE escalation_policy_steps SET step_number = $2 WHERE id = $1`),
+		deleteStep:           p.P(`DELETE FROM escalation_policy_steps WHERE id = $1 RETURNING escalation_policy_id`),
 	}, p.Err
 }
 
+// checkOwner will return an error if the context may not modify the given policies or steps, or
+// policies owned by any of the extra team IDs.
+func (s *Store) checkOwner(ctx context.Context, tx *sql.Tx, ids []string, extraTeamIDs ...string) error {
+	stmt := s.findTeamIDs
+	if tx != nil {
+		stmt = tx.StmtContext(ctx, stmt)
+	}
+
+	var teamIDs sqlutil.StringArray
+	err := stmt.QueryRowContext(ctx, sqlutil.UUIDArray(ids)).Scan(&teamIDs)
+	if err != nil {
+		return err
+	}
+
+	return permission.LimitCheckOwner(ctx, append(teamIDs, extraTeamIDs...)...)
+}
+
 func (s *Store) logChange(ctx context.Context, tx *sql.Tx, policyID string) {
 	err := s.log.LogEPTx(ctx, tx, policyID, alertlog.TypePolicyUpdated, nil)
 	if err != nil {
@@ -186,6 +329,120 @@ func (s *Store) logChange(ctx context.Context, tx *sql.Tx, policyID string) {
 	}
 }
 
+const stepColumns = `
+	step.id,
+	step.escalation_policy_id,
+	step.delay,
+	step.step_number,
+	step.condition_source,
+	step.condition_summary_regex,
+	step.condition_time_zone,
+	step.condition_start_time,
+	step.condition_end_time,
+	step.condition_weekdays,
+	step.condition_outside_hours,
+	step.target_mode,
+	step.sequential_delay_minutes
+`
+
+type scanner interface {
+	Scan(...interface{}) error
+}
+
+// scanStep will scan a step selected with stepColumns.
+func scanStep(row scanner) (*Step, error) {
+	var st Step
+	var src, re, tz, weekdays sql.NullString
+	var start, end timeutil.NullClock
+	var outside bool
+	err := row.Scan(&st.ID, &st.PolicyID, &st.DelayMinutes, &st.StepNumber, &src, &re, &tz, &start, &end, &weekdays, &outside, &st.TargetMode, &st.SequentialDelayMinutes)
+	if err != nil {
+		return nil, err
+	}
+	if !src.Valid && !re.Valid && !tz.Valid {
+		return &st, nil
+	}
+
+	st.Condition = &StepCondition{
+		Source:       alert.Source(src.String),
+		SummaryRegex: re.String,
+	}
+	if !tz.Valid {
+		return &st, nil
+	}
+
+	loc, err := util.LoadLocation(tz.String)
+	if err != nil {
+		return nil, errors.Wrap(err, "load time zone info")
+	}
+	st.Condition.Hours = &StepHours{
+		TimeZone: loc,
+		Start:    start.Clock,
+		End:      end.Clock,
+		Outside:  outside,
+	}
+	err = st.Condition.Hours.Weekdays.UnmarshalText([]byte(weekdays.String))
+	if err != nil {
+		return nil, errors.Wrap(err, "parse weekdays")
+	}
+
+	return &st, nil
+}
+
+// conditionArgs returns the column values for the condition, in the same order as stepColumns.
+func conditionArgs(c *StepCondition) []interface{} {
+	var src, re, tz, weekdays sql.NullString
+	var start, end timeutil.NullClock
+	var outside bool
+	if c != nil {
+		src = sql.NullString{String: string(c.Source), Valid: c.Source != ""}
+		re = sql.NullString{String: c.SummaryRegex, Valid: c.SummaryRegex != ""}
+	}
+	if c != nil && c.Hours != nil {
+		tz = sql.NullString{String: c.Hours.TimeZone.String(), Valid: true}
+		start = timeutil.NullClock{Clock: c.Hours.Start, Valid: true}
+		end = timeutil.NullClock{Clock: c.Hours.End, Valid: true}
+		days, _ := c.Hours.Weekdays.MarshalText()
+		weekdays = sql.NullString{String: string(days), Valid: true}
+		outside = c.Hours.Outside
+	}
+
+	return []interface{}{src, re, tz, start, end, weekdays, outside}
+}
+
+// fallbackColumns holds the policy fallback target columns.
+type fallbackColumns struct {
+	usr, sched, rot sql.NullString
+}
+
+func (fb *fallbackColumns) set(tgt assignment.Target) {
+	*fb = fallbackColumns{}
+	if tgt == nil {
+		return
+	}
+	id := sql.NullString{String: tgt.TargetID(), Valid: true}
+	switch tgt.TargetType() {
+	case assignment.TargetTypeUser:
+		fb.usr = id
+	case assignment.TargetTypeSchedule:
+		fb.sched = id
+	case assignment.TargetTypeRotation:
+		fb.rot = id
+	}
+}
+
+func (fb fallbackColumns) target() assignment.Target {
+	switch {
+	case fb.usr.Valid:
+		return assignment.UserTarget(fb.usr.String)
+	case fb.sched.Valid:
+		return assignment.ScheduleTarget(fb.sched.String)
+	case fb.rot.Valid:
+		return assignment.RotationTarget(fb.rot.String)
+	}
+	return nil
+}
+
 func validStepTarget(tgt assignment.Target) error {
 	return validate.Many(
 		validate.UUID("TargetID", tgt.TargetID()),
@@ -256,18 +513,20 @@ func (s *Store) FindManyPolicies(ctx context.Context, ids []string) ([]Policy, e
 
 	var result []Policy
 	var p Policy
+	var fb fallbackColumns
 	for rows.Next() {
-		err = rows.Scan(&p.ID, &p.Name, &p.Description, &p.Repeat, &p.isUserFavorite)
+		err = rows.Scan(&p.ID, &p.Name, &p.Description, &p.Repeat, &p.RepeatBackoffMinutes, &fb.usr, &fb.sched, &fb.rot, &p.TeamID, &p.isUserFavorite)
 		if err != nil {
 			return nil, err
 		}
+		p.Fallback = fb.target()
 		result = append(result, p)
 	}
 
 	return result, nil
 }
 
-func (s *Store) _updateStepTarget(ctx context.Context, stepID string, tgt assignment.Target, stmt *sql.Stmt, insert bool) error {
+func (s *Store) _updateStepTarget(ctx context.Context, tx *sql.Tx, stepID string, tgt assignment.Target, stmt *sql.Stmt, insert bool) error {
 	err := validate.Many(
 		validate.UUID("StepID", stepID),
 		validStepTarget(tgt),
@@ -279,6 +538,10 @@ func (s *Store) _updateStepTarget(ctx context.Context, stepID string, tgt assign
 	if err != nil {
 		return err
 	}
+	err = s.checkOwner(ctx, tx, []string{stepID})
+	if err != nil {
+		return err
+	}
 	_, err = stmt.ExecContext(ctx, tgtFields(stepID, tgt, insert)...)
 	if errors.Is(err, sql.ErrNoRows) {
 		err = nil
@@ -323,7 +586,7 @@ func (s *Store) AddStepTargetTx(ctx context.Context, tx *sql.Tx, stepID string,
 			return err
 		}
 	}
-	return s._updateStepTarget(ctx, stepID, tgt, tx.StmtContext(ctx, s.addStepTarget), true)
+	return s._updateStepTarget(ctx, tx, stepID, tgt, tx.StmtContext(ctx, s.addStepTarget), true)
 }
 
 // DeleteStepTargetTx removes the target from the step.
@@ -335,10 +598,47 @@ func (s *Store) DeleteStepTargetTx(ctx context.Context, tx *sql.Tx, stepID strin
 			return err
 		}
 	}
-	return s._updateStepTarget(ctx, stepID, tgt, tx.StmtContext(ctx, s.deleteStepTarget), false)
+	return s._updateStepTarget(ctx, tx, stepID, tgt, tx.StmtContext(ctx, s.deleteStepTarget), false)
+}
+
+// SetStepTargetOrderTx sets the order of a step's targets, used to determine who is
+// notified first with StepTargetModeSequential. Targets not on the step are ignored.
+func (s *Store) SetStepTargetOrderTx(ctx context.Context, tx *sql.Tx, stepID string, tgts []assignment.Target) error {
+	err := permission.LimitCheckAny(ctx, permission.Admin, permission.User)
+	if err != nil {
+		return err
+	}
+	err = validate.UUID("StepID", stepID)
+	if err != nil {
+		return err
+	}
+	err = s.checkOwner(ctx, tx, []string{stepID})
+	if err != nil {
+		return err
+	}
+
+	stmt := tx.StmtContext(ctx, s.setStepTargetPos)
+	for i, tgt := range tgts {
+		if tgt.TargetType() == assignment.TargetTypeSlackChannel {
+			tgt, err = s.lookupSlackChannel(ctx, tx, stepID, tgt.TargetID())
+			if errors.Is(err, sql.ErrNoRows) {
+				continue
+			}
+			if err != nil {
+				return err
+			}
+		}
+
+		_, err = stmt.ExecContext(ctx, append(tgtFields(stepID, tgt, false), i)...)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
-// FindAllStepTargetsTx returns the targets for a step.
+// FindAllStepTargetsTx returns the targets for a step, in order.
 func (s *Store) FindAllStepTargetsTx(ctx context.Context, tx *sql.Tx, stepID string) ([]assignment.Target, error) {
 	err := permission.LimitCheckAny(ctx, permission.User)
 	if err != nil {
@@ -414,6 +714,11 @@ func (s *Store) CreatePolicyTx(ctx context.Context, tx *sql.Tx, p *Policy) (*Pol
 		return nil, err
 	}
 
+	err = permission.LimitCheckOwner(ctx, n.TeamID)
+	if err != nil {
+		return nil, err
+	}
+
 	stmt := s.createPolicy
 	if tx != nil {
 		stmt = tx.StmtContext(ctx, stmt)
@@ -421,7 +726,9 @@ func (s *Store) CreatePolicyTx(ctx context.Context, tx *sql.Tx, p *Policy) (*Pol
 
 	n.ID = uuid.New().String()
 
-	_, err = stmt.ExecContext(ctx, n.ID, n.Name, n.Description, n.Repeat)
+	var fb fallbackColumns
+	fb.set(n.Fallback)
+	_, err = stmt.ExecContext(ctx, n.ID, n.Name, n.Description, n.Repeat, n.RepeatBackoffMinutes, fb.usr, fb.sched, fb.rot, n.teamID())
 	if err != nil {
 		return nil, err
 	}
@@ -444,12 +751,19 @@ func (s *Store) UpdatePolicyTx(ctx context.Context, tx *sql.Tx, p *Policy) error
 		return err
 	}
 
+	err = s.checkOwner(ctx, tx, []string{n.ID}, n.TeamID)
+	if err != nil {
+		return err
+	}
+
 	stmt := s.updatePolicy
 	if tx != nil {
 		stmt = tx.StmtContext(ctx, stmt)
 	}
 
-	_, err = stmt.ExecContext(ctx, n.ID, n.Name, n.Description, n.Repeat)
+	var fb fallbackColumns
+	fb.set(n.Fallback)
+	_, err = stmt.ExecContext(ctx, n.ID, n.Name, n.Description, n.Repeat, n.RepeatBackoffMinutes, fb.usr, fb.sched, fb.rot, n.teamID())
 	if err != nil {
 		return err
 	}
@@ -469,6 +783,10 @@ func (s *Store) DeleteManyPoliciesTx(ctx context.Context, tx *sql.Tx, ids []stri
 	if err != nil {
 		return err
 	}
+	err = s.checkOwner(ctx, tx, ids)
+	if err != nil {
+		return err
+	}
 
 	stmt := s.deletePolicy
 	if tx != nil {
@@ -497,7 +815,9 @@ func (s *Store) FindOnePolicyTx(ctx context.Context, tx *sql.Tx, id string) (*Po
 
 	row := stmt.QueryRowContext(ctx, id)
 	var p Policy
-	err = row.Scan(&p.ID, &p.Name, &p.Description, &p.Repeat)
+	var fb fallbackColumns
+	err = row.Scan(&p.ID, &p.Name, &p.Description, &p.Repeat, &p.RepeatBackoffMinutes, &fb.usr, &fb.sched, &fb.rot, &p.TeamID)
+	p.Fallback = fb.target()
 	return &p, err
 }
 
@@ -520,7 +840,9 @@ func (s *Store) FindOnePolicyForUpdateTx(ctx context.Context, tx *sql.Tx, id str
 
 	row := stmt.QueryRowContext(ctx, id)
 	var p Policy
-	err = row.Scan(&p.ID, &p.Name, &p.Description, &p.Repeat)
+	var fb fallbackColumns
+	err = row.Scan(&p.ID, &p.Name, &p.Description, &p.Repeat, &p.RepeatBackoffMinutes, &fb.usr, &fb.sched, &fb.rot, &p.TeamID)
+	p.Fallback = fb.target()
 	return &p, err
 }
 
@@ -541,12 +863,14 @@ func (s *Store) FindAllPoliciesBySchedule(ctx context.Context, scheduleID string
 	defer rows.Close()
 
 	var p Policy
+	var fb fallbackColumns
 	var policies []Policy
 	for rows.Next() {
-		err = rows.Scan(&p.ID, &p.Name, &p.Description, &p.Repeat)
+		err = rows.Scan(&p.ID, &p.Name, &p.Description, &p.Repeat, &p.RepeatBackoffMinutes, &fb.usr, &fb.sched, &fb.rot, &p.TeamID)
 		if err != nil {
 			return nil, err
 		}
+		p.Fallback = fb.target()
 		policies = append(policies, p)
 	}
 
@@ -570,14 +894,7 @@ func (s *Store) FindOneStepForUpdateTx(ctx context.Context, tx *sql.Tx, id strin
 		stmt = tx.StmtContext(ctx, stmt)
 	}
 
-	row := stmt.QueryRowContext(ctx, id)
-	var st Step
-	err = row.Scan(&st.ID, &st.PolicyID, &st.DelayMinutes, &st.StepNumber)
-	if err != nil {
-		return nil, err
-	}
-
-	return &st, nil
+	return scanStep(stmt.QueryRowContext(ctx, id))
 }
 
 func (s *Store) FindAllSteps(ctx context.Context, policyID string) ([]Step, error) {
@@ -608,12 +925,11 @@ func (s *Store) FindAllOnCallStepsForUserTx(ctx context.Context, tx *sql.Tx, use
 
 	var result []Step
 	for rows.Next() {
-		var s Step
-		err = rows.Scan(&s.ID, &s.PolicyID, &s.DelayMinutes, &s.StepNumber)
+		s, err := scanStep(rows)
 		if err != nil {
 			return nil, err
 		}
-		result = append(result, s)
+		result = append(result, *s)
 	}
 	return result, nil
 }
@@ -643,12 +959,11 @@ func (s *Store) FindAllStepsTx(ctx context.Context, tx *sql.Tx, policyID string)
 
 	var result []Step
 	for rows.Next() {
-		var s Step
-		err = rows.Scan(&s.ID, &s.PolicyID, &s.DelayMinutes, &s.StepNumber)
+		s, err := scanStep(rows)
 		if err != nil {
 			return nil, err
 		}
-		result = append(result, s)
+		result = append(result, *s)
 	}
 	return result, nil
 }
@@ -665,6 +980,11 @@ func (s *Store) CreateStepTx(ctx context.Context, tx *sql.Tx, st *Step) (*Step,
 		return nil, err
 	}
 
+	err = s.checkOwner(ctx, tx, []string{n.PolicyID})
+	if err != nil {
+		return nil, err
+	}
+
 	stmt := s.createStep
 	if tx != nil {
 		stmt = tx.StmtContext(ctx, stmt)
@@ -672,7 +992,9 @@ func (s *Store) CreateStepTx(ctx context.Context, tx *sql.Tx, st *Step) (*Step,
 
 	n.ID = uuid.New().String()
 
-	err = stmt.QueryRowContext(ctx, n.ID, n.PolicyID, n.DelayMinutes).Scan(&n.StepNumber)
+	args := append([]interface{}{n.ID, n.PolicyID, n.DelayMinutes}, conditionArgs(n.Condition)...)
+	args = append(args, n.TargetMode, n.SequentialDelayMinutes)
+	err = stmt.QueryRowContext(ctx, args...).Scan(&n.StepNumber)
 	if err != nil {
 		return nil, err
 	}
@@ -694,6 +1016,11 @@ func (s *Store) UpdateStepNumberTx(ctx context.Context, tx *sql.Tx, stepID strin
 		return err
 	}
 
+	err = s.checkOwner(ctx, tx, []string{stepID})
+	if err != nil {
+		return err
+	}
+
 	numStmt := s.updateStepNumber
 	if tx != nil {
 		numStmt = tx.StmtContext(ctx, numStmt)
@@ -724,6 +1051,11 @@ func (s *Store) UpdateStepDelayTx(ctx context.Context, tx *sql.Tx, stepID string
 		return err
 	}
 
+	err = s.checkOwner(ctx, tx, []string{stepID})
+	if err != nil {
+		return err
+	}
+
 	stmt := s.updateStepDelay
 	if tx != nil {
 		stmt = tx.StmtContext(ctx, stmt)
@@ -737,6 +1069,43 @@ func (s *Store) UpdateStepDelayTx(ctx context.Context, tx *sql.Tx, stepID string
 	return nil
 }
 
+// UpdateStepTargetModeTx updates the target mode and sequential delay for a step.
+func (s *Store) UpdateStepTargetModeTx(ctx context.Context, tx *sql.Tx, stepID string, mode StepTargetMode, seqDelay int) error {
+	err := permission.LimitCheckAny(ctx, permission.Admin, permission.User)
+	if err != nil {
+		return err
+	}
+
+	err = validate.Many(
+		validate.UUID("EscalationPolicyStepID", stepID),
+		validate.OneOf("TargetMode", mode, StepTargetModeAll, StepTargetModeRoundRobin, StepTargetModeSequential),
+		validate.Range("SequentialDelayMinutes", seqDelay, 1, 60),
+	)
+	if err != nil {
+		return err
+	}
+
+	err = s.checkOwner(ctx, tx, []string{stepID})
+	if err != nil {
+		return err
+	}
+
+	stmt := s.updateStepTargetMode
+	if tx != nil {
+		stmt = tx.StmtContext(ctx, stmt)
+	}
+
+	var policyID string
+	err = stmt.QueryRowContext(ctx, stepID, mode, seqDelay).Scan(&policyID)
+	if err != nil {
+		return err
+	}
+
+	s.logChange(ctx, tx, policyID)
+
+	return nil
+}
+
 // DeleteStepTx deletes a step from an escalation policy.
 func (s *Store) DeleteStepTx(ctx context.Context, tx *sql.Tx, id string) (string, error) {
 	err := validate.UUID("EscalationPolicyStepID", id)
@@ -748,6 +1117,10 @@ func (s *Store) DeleteStepTx(ctx context.Context, tx *sql.Tx, id string) (string
 	if err != nil {
 		return "", err
 	}
+	err = s.checkOwner(ctx, tx, []string{id})
+	if err != nil {
+		return "", err
+	}
 	stmt := s.deleteStep
 	if tx != nil {
 		stmt = tx.StmtContext(ctx, stmt)
@@ -763,3 +1136,286 @@ func (s *Store) DeleteStepTx(ctx context.Context, tx *sql.Tx, id string) (string
 
 	return polID, nil
 }
+
+// UpdateStepConditionTx updates the condition for a step, a nil condition will apply the step to all alerts.
+func (s *Store) UpdateStepConditionTx(ctx context.Context, tx *sql.Tx, stepID string, cond *StepCondition) error {
+	err := permission.LimitCheckAny(ctx, permission.Admin, permission.User)
+	if err != nil {
+		return err
+	}
+
+	err = validate.UUID("EscalationPolicyStepID", stepID)
+	if err != nil {
+		return err
+	}
+
+	if cond != nil {
+		cond, err = cond.Normalize()
+		if err != nil {
+			return err
+		}
+	}
+
+	err = s.checkOwner(ctx, tx, []string{stepID})
+	if err != nil {
+		return err
+	}
+
+	stmt := s.updateStepCondition
+	if tx != nil {
+		stmt = tx.StmtContext(ctx, stmt)
+	}
+
+	var policyID string
+	err = stmt.QueryRowContext(ctx, append([]interface{}{stepID}, conditionArgs(cond)...)...).Scan(&policyID)
+	if err != nil {
+		return err
+	}
+
+	s.logChange(ctx, tx, policyID)
+
+	return nil
+}
+
+// ClonePolicyTx will create a copy of the policy, including all steps and targets, with the given name.
+func (s *Store) ClonePolicyTx(ctx context.Context, tx *sql.Tx, id, name string) (*Policy, error) {
+	err := permission.LimitCheckAny(ctx, permission.Admin, permission.User)
+	if err != nil {
+		return nil, err
+	}
+
+	orig, err := s.FindOnePolicyForUpdateTx(ctx, tx, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, validation.NewFieldError("EscalationPolicyID", "not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	cpy := *orig
+	cpy.Name = name
+	if permission.LimitCheckOwner(ctx, cpy.TeamID) != nil {
+		// copies of policies owned by another team are left unowned
+		cpy.TeamID = ""
+	}
+	pol, err := s.CreatePolicyTx(ctx, tx, &cpy)
+	if err != nil {
+		return nil, err
+	}
+
+	steps, err := s.FindAllStepsTx(ctx, tx, orig.ID)
+	if err != nil {
+		return nil, err
+	}
+	for _, st := range steps {
+		origID := st.ID
+		st.PolicyID = pol.ID
+		newStep, err := s.CreateStepTx(ctx, tx, &st)
+		if err != nil {
+			return nil, err
+		}
+
+		_, err = tx.StmtContext(ctx, s.copyStepTargets).ExecContext(ctx, origID, newStep.ID)
+		if err != nil {
+			return nil, errors.Wrap(err, "copy step targets")
+		}
+	}
+
+	return pol, nil
+}
+
+func scanTemplate(row scanner) (*Template, error) {
+	var t Template
+	var steps []byte
+	err := row.Scan(&t.ID, &t.Name, &t.Description, &t.Repeat, &t.RepeatBackoffMinutes, &steps, &t.TeamID, &t.CreatedByUserID)
+	if err != nil {
+		return nil, err
+	}
+	err = json.Unmarshal(steps, &t.Steps)
+	if err != nil {
+		return nil, errors.Wrap(err, "decode template steps")
+	}
+
+	return &t, nil
+}
+
+// CreateTemplateTx will create a new escalation policy template.
+func (s *Store) CreateTemplateTx(ctx context.Context, tx *sql.Tx, t *Template) (*Template, error) {
+	err := permission.LimitCheckAny(ctx, permission.Admin, permission.User)
+	if err != nil {
+		return nil, err
+	}
+
+	n, err := t.Normalize()
+	if err != nil {
+		return nil, err
+	}
+	err = permission.LimitCheckOwner(ctx, n.TeamID)
+	if err != nil {
+		return nil, err
+	}
+
+	steps, err := json.Marshal(n.Steps)
+	if err != nil {
+		return nil, err
+	}
+
+	stmt := s.createTemplate
+	if tx != nil {
+		stmt = tx.StmtContext(ctx, stmt)
+	}
+
+	n.ID = uuid.New().String()
+	n.CreatedByUserID = permission.UserID(ctx)
+	_, err = stmt.ExecContext(ctx, n.ID, n.Name, n.Description, n.Repeat, n.RepeatBackoffMinutes, steps,
+		sql.NullString{String: n.TeamID, Valid: n.TeamID != ""},
+		sql.NullString{String: n.CreatedByUserID, Valid: n.CreatedByUserID != ""},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return n, nil
+}
+
+// FindOneTemplate will return a single escalation policy template.
+func (s *Store) FindOneTemplate(ctx context.Context, id string) (*Template, error) {
+	err := permission.LimitCheckAny(ctx, permission.User)
+	if err != nil {
+		return nil, err
+	}
+	err = validate.UUID("EscalationPolicyTemplateID", id)
+	if err != nil {
+		return nil, err
+	}
+
+	t, err := scanTemplate(s.findOneTemplate.QueryRowContext(ctx, id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+
+	return t, err
+}
+
+// FindAllTemplates will return all escalation policy templates, ordered by name.
+func (s *Store) FindAllTemplates(ctx context.Context) ([]Template, error) {
+	err := permission.LimitCheckAny(ctx, permission.User)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := s.findAllTemplates.QueryContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []Template
+	for rows.Next() {
+		t, err := scanTemplate(rows)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, *t)
+	}
+
+	return result, rows.Err()
+}
+
+// DeleteManyTemplatesTx will delete the given escalation policy templates.
+//
+// Templates may only be deleted by an admin, a member of the owning team, or the user that created them.
+func (s *Store) DeleteManyTemplatesTx(ctx context.Context, tx *sql.Tx, ids []string) error {
+	err := permission.LimitCheckAny(ctx, permission.Admin, permission.User)
+	if err != nil {
+		return err
+	}
+	err = validate.ManyUUID("EscalationPolicyTemplateID", ids, 50)
+	if err != nil {
+		return err
+	}
+
+	err = s.checkTemplateOwner(ctx, tx, ids)
+	if err != nil {
+		return err
+	}
+
+	stmt := s.deleteTemplate
+	if tx != nil {
+		stmt = tx.StmtContext(ctx, stmt)
+	}
+	_, err = stmt.ExecContext(ctx, sqlutil.UUIDArray(ids))
+	return err
+}
+
+// checkTemplateOwner will return an error if the context may not modify the given templates.
+func (s *Store) checkTemplateOwner(ctx context.Context, tx *sql.Tx, ids []string) error {
+	stmt := s.templateOwners
+	if tx != nil {
+		stmt = tx.StmtContext(ctx, stmt)
+	}
+	rows, err := stmt.QueryContext(ctx, sqlutil.UUIDArray(ids))
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var teamID, userID string
+		err = rows.Scan(&teamID, &userID)
+		if err != nil {
+			return err
+		}
+		err = permission.LimitCheckAny(ctx, permission.System, permission.Admin, permission.TeamMember(teamID), permission.MatchUser(userID))
+		if err != nil {
+			return err
+		}
+	}
+
+	return rows.Err()
+}
+
+// CreatePolicyFromTemplateTx will create a new policy, with steps and targets, from a template.
+func (s *Store) CreatePolicyFromTemplateTx(ctx context.Context, tx *sql.Tx, templateID, name, description string, params map[string]assignment.Target) (*Policy, error) {
+	err := permission.LimitCheckAny(ctx, permission.Admin, permission.User)
+	if err != nil {
+		return nil, err
+	}
+	err = validate.UUID("EscalationPolicyTemplateID", templateID)
+	if err != nil {
+		return nil, err
+	}
+
+	t, err := scanTemplate(tx.StmtContext(ctx, s.findOneTemplate).QueryRowContext(ctx, templateID))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, validation.NewFieldError("EscalationPolicyTemplateID", "not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	p, steps, err := t.Policy(name, description, params)
+	if err != nil {
+		return nil, err
+	}
+
+	pol, err := s.CreatePolicyTx(ctx, tx, p)
+	if err != nil {
+		return nil, err
+	}
+	for i, st := range steps {
+		st.PolicyID = pol.ID
+		newStep, err := s.CreateStepTx(ctx, tx, &st)
+		if err != nil {
+			return nil, validation.AddPrefix(fmt.Sprintf("Steps[%d].", i), err)
+		}
+		for _, tgt := range st.Targets {
+			err = s.AddStepTargetTx(ctx, tx, newStep.ID, tgt)
+			if err != nil {
+				return nil, validation.AddPrefix(fmt.Sprintf("Steps[%d].", i), err)
+			}
+		}
+	}
+
+	return pol, nil
+}
@@ -0,0 +1,170 @@
+package escalation
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/target/goalert/assignment"
+	"github.com/target/goalert/validation"
+	"github.com/target/goalert/validation/validate"
+)
+
+// A Template is a reusable escalation policy definition. Steps may refer to named
+// parameters that are replaced with a schedule or rotation when a policy is created.
+type Template struct {
+	ID                   string
+	Name                 string
+	Description          string
+	Repeat               int
+	RepeatBackoffMinutes int
+
+	// TeamID is the team that owns the template, or empty if it is not owned by a team.
+	// Only members of the owning team, or the user that created it, may delete a template.
+	TeamID string
+
+	// CreatedByUserID is the user that created the template, if known.
+	CreatedByUserID string
+
+	Steps []TemplateStep
+}
+
+// TemplateStep is a single step of a Template.
+type TemplateStep struct {
+	DelayMinutes           int            `json:"delay_minutes"`
+	TargetMode             StepTargetMode `json:"target_mode"`
+	SequentialDelayMinutes int            `json:"sequential_delay_minutes"`
+
+	// Targets are assigned to the step as-is.
+	Targets []assignment.RawTarget `json:"targets"`
+
+	// Params are the names of parameters assigned to the step.
+	Params []string `json:"params"`
+}
+
+// Normalize will validate and normalize the template.
+func (t Template) Normalize() (*Template, error) {
+	err := validate.Many(
+		validate.IDName("Name", t.Name),
+		validate.Text("Description", t.Description, 0, 255),
+		validate.Range("Repeat", t.Repeat, RepeatUntilAcknowledged, 5),
+		validate.Range("RepeatBackoffMinutes", t.RepeatBackoffMinutes, 0, 1440),
+		validate.Range("Steps", len(t.Steps), 1, 20),
+	)
+	if t.TeamID != "" {
+		err = validate.Many(err, validate.UUID("TeamID", t.TeamID))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	steps := make([]TemplateStep, len(t.Steps))
+	for i, s := range t.Steps {
+		if s.TargetMode == "" {
+			s.TargetMode = StepTargetModeAll
+		}
+		if s.SequentialDelayMinutes == 0 {
+			s.SequentialDelayMinutes = 1
+		}
+		prefix := fmt.Sprintf("Steps[%d].", i)
+		err = validate.Many(
+			validate.Range(prefix+"DelayMinutes", s.DelayMinutes, 1, 9000),
+			validate.OneOf(prefix+"TargetMode", s.TargetMode, StepTargetModeAll, StepTargetModeRoundRobin, StepTargetModeSequential),
+			validate.Range(prefix+"SequentialDelayMinutes", s.SequentialDelayMinutes, 1, 60),
+			validate.Range(prefix+"Targets", len(s.Targets), 0, 20),
+			validate.Range(prefix+"Params", len(s.Params), 0, 20),
+		)
+		for j, tgt := range s.Targets {
+			fname := fmt.Sprintf("%sTargets[%d].", prefix, j)
+			err = validate.Many(err, validate.OneOf(fname+"Type", tgt.Type,
+				assignment.TargetTypeUser,
+				assignment.TargetTypeSchedule,
+				assignment.TargetTypeRotation,
+				assignment.TargetTypeNotificationChannel,
+				assignment.TargetTypeSlackChannel,
+			))
+			if tgt.Type != assignment.TargetTypeSlackChannel {
+				err = validate.Many(err, validate.UUID(fname+"ID", tgt.ID))
+			}
+		}
+		for j, name := range s.Params {
+			err = validate.Many(err, validate.IDName(fmt.Sprintf("%sParams[%d]", prefix, j), name))
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		steps[i] = s
+	}
+	t.Steps = steps
+
+	return &t, nil
+}
+
+// Params returns the unique parameter names used by the template, in sorted order.
+func (t Template) Params() []string {
+	seen := make(map[string]bool)
+	var names []string
+	for _, s := range t.Steps {
+		for _, name := range s.Params {
+			if seen[name] {
+				continue
+			}
+			seen[name] = true
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// Policy returns a new policy and steps from the template, with each parameter replaced by
+// the schedule or rotation in params.
+func (t Template) Policy(name, description string, params map[string]assignment.Target) (*Policy, []Step, error) {
+	for _, pName := range t.Params() {
+		tgt, ok := params[pName]
+		if !ok {
+			return nil, nil, validation.NewFieldError("Params", fmt.Sprintf("missing value for '%s'", pName))
+		}
+		err := validate.Many(
+			validate.OneOf("Params["+pName+"].Type", tgt.TargetType(),
+				assignment.TargetTypeSchedule,
+				assignment.TargetTypeRotation,
+			),
+			validate.UUID("Params["+pName+"].ID", tgt.TargetID()),
+		)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	if len(params) != len(t.Params()) {
+		return nil, nil, validation.NewFieldError("Params", "unknown parameter for template")
+	}
+
+	if description == "" {
+		description = t.Description
+	}
+	p := &Policy{
+		Name:                 name,
+		Description:          description,
+		Repeat:               t.Repeat,
+		RepeatBackoffMinutes: t.RepeatBackoffMinutes,
+	}
+
+	steps := make([]Step, len(t.Steps))
+	for i, s := range t.Steps {
+		steps[i] = Step{
+			DelayMinutes:           s.DelayMinutes,
+			TargetMode:             s.TargetMode,
+			SequentialDelayMinutes: s.SequentialDelayMinutes,
+		}
+		for _, tgt := range s.Targets {
+			steps[i].Targets = append(steps[i].Targets, tgt)
+		}
+		for _, pName := range s.Params {
+			steps[i].Targets = append(steps[i].Targets, params[pName])
+		}
+	}
+
+	return p, steps, nil
+}
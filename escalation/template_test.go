@@ -0,0 +1,79 @@
+package escalation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/target/goalert/assignment"
+)
+
+func TestTemplate_Normalize(t *testing.T) {
+	test := func(valid bool, tmpl Template) {
+		t.Helper()
+		_, err := tmpl.Normalize()
+		if valid {
+			assert.NoError(t, err, "%+v", tmpl)
+		} else {
+			assert.Error(t, err, "%+v", tmpl)
+		}
+	}
+
+	userID := "a81facc0-4764-012d-7bfb-002500d5d678"
+	test(true, Template{Name: "Standard", Steps: []TemplateStep{{DelayMinutes: 5, Params: []string{"primary"}}}})
+	test(true, Template{Name: "Standard", Steps: []TemplateStep{{DelayMinutes: 5, Targets: []assignment.RawTarget{{Type: assignment.TargetTypeUser, ID: userID}}}}})
+	test(true, Template{Name: "Standard", Steps: []TemplateStep{{DelayMinutes: 5, Targets: []assignment.RawTarget{{Type: assignment.TargetTypeSlackChannel, ID: "C12345"}}}}})
+
+	test(false, Template{Name: "Standard"})
+	test(false, Template{Name: "Standard", Steps: []TemplateStep{{DelayMinutes: 0}}})
+	test(false, Template{Name: "Standard", Steps: []TemplateStep{{DelayMinutes: 5, Params: []string{""}}}})
+	test(false, Template{Name: "Standard", Steps: []TemplateStep{{DelayMinutes: 5, Targets: []assignment.RawTarget{{Type: assignment.TargetTypeUser, ID: "foo"}}}}})
+	test(false, Template{Name: "Standard", Steps: []TemplateStep{{DelayMinutes: 5, Targets: []assignment.RawTarget{{Type: assignment.TargetTypeService, ID: userID}}}}})
+}
+
+func TestTemplate_Policy(t *testing.T) {
+	schedID := "b81facc0-4764-012d-7bfb-002500d5d678"
+	rotID := "c81facc0-4764-012d-7bfb-002500d5d678"
+	userID := "a81facc0-4764-012d-7bfb-002500d5d678"
+	tmpl := Template{
+		Name:        "Standard",
+		Description: "Standard policy",
+		Repeat:      2,
+		Steps: []TemplateStep{
+			{DelayMinutes: 5, TargetMode: StepTargetModeAll, SequentialDelayMinutes: 1, Params: []string{"primary"}},
+			{DelayMinutes: 10, TargetMode: StepTargetModeRoundRobin, SequentialDelayMinutes: 1, Params: []string{"secondary", "primary"}, Targets: []assignment.RawTarget{{Type: assignment.TargetTypeUser, ID: userID}}},
+		},
+	}
+	assert.Equal(t, []string{"primary", "secondary"}, tmpl.Params())
+
+	p, steps, err := tmpl.Policy("Team A", "", map[string]assignment.Target{
+		"primary":   assignment.ScheduleTarget(schedID),
+		"secondary": assignment.RotationTarget(rotID),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, &Policy{Name: "Team A", Description: "Standard policy", Repeat: 2}, p)
+	require.Len(t, steps, 2)
+	assert.Equal(t, []assignment.Target{assignment.ScheduleTarget(schedID)}, steps[0].Targets)
+	assert.Equal(t, StepTargetModeRoundRobin, steps[1].TargetMode)
+	assert.Equal(t, []assignment.Target{
+		assignment.RawTarget{Type: assignment.TargetTypeUser, ID: userID},
+		assignment.RotationTarget(rotID),
+		assignment.ScheduleTarget(schedID),
+	}, steps[1].Targets)
+
+	_, _, err = tmpl.Policy("Team A", "", map[string]assignment.Target{"primary": assignment.ScheduleTarget(schedID)})
+	assert.Error(t, err, "missing param")
+
+	_, _, err = tmpl.Policy("Team A", "", map[string]assignment.Target{
+		"primary":   assignment.ScheduleTarget(schedID),
+		"secondary": assignment.UserTarget(userID),
+	})
+	assert.Error(t, err, "user param")
+
+	_, _, err = tmpl.Policy("Team A", "", map[string]assignment.Target{
+		"primary":   assignment.ScheduleTarget(schedID),
+		"secondary": assignment.RotationTarget(rotID),
+		"other":     assignment.RotationTarget(rotID),
+	})
+	assert.Error(t, err, "unknown param")
+}
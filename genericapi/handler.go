@@ -3,15 +3,18 @@ package genericapi
 import (
 	"database/sql"
 	"net/http"
+	"net/url"
 	"strings"
 	"time"
 
 	"github.com/pkg/errors"
 	"github.com/target/goalert/alert"
 	"github.com/target/goalert/auth"
+	"github.com/target/goalert/integrationkey"
 	"github.com/target/goalert/permission"
 	"github.com/target/goalert/retry"
 	"github.com/target/goalert/util/errutil"
+	"github.com/target/goalert/util/log"
 	"github.com/target/goalert/validation/validate"
 )
 
@@ -72,6 +75,19 @@ func (h *Handler) ServeHeartbeatCheck(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// formMeta will return all `meta.<key>` params as metadata for routing rules.
+func formMeta(form url.Values) map[string]string {
+	meta := make(map[string]string)
+	for key, values := range form {
+		if !strings.HasPrefix(key, "meta.") || len(values) == 0 {
+			continue
+		}
+		meta[strings.TrimPrefix(key, "meta.")] = values[0]
+	}
+
+	return meta
+}
+
 // ServeCreateAlert allows creating or closing an alert.
 func (h *Handler) ServeCreateAlert(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -102,6 +118,21 @@ func (h *Handler) ServeCreateAlert(w http.ResponseWriter, r *http.Request) {
 		Status:    status,
 	}
 
+	ctx, route, err := h.c.IntegrationKeyStore.RouteAlert(ctx, integrationkey.RouteInput{
+		Summary: summary,
+		Details: details,
+		Meta:    formMeta(r.Form),
+	})
+	if errutil.HTTPError(ctx, w, errors.Wrap(err, "route alert")) {
+		return
+	}
+	if route.Drop() {
+		log.Logf(ctx, "alert dropped by routing rule")
+		w.WriteHeader(204)
+		return
+	}
+	a.ServiceID = route.ServiceID
+
 	err = retry.DoTemporaryError(func(int) error {
 		_, err = h.c.AlertStore.CreateOrUpdate(ctx, a)
 		return err
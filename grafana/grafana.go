@@ -23,6 +23,7 @@ type grafanaPost struct {
 	State    string
 	Title    string
 	RuleURL  string
+	Tags     map[string]string
 }
 
 func clientError(w http.ResponseWriter, code int, err error) bool {
@@ -89,6 +90,20 @@ func GrafanaToEventsAPI(aDB alert.Store, intDB integrationkey.Store) http.Handle
 			Dedup:     alert.NewUserDedup(r.FormValue("dedup")),
 		}
 
+		ctx, route, err := intDB.RouteAlert(ctx, integrationkey.RouteInput{
+			Summary: msg.Summary,
+			Details: msg.Details,
+			Meta:    g.Tags,
+		})
+		if errutil.HTTPError(ctx, w, errors.Wrap(err, "route alert")) {
+			return
+		}
+		if route.Drop() {
+			log.Logf(ctx, "alert dropped by routing rule")
+			return
+		}
+		msg.ServiceID = route.ServiceID
+
 		err = retry.DoTemporaryError(func(int) error {
 			_, err = aDB.CreateOrUpdate(ctx, msg)
 			return err
@@ -408,6 +408,15 @@ func (m *Mutation) EscalateAlerts(ctx context.Context, ids []int) ([]alert.Alert
 	return m.AlertStore.FindMany(ctx, ids)
 }
 
+func (m *Mutation) SnoozeAlert(ctx context.Context, input graphql2.SnoozeAlertInput) (*alert.Alert, error) {
+	err := m.AlertStore.Snooze(ctx, input.AlertID, input.Until)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.AlertStore.FindOne(ctx, input.AlertID)
+}
+
 func (m *Mutation) UpdateAlerts(ctx context.Context, args graphql2.UpdateAlertsInput) ([]alert.Alert, error) {
 	var status alert.Status
 
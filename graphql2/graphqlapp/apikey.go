@@ -0,0 +1,100 @@
+package graphqlapp
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/target/goalert/apikey"
+	"github.com/target/goalert/graphql2"
+	"github.com/target/goalert/permission"
+	"github.com/target/goalert/user"
+	"github.com/target/goalert/validation"
+)
+
+type APIKey App
+
+func (a *App) APIKey() graphql2.APIKeyResolver { return (*APIKey)(a) }
+
+func (a *APIKey) UserID(ctx context.Context, obj *apikey.APIKey) (*string, error) {
+	if obj.IsServiceAccount() {
+		return nil, nil
+	}
+	return &obj.UserID, nil
+}
+func (a *APIKey) User(ctx context.Context, obj *apikey.APIKey) (*user.User, error) {
+	if obj.IsServiceAccount() {
+		return nil, nil
+	}
+	return (*App)(a).FindOneUser(ctx, obj.UserID)
+}
+func (a *APIKey) ServiceAccount(ctx context.Context, obj *apikey.APIKey) (bool, error) {
+	return obj.IsServiceAccount(), nil
+}
+func (a *APIKey) ExpiresAt(ctx context.Context, obj *apikey.APIKey) (*time.Time, error) {
+	if obj.ExpiresAt.IsZero() {
+		return nil, nil
+	}
+	return &obj.ExpiresAt, nil
+}
+func (a *APIKey) LastUsedAt(ctx context.Context, obj *apikey.APIKey) (*time.Time, error) {
+	if obj.LastUsedAt.IsZero() {
+		return nil, nil
+	}
+	return &obj.LastUsedAt, nil
+}
+func (a *APIKey) Token(ctx context.Context, obj *apikey.APIKey) (*string, error) {
+	tok := obj.Token()
+	if tok == "" {
+		return nil, nil
+	}
+	return &tok, nil
+}
+
+func (q *Query) APIKey(ctx context.Context, id string) (*apikey.APIKey, error) {
+	return q.APIKeyStore.FindOne(ctx, id)
+}
+func (q *Query) APIKeys(ctx context.Context) ([]apikey.APIKey, error) {
+	return q.APIKeyStore.FindAll(ctx)
+}
+
+func (m *Mutation) CreateAPIKey(ctx context.Context, input graphql2.CreateAPIKeyInput) (k *apikey.APIKey, err error) {
+	k = &apikey.APIKey{
+		Name:   input.Name,
+		Scope:  input.Scope,
+		UserID: permission.UserID(ctx),
+	}
+	if input.Description != nil {
+		k.Description = *input.Description
+	}
+	if input.ExpiresAt != nil {
+		k.ExpiresAt = *input.ExpiresAt
+	}
+	if input.UserID != nil {
+		k.UserID = *input.UserID
+	}
+	if input.ServiceAccount != nil && *input.ServiceAccount {
+		if input.UserID != nil {
+			return nil, validation.NewFieldError("serviceAccount", "cannot be used with `userID`.")
+		}
+		k.UserID = ""
+	}
+
+	err = withContextTx(ctx, m.DB, func(ctx context.Context, tx *sql.Tx) error {
+		k, err = m.APIKeyStore.CreateTx(ctx, tx, k)
+		return err
+	})
+
+	return k, err
+}
+
+func (m *Mutation) DeleteAPIKeys(ctx context.Context, ids []string) (bool, error) {
+	err := withContextTx(ctx, m.DB, func(ctx context.Context, tx *sql.Tx) error {
+		return m.APIKeyStore.DeleteTx(ctx, tx, ids...)
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
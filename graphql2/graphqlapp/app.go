@@ -15,6 +15,8 @@ import (
 	"github.com/pkg/errors"
 	"github.com/target/goalert/alert"
 	alertlog "github.com/target/goalert/alert/log"
+	"github.com/target/goalert/apikey"
+	"github.com/target/goalert/audit"
 	"github.com/target/goalert/auth"
 	"github.com/target/goalert/auth/basic"
 	"github.com/target/goalert/calendarsubscription"
@@ -31,17 +33,20 @@ import (
 	"github.com/target/goalert/notification/twilio"
 	"github.com/target/goalert/notificationchannel"
 	"github.com/target/goalert/oncall"
+	"github.com/target/goalert/oncall/workload"
 	"github.com/target/goalert/override"
 	"github.com/target/goalert/permission"
 	"github.com/target/goalert/schedule"
 	"github.com/target/goalert/schedule/rotation"
 	"github.com/target/goalert/schedule/rule"
 	"github.com/target/goalert/service"
+	"github.com/target/goalert/team"
 	"github.com/target/goalert/timezone"
 	"github.com/target/goalert/user"
 	"github.com/target/goalert/user/contactmethod"
 	"github.com/target/goalert/user/favorite"
 	"github.com/target/goalert/user/notificationrule"
+	"github.com/target/goalert/user/timeoff"
 	"github.com/target/goalert/util/errutil"
 	"github.com/target/goalert/util/log"
 	"github.com/target/goalert/validation"
@@ -63,12 +68,17 @@ type App struct {
 	PolicyStore    *escalation.Store
 	ScheduleStore  *schedule.Store
 	CalSubStore    *calendarsubscription.Store
+	APIKeyStore    *apikey.Store
+	TeamStore      *team.Store
+	AuditStore     *audit.Store
 	RotationStore  rotation.Store
 	OnCallStore    oncall.Store
+	WorkloadStore  *workload.Store
 	IntKeyStore    integrationkey.Store
 	LabelStore     label.Store
 	RuleStore      rule.Store
 	OverrideStore  override.Store
+	TimeOffStore   *timeoff.Store
 	ConfigStore    *config.Store
 	LimitStore     *limit.Store
 	SlackStore     *slack.ChannelSender
@@ -186,6 +196,13 @@ func (a *App) Handler() http.Handler {
 			trace.StringAttribute("graphql.object", fieldCtx.Object),
 			trace.StringAttribute("graphql.field.name", fieldCtx.Field.Name),
 		)
+		if fieldCtx.Object == "Mutation" {
+			err = apikey.CheckMutation(ctx, fieldCtx.Field.Name)
+			if err != nil {
+				return nil, err
+			}
+		}
+
 		start := time.Now()
 		res, err = next(ctx)
 		errVal := "0"
@@ -0,0 +1,114 @@
+package graphqlapp
+
+import (
+	context "context"
+
+	"github.com/target/goalert/audit"
+	"github.com/target/goalert/graphql2"
+	"github.com/target/goalert/search"
+	"github.com/target/goalert/user"
+)
+
+type AuditLog App
+
+func (a *App) AuditLog() graphql2.AuditLogResolver { return (*AuditLog)(a) }
+
+func (a *AuditLog) ActorType(ctx context.Context, e *audit.Entry) (graphql2.AuditLogActorType, error) {
+	return graphql2.AuditLogActorType(e.ActorType), nil
+}
+
+func (a *AuditLog) ActorUser(ctx context.Context, e *audit.Entry) (*user.User, error) {
+	if e.ActorUserID == "" {
+		return nil, nil
+	}
+
+	return (*App)(a).FindOneUser(ctx, e.ActorUserID)
+}
+
+func (a *AuditLog) ObjectType(ctx context.Context, e *audit.Entry) (graphql2.AuditLogObjectType, error) {
+	return graphql2.AuditLogObjectType(e.ObjectType), nil
+}
+
+func (a *AuditLog) Action(ctx context.Context, e *audit.Entry) (graphql2.AuditLogAction, error) {
+	return graphql2.AuditLogAction(e.Action), nil
+}
+
+func (a *AuditLog) Before(ctx context.Context, e *audit.Entry) (*string, error) {
+	if e.Before == nil {
+		return nil, nil
+	}
+
+	s := string(e.Before)
+	return &s, nil
+}
+
+func (a *AuditLog) After(ctx context.Context, e *audit.Entry) (*string, error) {
+	if e.After == nil {
+		return nil, nil
+	}
+
+	s := string(e.After)
+	return &s, nil
+}
+
+func (q *Query) AuditLogs(ctx context.Context, input *graphql2.AuditLogSearchOptions) (conn *graphql2.AuditLogConnection, err error) {
+	if input == nil {
+		input = &graphql2.AuditLogSearchOptions{}
+	}
+
+	var searchOpts audit.SearchOptions
+	if input.After != nil && *input.After != "" {
+		err = search.ParseCursor(*input.After, &searchOpts)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		for _, t := range input.FilterByObjectType {
+			searchOpts.ObjectTypes = append(searchOpts.ObjectTypes, audit.ObjectType(t))
+		}
+		if input.FilterByObjectID != nil {
+			searchOpts.ObjectID = *input.FilterByObjectID
+		}
+		searchOpts.ActorUserIDs = input.FilterByActorUserID
+		for _, act := range input.FilterByAction {
+			searchOpts.Actions = append(searchOpts.Actions, audit.Action(act))
+		}
+		if input.Start != nil {
+			searchOpts.Start = *input.Start
+		}
+		if input.End != nil {
+			searchOpts.End = *input.End
+		}
+	}
+	if input.First != nil {
+		searchOpts.Limit = *input.First
+	}
+	if searchOpts.Limit == 0 {
+		searchOpts.Limit = 15
+	}
+
+	searchOpts.Limit++
+	entries, err := q.AuditStore.Search(ctx, &searchOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	conn = new(graphql2.AuditLogConnection)
+	conn.PageInfo = &graphql2.PageInfo{}
+	if len(entries) == searchOpts.Limit {
+		entries = entries[:len(entries)-1]
+		conn.PageInfo.HasNextPage = true
+	}
+	if len(entries) > 0 {
+		last := entries[len(entries)-1]
+		searchOpts.After.ID = last.ID
+
+		cur, err := search.Cursor(searchOpts)
+		if err != nil {
+			return conn, err
+		}
+		conn.PageInfo.EndCursor = &cur
+	}
+	conn.Nodes = entries
+	return conn, err
+}
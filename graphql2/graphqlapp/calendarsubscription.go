@@ -21,7 +21,16 @@ func (a *App) UserCalendarSubscription() graphql2.UserCalendarSubscriptionResolv
 func (a *UserCalendarSubscription) ReminderMinutes(ctx context.Context, obj *calendarsubscription.CalendarSubscription) ([]int, error) {
 	return obj.Config.ReminderMinutes, nil
 }
+func (a *UserCalendarSubscription) ScheduleID(ctx context.Context, obj *calendarsubscription.CalendarSubscription) (*string, error) {
+	if obj.ScheduleID == "" {
+		return nil, nil
+	}
+	return &obj.ScheduleID, nil
+}
 func (a *UserCalendarSubscription) Schedule(ctx context.Context, obj *calendarsubscription.CalendarSubscription) (*schedule.Schedule, error) {
+	if obj.ScheduleID == "" {
+		return nil, nil
+	}
 	return a.ScheduleStore.FindOne(ctx, obj.ScheduleID)
 }
 func (a *UserCalendarSubscription) URL(ctx context.Context, obj *calendarsubscription.CalendarSubscription) (*string, error) {
@@ -45,9 +54,11 @@ func (q *Query) UserCalendarSubscription(ctx context.Context, id string) (*calen
 // todo: return UserCalendarSubscription with generated url once endpoint has been created
 func (m *Mutation) CreateUserCalendarSubscription(ctx context.Context, input graphql2.CreateUserCalendarSubscriptionInput) (cs *calendarsubscription.CalendarSubscription, err error) {
 	cs = &calendarsubscription.CalendarSubscription{
-		Name:       input.Name,
-		ScheduleID: input.ScheduleID,
-		UserID:     permission.UserID(ctx),
+		Name:   input.Name,
+		UserID: permission.UserID(ctx),
+	}
+	if input.ScheduleID != nil {
+		cs.ScheduleID = *input.ScheduleID
 	}
 	if input.Disabled != nil {
 		cs.Disabled = *input.Disabled
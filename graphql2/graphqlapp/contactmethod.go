@@ -126,6 +126,12 @@ func (m *Mutation) UpdateUserContactMethod(ctx context.Context, input graphql2.U
 		if input.Value != nil {
 			cm.Value = *input.Value
 		}
+		if input.ShiftStartReminderMinutes != nil {
+			cm.ShiftStartReminderMinutes = *input.ShiftStartReminderMinutes
+		}
+		if input.ShiftEndNotification != nil {
+			cm.ShiftEndNotification = *input.ShiftEndNotification
+		}
 
 		return m.CMStore.UpdateTx(ctx, tx, cm)
 	})
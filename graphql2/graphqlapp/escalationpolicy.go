@@ -6,23 +6,66 @@ import (
 	"fmt"
 	"strconv"
 
+	"github.com/target/goalert/alert"
 	"github.com/target/goalert/assignment"
 	"github.com/target/goalert/escalation"
 	"github.com/target/goalert/graphql2"
 	"github.com/target/goalert/notice"
 	"github.com/target/goalert/permission"
 	"github.com/target/goalert/search"
+	"github.com/target/goalert/team"
+	"github.com/target/goalert/util"
 	"github.com/target/goalert/validation"
 	"github.com/target/goalert/validation/validate"
 )
 
 type EscalationPolicy App
 type EscalationPolicyStep App
+type EscalationStepCondition App
+type EscalationStepHours App
 
 func (a *App) EscalationPolicy() graphql2.EscalationPolicyResolver { return (*EscalationPolicy)(a) }
 func (a *App) EscalationPolicyStep() graphql2.EscalationPolicyStepResolver {
 	return (*EscalationPolicyStep)(a)
 }
+func (a *App) EscalationStepCondition() graphql2.EscalationStepConditionResolver {
+	return (*EscalationStepCondition)(a)
+}
+func (a *App) EscalationStepHours() graphql2.EscalationStepHoursResolver {
+	return (*EscalationStepHours)(a)
+}
+
+// stepCondition will convert the input to a step condition, returning nil if it is empty.
+func stepCondition(input *graphql2.EscalationStepConditionInput) (*escalation.StepCondition, error) {
+	if input == nil {
+		return nil, nil
+	}
+
+	var cond escalation.StepCondition
+	if input.Source != nil {
+		cond.Source = alert.Source(*input.Source)
+	}
+	if input.SummaryRegex != nil {
+		cond.SummaryRegex = *input.SummaryRegex
+	}
+	if input.Hours != nil {
+		loc, err := util.LoadLocation(input.Hours.TimeZone)
+		if err != nil {
+			return nil, validation.NewFieldError("condition.hours.timeZone", err.Error())
+		}
+		cond.Hours = &escalation.StepHours{
+			TimeZone: loc,
+			Start:    input.Hours.Start,
+			End:      input.Hours.End,
+			Weekdays: input.Hours.WeekdayFilter,
+		}
+		if input.Hours.Outside != nil {
+			cond.Hours.Outside = *input.Hours.Outside
+		}
+	}
+
+	return cond.Normalize()
+}
 
 func contains(ids []string, id string) bool {
 	for _, x := range ids {
@@ -55,13 +98,25 @@ func (m *Mutation) CreateEscalationPolicyStep(ctx context.Context, input graphql
 		)
 	}
 
+	cond, err := stepCondition(input.Condition)
+	if err != nil {
+		return nil, err
+	}
+
 	err = withContextTx(ctx, m.DB, func(ctx context.Context, tx *sql.Tx) error {
 		s := &escalation.Step{
 			DelayMinutes: input.DelayMinutes,
+			Condition:    cond,
 		}
 		if input.EscalationPolicyID != nil {
 			s.PolicyID = *input.EscalationPolicyID
 		}
+		if input.TargetMode != nil {
+			s.TargetMode = escalation.StepTargetMode(*input.TargetMode)
+		}
+		if input.SequentialDelayMinutes != nil {
+			s.SequentialDelayMinutes = *input.SequentialDelayMinutes
+		}
 
 		step, err = m.PolicyStore.CreateStepTx(ctx, tx, s)
 		if err != nil {
@@ -126,6 +181,15 @@ func (m *Mutation) CreateEscalationPolicy(ctx context.Context, input graphql2.Cr
 		if input.Description != nil {
 			p.Description = *input.Description
 		}
+		if input.RepeatBackoffMinutes != nil {
+			p.RepeatBackoffMinutes = *input.RepeatBackoffMinutes
+		}
+		if input.FallbackTarget != nil {
+			p.Fallback = *input.FallbackTarget
+		}
+		if input.TeamID != nil {
+			p.TeamID = *input.TeamID
+		}
 
 		pol, err = m.PolicyStore.CreatePolicyTx(ctx, tx, p)
 		if err != nil {
@@ -170,6 +234,23 @@ func (m *Mutation) UpdateEscalationPolicy(ctx context.Context, input graphql2.Up
 			ep.Repeat = *input.Repeat
 		}
 
+		if input.RepeatBackoffMinutes != nil {
+			ep.RepeatBackoffMinutes = *input.RepeatBackoffMinutes
+		}
+
+		if input.FallbackTarget != nil && input.ClearFallbackTarget != nil && *input.ClearFallbackTarget {
+			return validation.NewFieldError("clearFallbackTarget", "cannot be used with `fallbackTarget`")
+		}
+		if input.FallbackTarget != nil {
+			ep.Fallback = *input.FallbackTarget
+		}
+		if input.ClearFallbackTarget != nil && *input.ClearFallbackTarget {
+			ep.Fallback = nil
+		}
+		if input.TeamID != nil {
+			ep.TeamID = *input.TeamID
+		}
+
 		err = m.PolicyStore.UpdatePolicyTx(ctx, tx, ep)
 		if err != nil {
 			return err
@@ -224,16 +305,51 @@ func (m *Mutation) UpdateEscalationPolicyStep(ctx context.Context, input graphql
 			return err
 		}
 
-		// update delay if provided
 		if input.DelayMinutes != nil {
 			step.DelayMinutes = *input.DelayMinutes
+		}
+		if input.TargetMode != nil {
+			step.TargetMode = escalation.StepTargetMode(*input.TargetMode)
+		}
+		if input.SequentialDelayMinutes != nil {
+			step.SequentialDelayMinutes = *input.SequentialDelayMinutes
+		}
+
+		// validate the updated step, as the sequential delay depends on the step delay
+		_, err = step.Normalize()
+		if err != nil {
+			return err
+		}
 
+		// update delay if provided
+		if input.DelayMinutes != nil {
 			err = m.PolicyStore.UpdateStepDelayTx(ctx, tx, step.ID, step.DelayMinutes)
 			if err != nil {
 				return err
 			}
 		}
 
+		// update condition if provided
+		if input.Condition != nil {
+			step.Condition, err = stepCondition(input.Condition)
+			if err != nil {
+				return err
+			}
+
+			err = m.PolicyStore.UpdateStepConditionTx(ctx, tx, step.ID, step.Condition)
+			if err != nil {
+				return err
+			}
+		}
+
+		// update target mode if provided
+		if input.TargetMode != nil || input.SequentialDelayMinutes != nil {
+			err = m.PolicyStore.UpdateStepTargetModeTx(ctx, tx, step.ID, step.TargetMode, step.SequentialDelayMinutes)
+			if err != nil {
+				return err
+			}
+		}
+
 		// update targets if provided
 		if input.Targets != nil {
 			step.Targets = make([]assignment.Target, len(input.Targets))
@@ -287,6 +403,11 @@ func (m *Mutation) UpdateEscalationPolicyStep(ctx context.Context, input graphql
 					return err
 				}
 			}
+
+			err = m.PolicyStore.SetStepTargetOrderTx(ctx, tx, step.ID, step.Targets)
+			if err != nil {
+				return err
+			}
 		}
 
 		return err
@@ -322,14 +443,46 @@ func (step *EscalationPolicyStep) Targets(ctx context.Context, raw *escalation.S
 
 	return result, nil
 }
+func (step *EscalationPolicyStep) TargetMode(ctx context.Context, raw *escalation.Step) (graphql2.EscalationStepTargetMode, error) {
+	return graphql2.EscalationStepTargetMode(raw.TargetMode), nil
+}
+
 func (step *EscalationPolicyStep) EscalationPolicy(ctx context.Context, raw *escalation.Step) (*escalation.Policy, error) {
 	return (*App)(step).FindOnePolicy(ctx, raw.PolicyID)
 }
 
+func (c *EscalationStepCondition) Source(ctx context.Context, raw *escalation.StepCondition) (*string, error) {
+	if raw.Source == "" {
+		return nil, nil
+	}
+	src := string(raw.Source)
+	return &src, nil
+}
+
+func (c *EscalationStepCondition) SummaryRegex(ctx context.Context, raw *escalation.StepCondition) (*string, error) {
+	if raw.SummaryRegex == "" {
+		return nil, nil
+	}
+	return &raw.SummaryRegex, nil
+}
+
+func (h *EscalationStepHours) TimeZone(ctx context.Context, raw *escalation.StepHours) (string, error) {
+	return raw.TimeZone.String(), nil
+}
+
 func (step *EscalationPolicy) IsFavorite(ctx context.Context, raw *escalation.Policy) (bool, error) {
 	return raw.IsUserFavorite(), nil
 }
 
+func (ep *EscalationPolicy) FallbackTarget(ctx context.Context, raw *escalation.Policy) (*assignment.RawTarget, error) {
+	if raw.Fallback == nil {
+		return nil, nil
+	}
+
+	tgt := assignment.NewRawTarget(raw.Fallback)
+	return &tgt, nil
+}
+
 func (ep *EscalationPolicy) Steps(ctx context.Context, raw *escalation.Policy) ([]escalation.Step, error) {
 	return ep.PolicyStore.FindAllSteps(ctx, raw.ID)
 }
@@ -414,3 +567,10 @@ func (q *Query) EscalationPolicies(ctx context.Context, opts *graphql2.Escalatio
 	conn.Nodes = pols
 	return conn, err
 }
+
+func (ep *EscalationPolicy) TeamID(ctx context.Context, raw *escalation.Policy) (*string, error) {
+	return ownerTeamID(raw.TeamID), nil
+}
+func (ep *EscalationPolicy) Team(ctx context.Context, raw *escalation.Policy) (*team.Team, error) {
+	return (*App)(ep).findOwnerTeam(ctx, raw.TeamID)
+}
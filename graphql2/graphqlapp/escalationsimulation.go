@@ -0,0 +1,134 @@
+package graphqlapp
+
+import (
+	context "context"
+	"fmt"
+	"time"
+
+	"github.com/target/goalert/alert"
+	"github.com/target/goalert/assignment"
+	"github.com/target/goalert/escalation"
+	"github.com/target/goalert/graphql2"
+	"github.com/target/goalert/oncall"
+	"github.com/target/goalert/user"
+	"github.com/target/goalert/user/contactmethod"
+	"github.com/target/goalert/validation"
+	"github.com/target/goalert/validation/validate"
+)
+
+type EscalationSimulationEvent App
+
+func (a *App) EscalationSimulationEvent() graphql2.EscalationSimulationEventResolver {
+	return (*EscalationSimulationEvent)(a)
+}
+
+func (q *Query) SimulateEscalationPolicy(ctx context.Context, input graphql2.SimulateEscalationPolicyInput) ([]oncall.SimEvent, error) {
+	if (input.EscalationPolicyID == nil) == (input.Steps == nil) {
+		return nil, validation.NewFieldError("escalationPolicyID", "exactly one of `escalationPolicyID` or `steps` is required")
+	}
+
+	opts := oncall.SimOptions{
+		Start: input.Start,
+		End:   input.Start.Add(24 * time.Hour),
+	}
+	if input.End != nil {
+		opts.End = *input.End
+	}
+	if input.AlertSource != nil {
+		opts.AlertSource = alert.Source(*input.AlertSource)
+	}
+	if input.AlertSummary != nil {
+		opts.AlertSummary = *input.AlertSummary
+	}
+
+	var p oncall.SimPolicy
+	if input.EscalationPolicyID != nil {
+		pol, err := (*App)(q).FindOnePolicy(ctx, *input.EscalationPolicyID)
+		if err != nil {
+			return nil, err
+		}
+		if pol == nil {
+			return nil, validation.NewFieldError("escalationPolicyID", "not found")
+		}
+		p.Repeat = pol.Repeat
+		p.RepeatBackoffMinutes = pol.RepeatBackoffMinutes
+		p.Fallback = pol.Fallback
+
+		p.Steps, err = q.PolicyStore.FindAllSteps(ctx, pol.ID)
+		if err != nil {
+			return nil, err
+		}
+		for i := range p.Steps {
+			p.Steps[i].Targets, err = q.PolicyStore.FindAllStepTargetsTx(ctx, nil, p.Steps[i].ID)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if input.Steps != nil {
+		if input.RepeatBackoffMinutes != nil {
+			p.RepeatBackoffMinutes = *input.RepeatBackoffMinutes
+		}
+		if input.FallbackTarget != nil {
+			p.Fallback = *input.FallbackTarget
+		}
+	}
+
+	for i, s := range input.Steps {
+		fname := fmt.Sprintf("steps[%d].", i)
+		step := escalation.Step{
+			StepNumber:             i,
+			DelayMinutes:           s.DelayMinutes,
+			TargetMode:             escalation.StepTargetModeAll,
+			SequentialDelayMinutes: 1,
+		}
+		if s.TargetMode != nil {
+			step.TargetMode = escalation.StepTargetMode(*s.TargetMode)
+		}
+		if s.SequentialDelayMinutes != nil {
+			step.SequentialDelayMinutes = *s.SequentialDelayMinutes
+		}
+		err := validate.Many(
+			validate.Range(fname+"delayMinutes", step.DelayMinutes, 1, 9000),
+			validate.OneOf(fname+"targetMode", step.TargetMode, escalation.StepTargetModeAll, escalation.StepTargetModeRoundRobin, escalation.StepTargetModeSequential),
+			validate.Range(fname+"sequentialDelayMinutes", step.SequentialDelayMinutes, 1, 60),
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		step.Condition, err = stepCondition(s.Condition)
+		if err != nil {
+			return nil, validation.AddPrefix(fname, err)
+		}
+
+		step.Targets = make([]assignment.Target, len(s.Targets))
+		for j, tgt := range s.Targets {
+			step.Targets[j] = tgt
+		}
+		p.Steps = append(p.Steps, step)
+	}
+
+	if input.Repeat != nil {
+		p.Repeat = *input.Repeat
+	}
+
+	return q.OnCallStore.SimulatePolicy(ctx, p, opts)
+}
+
+func (e *EscalationSimulationEvent) User(ctx context.Context, raw *oncall.SimEvent) (*user.User, error) {
+	if raw.UserID == "" {
+		return nil, nil
+	}
+
+	return (*App)(e).FindOneUser(ctx, raw.UserID)
+}
+
+func (e *EscalationSimulationEvent) ContactMethod(ctx context.Context, raw *oncall.SimEvent) (*contactmethod.ContactMethod, error) {
+	if raw.ContactMethodID == "" {
+		return nil, nil
+	}
+
+	return (*App)(e).FindOneCM(ctx, raw.ContactMethodID)
+}
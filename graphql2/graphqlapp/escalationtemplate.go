@@ -0,0 +1,138 @@
+package graphqlapp
+
+import (
+	context "context"
+	"database/sql"
+	"fmt"
+
+	"github.com/target/goalert/assignment"
+	"github.com/target/goalert/escalation"
+	"github.com/target/goalert/graphql2"
+	"github.com/target/goalert/permission"
+	"github.com/target/goalert/team"
+	"github.com/target/goalert/validation"
+)
+
+type EscalationPolicyTemplate App
+type EscalationPolicyTemplateStep App
+
+func (a *App) EscalationPolicyTemplate() graphql2.EscalationPolicyTemplateResolver {
+	return (*EscalationPolicyTemplate)(a)
+}
+
+func (a *App) EscalationPolicyTemplateStep() graphql2.EscalationPolicyTemplateStepResolver {
+	return (*EscalationPolicyTemplateStep)(a)
+}
+
+func (step *EscalationPolicyTemplateStep) TargetMode(ctx context.Context, raw *escalation.TemplateStep) (graphql2.EscalationStepTargetMode, error) {
+	return graphql2.EscalationStepTargetMode(raw.TargetMode), nil
+}
+
+func (t *EscalationPolicyTemplate) TeamID(ctx context.Context, raw *escalation.Template) (*string, error) {
+	return ownerTeamID(raw.TeamID), nil
+}
+func (t *EscalationPolicyTemplate) Team(ctx context.Context, raw *escalation.Template) (*team.Team, error) {
+	return (*App)(t).findOwnerTeam(ctx, raw.TeamID)
+}
+
+func (q *Query) EscalationPolicyTemplate(ctx context.Context, id string) (*escalation.Template, error) {
+	return q.PolicyStore.FindOneTemplate(ctx, id)
+}
+
+func (q *Query) EscalationPolicyTemplates(ctx context.Context) ([]escalation.Template, error) {
+	return q.PolicyStore.FindAllTemplates(ctx)
+}
+
+func (m *Mutation) CloneEscalationPolicy(ctx context.Context, input graphql2.CloneEscalationPolicyInput) (pol *escalation.Policy, err error) {
+	err = withContextTx(ctx, m.DB, func(ctx context.Context, tx *sql.Tx) error {
+		pol, err = m.PolicyStore.ClonePolicyTx(ctx, tx, input.ID, input.Name)
+		if err != nil {
+			return err
+		}
+		if input.Favorite != nil && *input.Favorite {
+			err = m.FavoriteStore.SetTx(ctx, tx, permission.UserID(ctx), assignment.EscalationPolicyTarget(pol.ID))
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+
+	return pol, err
+}
+
+func (m *Mutation) CreateEscalationPolicyTemplate(ctx context.Context, input graphql2.CreateEscalationPolicyTemplateInput) (*escalation.Template, error) {
+	t := &escalation.Template{
+		Name: input.Name,
+	}
+	if input.Description != nil {
+		t.Description = *input.Description
+	}
+	if input.Repeat != nil {
+		t.Repeat = *input.Repeat
+	}
+	if input.RepeatBackoffMinutes != nil {
+		t.RepeatBackoffMinutes = *input.RepeatBackoffMinutes
+	}
+	if input.TeamID != nil {
+		t.TeamID = *input.TeamID
+	}
+	for _, s := range input.Steps {
+		step := escalation.TemplateStep{
+			DelayMinutes: s.DelayMinutes,
+			Targets:      s.Targets,
+			Params:       s.Params,
+		}
+		if s.TargetMode != nil {
+			step.TargetMode = escalation.StepTargetMode(*s.TargetMode)
+		}
+		if s.SequentialDelayMinutes != nil {
+			step.SequentialDelayMinutes = *s.SequentialDelayMinutes
+		}
+		t.Steps = append(t.Steps, step)
+	}
+
+	return m.PolicyStore.CreateTemplateTx(ctx, nil, t)
+}
+
+func (m *Mutation) DeleteEscalationPolicyTemplates(ctx context.Context, ids []string) (bool, error) {
+	err := m.PolicyStore.DeleteManyTemplatesTx(ctx, nil, ids)
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (m *Mutation) CreateEscalationPolicyFromTemplate(ctx context.Context, input graphql2.CreateEscalationPolicyFromTemplateInput) (pol *escalation.Policy, err error) {
+	params := make(map[string]assignment.Target, len(input.Params))
+	for i, p := range input.Params {
+		if _, ok := params[p.Name]; ok {
+			return nil, validation.NewFieldError(fmt.Sprintf("params[%d].name", i), "duplicate parameter")
+		}
+		params[p.Name] = *p.Target
+	}
+
+	var desc string
+	if input.Description != nil {
+		desc = *input.Description
+	}
+
+	err = withContextTx(ctx, m.DB, func(ctx context.Context, tx *sql.Tx) error {
+		pol, err = m.PolicyStore.CreatePolicyFromTemplateTx(ctx, tx, input.TemplateID, input.Name, desc, params)
+		if err != nil {
+			return err
+		}
+		if input.Favorite != nil && *input.Favorite {
+			err = m.FavoriteStore.SetTx(ctx, tx, permission.UserID(ctx), assignment.EscalationPolicyTarget(pol.ID))
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+
+	return pol, err
+}
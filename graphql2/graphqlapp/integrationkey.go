@@ -3,16 +3,29 @@ package graphqlapp
 import (
 	context "context"
 	"database/sql"
+	"fmt"
 	"net/url"
+	"time"
 
 	"github.com/target/goalert/config"
 	"github.com/target/goalert/graphql2"
 	"github.com/target/goalert/integrationkey"
+	"github.com/target/goalert/notice"
+	"github.com/target/goalert/service"
+	"github.com/target/goalert/validation"
 )
 
 type IntegrationKey App
+type IntegrationKeyRoutingRule App
+type IntegrationKeyRouteCondition App
 
 func (a *App) IntegrationKey() graphql2.IntegrationKeyResolver { return (*IntegrationKey)(a) }
+func (a *App) IntegrationKeyRoutingRule() graphql2.IntegrationKeyRoutingRuleResolver {
+	return (*IntegrationKeyRoutingRule)(a)
+}
+func (a *App) IntegrationKeyRouteCondition() graphql2.IntegrationKeyRouteConditionResolver {
+	return (*IntegrationKeyRouteCondition)(a)
+}
 
 func (q *Query) IntegrationKey(ctx context.Context, id string) (*integrationkey.IntegrationKey, error) {
 	return q.IntKeyStore.FindOne(ctx, id)
@@ -28,18 +41,197 @@ func (m *Mutation) CreateIntegrationKey(ctx context.Context, input graphql2.Crea
 			Name:      input.Name,
 			Type:      integrationkey.Type(input.Type),
 		}
+		if input.ExpiresAt != nil {
+			key.ExpiresAt = *input.ExpiresAt
+		}
 		key, err = m.IntKeyStore.CreateKeyTx(ctx, tx, key)
 		return err
 	})
 	return key, err
 }
+
+func (m *Mutation) RotateIntegrationKey(ctx context.Context, input graphql2.RotateIntegrationKeyInput) (key *integrationkey.IntegrationKey, err error) {
+	grace := 24 * time.Hour
+	if input.GracePeriodHours != nil {
+		grace = time.Duration(*input.GracePeriodHours) * time.Hour
+	}
+	err = withContextTx(ctx, m.DB, func(ctx context.Context, tx *sql.Tx) error {
+		key, err = m.IntKeyStore.RotateSecretTx(ctx, tx, input.ID, grace)
+		return err
+	})
+	return key, err
+}
+
+func (m *Mutation) SetIntegrationKeyExpiration(ctx context.Context, input graphql2.SetIntegrationKeyExpirationInput) (bool, error) {
+	var expiresAt time.Time
+	if input.ExpiresAt != nil {
+		expiresAt = *input.ExpiresAt
+	}
+	err := withContextTx(ctx, m.DB, func(ctx context.Context, tx *sql.Tx) error {
+		return m.IntKeyStore.SetExpirationTx(ctx, tx, input.ID, expiresAt)
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// routingRules will convert routing rule inputs to routing rules.
+func routingRules(input []graphql2.IntegrationKeyRoutingRuleInput) []integrationkey.RoutingRule {
+	rules := make([]integrationkey.RoutingRule, len(input))
+	for i, r := range input {
+		rules[i] = integrationkey.RoutingRule{
+			Name:   r.Name,
+			Action: integrationkey.RouteAction(r.Action),
+		}
+		if r.ServiceID != nil {
+			rules[i].ServiceID = *r.ServiceID
+		}
+		for _, c := range r.Conditions {
+			cond := integrationkey.RouteCondition{
+				Field: integrationkey.RouteField(c.Field),
+				Regex: c.Regex,
+			}
+			if c.Key != nil {
+				cond.Key = *c.Key
+			}
+			rules[i].Conditions = append(rules[i].Conditions, cond)
+		}
+	}
+
+	return rules
+}
+
+func (m *Mutation) SetIntegrationKeyRoutingRules(ctx context.Context, input graphql2.SetIntegrationKeyRoutingRulesInput) (bool, error) {
+	err := withContextTx(ctx, m.DB, func(ctx context.Context, tx *sql.Tx) error {
+		return m.IntKeyStore.SetRoutingRulesTx(ctx, tx, input.IntegrationKeyID, routingRules(input.Rules))
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (q *Query) TestIntegrationKeyRoute(ctx context.Context, input graphql2.TestIntegrationKeyRouteInput) (*graphql2.IntegrationKeyRouteResult, error) {
+	key, err := q.IntKeyStore.FindOne(ctx, input.IntegrationKeyID)
+	if err != nil {
+		return nil, err
+	}
+	if key == nil {
+		return nil, validation.NewFieldError("IntegrationKeyID", "not found")
+	}
+
+	var rules []integrationkey.RoutingRule
+	if input.Rules != nil {
+		rules = routingRules(input.Rules)
+		for i, r := range rules {
+			r.IntegrationKeyID = key.ID
+			_, err = r.Normalize()
+			if err != nil {
+				return nil, validation.AddPrefix(fmt.Sprintf("Rules[%d].", i), err)
+			}
+		}
+	} else {
+		rules, err = q.IntKeyStore.FindRoutingRules(ctx, key.ID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	in := integrationkey.RouteInput{
+		Summary: input.Summary,
+		Meta:    make(map[string]string, len(input.Meta)),
+	}
+	if input.Details != nil {
+		in.Details = *input.Details
+	}
+	for _, m := range input.Meta {
+		in.Meta[m.Key] = m.Value
+	}
+
+	route := integrationkey.EvaluateRules(rules, key.ServiceID, in)
+	result := &graphql2.IntegrationKeyRouteResult{
+		Rule:      route.Rule,
+		RuleIndex: -1,
+		Dropped:   route.Drop(),
+	}
+	for i := range rules {
+		if route.Rule == &rules[i] {
+			result.RuleIndex = i
+		}
+	}
+	if !route.Drop() {
+		result.Service, err = (*App)(q).FindOneService(ctx, route.ServiceID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+func (key *IntegrationKey) RoutingRules(ctx context.Context, raw *integrationkey.IntegrationKey) ([]integrationkey.RoutingRule, error) {
+	return key.IntKeyStore.FindRoutingRules(ctx, raw.ID)
+}
+
+func (r *IntegrationKeyRoutingRule) Action(ctx context.Context, raw *integrationkey.RoutingRule) (graphql2.IntegrationKeyRouteAction, error) {
+	return graphql2.IntegrationKeyRouteAction(raw.Action), nil
+}
+
+func (r *IntegrationKeyRoutingRule) Service(ctx context.Context, raw *integrationkey.RoutingRule) (*service.Service, error) {
+	if raw.ServiceID == "" {
+		return nil, nil
+	}
+
+	return (*App)(r).FindOneService(ctx, raw.ServiceID)
+}
+
+func (c *IntegrationKeyRouteCondition) Field(ctx context.Context, raw *integrationkey.RouteCondition) (graphql2.IntegrationKeyRouteField, error) {
+	return graphql2.IntegrationKeyRouteField(raw.Field), nil
+}
+
+func (c *IntegrationKeyRouteCondition) Key(ctx context.Context, raw *integrationkey.RouteCondition) (*string, error) {
+	if raw.Key == "" {
+		return nil, nil
+	}
+
+	return &raw.Key, nil
+}
+
+func (key *IntegrationKey) ExpiresAt(ctx context.Context, raw *integrationkey.IntegrationKey) (*time.Time, error) {
+	if raw.ExpiresAt.IsZero() {
+		return nil, nil
+	}
+	return &raw.ExpiresAt, nil
+}
+func (key *IntegrationKey) PreviousSecretExpiresAt(ctx context.Context, raw *integrationkey.IntegrationKey) (*time.Time, error) {
+	if !raw.PrevSecretExpiresAt.After(time.Now()) {
+		return nil, nil
+	}
+	return &raw.PrevSecretExpiresAt, nil
+}
+func (key *IntegrationKey) LastUsedAt(ctx context.Context, raw *integrationkey.IntegrationKey) (*time.Time, error) {
+	if raw.LastUsedAt.IsZero() {
+		return nil, nil
+	}
+	return &raw.LastUsedAt, nil
+}
+func (key *IntegrationKey) RequestCount(ctx context.Context, raw *integrationkey.IntegrationKey) (int, error) {
+	return int(raw.RequestCount), nil
+}
+func (key *IntegrationKey) Notices(ctx context.Context, raw *integrationkey.IntegrationKey) ([]notice.Notice, error) {
+	cfg := config.FromContext(ctx)
+	return raw.Notices(cfg.Maintenance.UnusedIntegrationKeyDays, time.Now()), nil
+}
 func (key *IntegrationKey) Type(ctx context.Context, raw *integrationkey.IntegrationKey) (graphql2.IntegrationKeyType, error) {
 	return graphql2.IntegrationKeyType(raw.Type), nil
 }
 func (key *IntegrationKey) Href(ctx context.Context, raw *integrationkey.IntegrationKey) (string, error) {
 	cfg := config.FromContext(ctx)
 	q := make(url.Values)
-	q.Set("token", raw.ID)
+	q.Set("token", raw.Secret)
 	switch raw.Type {
 	case integrationkey.TypeGeneric:
 		return cfg.CallbackURL("/api/v2/generic/incoming", q), nil
@@ -53,7 +245,7 @@ func (key *IntegrationKey) Href(ctx context.Context, raw *integrationkey.Integra
 		if !cfg.Mailgun.Enable || cfg.Mailgun.EmailDomain == "" {
 			return "", nil
 		}
-		return "mailto:" + raw.ID + "@" + cfg.Mailgun.EmailDomain, nil
+		return "mailto:" + raw.Secret + "@" + cfg.Mailgun.EmailDomain, nil
 	}
 
 	return "", nil
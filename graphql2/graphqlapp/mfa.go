@@ -0,0 +1,45 @@
+package graphqlapp
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/target/goalert/auth/basic"
+	"github.com/target/goalert/permission"
+	"github.com/target/goalert/user"
+)
+
+func (a *User) MfaEnabled(ctx context.Context, obj *user.User) (bool, error) {
+	return a.AuthBasicStore.MFAEnabled(ctx, obj.ID)
+}
+
+func (m *Mutation) SetupMfa(ctx context.Context) (setup *basic.MFASetup, err error) {
+	err = withContextTx(ctx, m.DB, func(ctx context.Context, tx *sql.Tx) error {
+		setup, err = m.AuthBasicStore.SetupMFATx(ctx, tx, permission.UserID(ctx))
+		return err
+	})
+
+	return setup, err
+}
+
+func (m *Mutation) EnableMfa(ctx context.Context, code string) (bool, error) {
+	err := withContextTx(ctx, m.DB, func(ctx context.Context, tx *sql.Tx) error {
+		return m.AuthBasicStore.EnableMFATx(ctx, tx, permission.UserID(ctx), code)
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (m *Mutation) DisableMfa(ctx context.Context, userID string) (bool, error) {
+	err := withContextTx(ctx, m.DB, func(ctx context.Context, tx *sql.Tx) error {
+		return m.AuthBasicStore.DisableMFATx(ctx, tx, userID)
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
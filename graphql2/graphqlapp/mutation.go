@@ -6,6 +6,7 @@ import (
 	"fmt"
 
 	"github.com/target/goalert/assignment"
+	"github.com/target/goalert/audit"
 	"github.com/target/goalert/graphql2"
 	"github.com/target/goalert/notificationchannel"
 	"github.com/target/goalert/permission"
@@ -150,6 +151,31 @@ func (a *Mutation) EndAllAuthSessionsByCurrentUser(ctx context.Context) (bool, e
 	return true, nil
 }
 
+func (a *Mutation) EndAuthSession(ctx context.Context, id string) (bool, error) {
+	err := validate.UUID("ID", id)
+	if err != nil {
+		return false, err
+	}
+
+	err = withContextTx(ctx, a.DB, func(ctx context.Context, tx *sql.Tx) error {
+		return a.AuthHandler.EndUserSessionTx(ctx, tx, id)
+	})
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (a *Mutation) EndAllAuthSessionsByUser(ctx context.Context, userID string) (bool, error) {
+	err := withContextTx(ctx, a.DB, func(ctx context.Context, tx *sql.Tx) error {
+		return a.AuthHandler.EndAllSessionsForUserTx(ctx, tx, userID)
+	})
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
 func (a *Mutation) DeleteAll(ctx context.Context, input []assignment.RawTarget) (bool, error) {
 	tx, err := a.DB.BeginTx(ctx, nil)
 	if err != nil {
@@ -157,6 +183,11 @@ func (a *Mutation) DeleteAll(ctx context.Context, input []assignment.RawTarget)
 	}
 	defer tx.Rollback()
 
+	err = audit.SetActorTx(ctx, tx)
+	if err != nil {
+		return false, err
+	}
+
 	m := make(map[assignment.TargetType][]string)
 	for _, tgt := range input {
 		m[tgt.TargetType()] = append(m[tgt.TargetType()], tgt.TargetID())
@@ -165,6 +196,7 @@ func (a *Mutation) DeleteAll(ctx context.Context, input []assignment.RawTarget)
 	order := []assignment.TargetType{
 		assignment.TargetTypeRotation,
 		assignment.TargetTypeUserOverride,
+		assignment.TargetTypeUserTimeOff,
 		assignment.TargetTypeSchedule,
 		assignment.TargetTypeCalendarSubscription,
 		assignment.TargetTypeUser,
@@ -185,6 +217,8 @@ func (a *Mutation) DeleteAll(ctx context.Context, input []assignment.RawTarget)
 		switch typ {
 		case assignment.TargetTypeUserOverride:
 			err = errors.Wrap(a.OverrideStore.DeleteUserOverrideTx(ctx, tx, ids...), "delete user overrides")
+		case assignment.TargetTypeUserTimeOff:
+			err = errors.Wrap(a.TimeOffStore.DeleteTx(ctx, tx, ids...), "delete user time off")
 		case assignment.TargetTypeUser:
 			err = errors.Wrap(a.UserStore.DeleteManyTx(ctx, tx, ids), "delete users")
 		case assignment.TargetTypeService:
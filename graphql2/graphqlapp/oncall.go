@@ -2,15 +2,43 @@ package graphqlapp
 
 import (
 	context "context"
+
 	"github.com/target/goalert/graphql2"
 	"github.com/target/goalert/oncall"
+	"github.com/target/goalert/oncall/workload"
 	"github.com/target/goalert/user"
 )
 
 type OnCallShift App
+type OnCallWorkload App
 
-func (a *App) OnCallShift() graphql2.OnCallShiftResolver { return (*OnCallShift)(a) }
+func (a *App) OnCallShift() graphql2.OnCallShiftResolver       { return (*OnCallShift)(a) }
+func (a *App) OnCallWorkload() graphql2.OnCallWorkloadResolver { return (*OnCallWorkload)(a) }
 
 func (oc *OnCallShift) User(ctx context.Context, raw *oncall.Shift) (*user.User, error) {
 	return (*App)(oc).FindOneUser(ctx, raw.UserID)
 }
+
+func (w *OnCallWorkload) User(ctx context.Context, raw *workload.UserWorkload) (*user.User, error) {
+	return (*App)(w).FindOneUser(ctx, raw.UserID)
+}
+
+func (q *Query) OnCallWorkloadReport(ctx context.Context, input graphql2.OnCallWorkloadReportInput) ([]workload.UserWorkload, error) {
+	opts := workload.ReportOptions{
+		Start:       input.Start,
+		End:         input.End,
+		ScheduleIDs: input.ScheduleIDs,
+		UserIDs:     input.UserIDs,
+	}
+	if input.TimeZone != nil {
+		opts.TimeZone = *input.TimeZone
+	}
+	if input.BusinessStart != nil {
+		opts.BusinessStart = *input.BusinessStart
+	}
+	if input.BusinessEnd != nil {
+		opts.BusinessEnd = *input.BusinessEnd
+	}
+
+	return q.WorkloadStore.Report(ctx, opts)
+}
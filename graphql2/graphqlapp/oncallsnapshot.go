@@ -0,0 +1,74 @@
+package graphqlapp
+
+import (
+	context "context"
+
+	"github.com/target/goalert/escalation"
+	"github.com/target/goalert/graphql2"
+	"github.com/target/goalert/oncall"
+	"github.com/target/goalert/user"
+	"github.com/target/goalert/validation"
+)
+
+type OnCallSnapshot App
+type OnCallSnapshotStep App
+type OnCallSnapshotTarget App
+
+func (a *App) OnCallSnapshot() graphql2.OnCallSnapshotResolver { return (*OnCallSnapshot)(a) }
+func (a *App) OnCallSnapshotStep() graphql2.OnCallSnapshotStepResolver {
+	return (*OnCallSnapshotStep)(a)
+}
+func (a *App) OnCallSnapshotTarget() graphql2.OnCallSnapshotTargetResolver {
+	return (*OnCallSnapshotTarget)(a)
+}
+
+func (q *Query) OnCallSnapshot(ctx context.Context, input graphql2.OnCallSnapshotInput) (*oncall.Snapshot, error) {
+	if (input.ServiceID == nil) == (input.EscalationPolicyID == nil) {
+		return nil, validation.NewFieldError("serviceID", "exactly one of `serviceID` or `escalationPolicyID` is required")
+	}
+
+	if input.ServiceID != nil {
+		return q.OnCallStore.SnapshotByService(ctx, *input.ServiceID, input.Time)
+	}
+
+	return q.OnCallStore.SnapshotByPolicy(ctx, *input.EscalationPolicyID, input.Time)
+}
+
+func (s *OnCallSnapshot) EscalationPolicy(ctx context.Context, raw *oncall.Snapshot) (*escalation.Policy, error) {
+	return (*App)(s).FindOnePolicy(ctx, raw.EscalationPolicyID)
+}
+
+func (s *OnCallSnapshotStep) Users(ctx context.Context, raw *oncall.SnapshotStep) ([]user.User, error) {
+	return (*App)(s).findUsers(ctx, raw.UserIDs)
+}
+
+func (s *OnCallSnapshotTarget) Users(ctx context.Context, raw *oncall.SnapshotTarget) ([]user.User, error) {
+	return (*App)(s).findUsers(ctx, raw.UserIDs)
+}
+
+// findUsers will return the users for the given IDs in order, omitting any that no longer exist.
+func (app *App) findUsers(ctx context.Context, ids []string) ([]user.User, error) {
+	if len(ids) == 0 {
+		return []user.User{}, nil
+	}
+
+	found, err := app.UserStore.FindMany(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[string]user.User, len(found))
+	for _, u := range found {
+		byID[u.ID] = u
+	}
+
+	users := make([]user.User, 0, len(ids))
+	for _, id := range ids {
+		u, ok := byID[id]
+		if !ok {
+			continue
+		}
+		users = append(users, u)
+	}
+
+	return users, nil
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/target/goalert/permission"
 	"github.com/target/goalert/schedule/rotation"
 	"github.com/target/goalert/search"
+	"github.com/target/goalert/team"
 	"github.com/target/goalert/user"
 	"github.com/target/goalert/util"
 	"github.com/target/goalert/validation"
@@ -43,6 +44,9 @@ func (m *Mutation) CreateRotation(ctx context.Context, input graphql2.CreateRota
 		if input.ShiftLength != nil {
 			rot.ShiftLength = *input.ShiftLength
 		}
+		if input.TeamID != nil {
+			rot.TeamID = *input.TeamID
+		}
 
 		result, err = m.RotationStore.CreateRotationTx(ctx, tx, rot)
 		if err != nil {
@@ -334,6 +338,11 @@ func (m *Mutation) UpdateRotation(ctx context.Context, input graphql2.UpdateRota
 			result.ShiftLength = *input.ShiftLength
 		}
 
+		if input.TeamID != nil {
+			update = true
+			result.TeamID = *input.TeamID
+		}
+
 		if input.TimeZone != nil {
 			update = true
 			loc, err := util.LoadLocation(*input.TimeZone)
@@ -412,3 +421,10 @@ func (a *Query) CalcRotationHandoffTimes(ctx context.Context, input *graphql2.Ca
 
 	return result, nil
 }
+
+func (r *Rotation) TeamID(ctx context.Context, raw *rotation.Rotation) (*string, error) {
+	return ownerTeamID(raw.TeamID), nil
+}
+func (r *Rotation) Team(ctx context.Context, raw *rotation.Rotation) (*team.Team, error) {
+	return (*App)(r).findOwnerTeam(ctx, raw.TeamID)
+}
@@ -11,12 +11,14 @@ import (
 
 	"github.com/target/goalert/assignment"
 	"github.com/target/goalert/graphql2"
+	"github.com/target/goalert/notice"
 	"github.com/target/goalert/notificationchannel"
 	"github.com/target/goalert/oncall"
 	"github.com/target/goalert/permission"
 	"github.com/target/goalert/schedule"
 	"github.com/target/goalert/schedule/rule"
 	"github.com/target/goalert/search"
+	"github.com/target/goalert/team"
 	"github.com/target/goalert/util"
 	"github.com/target/goalert/validation"
 	"github.com/target/goalert/validation/validate"
@@ -74,6 +76,54 @@ func (s *Schedule) Shifts(ctx context.Context, raw *schedule.Schedule, start, en
 	return s.OnCallStore.HistoryBySchedule(ctx, raw.ID, start, end)
 }
 
+// timeOffNoticeWindow is how far ahead schedule notices look for time off conflicts.
+const timeOffNoticeWindow = 14 * 24 * time.Hour
+
+func (s *Schedule) Notices(ctx context.Context, raw *schedule.Schedule) ([]notice.Notice, error) {
+	start := time.Now()
+	end := start.Add(timeOffNoticeWindow)
+
+	timeOff, err := s.TimeOffStore.FindAllBySchedule(ctx, raw.ID, start, end)
+	if err != nil {
+		return nil, err
+	}
+	if len(timeOff) == 0 {
+		return []notice.Notice{}, nil
+	}
+
+	shifts, err := s.OnCallStore.HistoryBySchedule(ctx, raw.ID, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	loc := raw.TimeZone
+	const timeFmt = "Mon Jan 2 3:04PM MST"
+	notices := []notice.Notice{}
+	for _, c := range oncall.TimeOffConflicts(shifts, timeOff) {
+		usr, err := (*App)(s).FindOneUser(ctx, c.Shift.UserID)
+		if err != nil {
+			return nil, err
+		}
+		name := c.Shift.UserID
+		if usr != nil {
+			name = usr.Name
+		}
+
+		notices = append(notices, notice.Notice{
+			Type:    notice.TypeWarning,
+			Message: fmt.Sprintf("%s is on call during time off", name),
+			Details: fmt.Sprintf("Shift from %s to %s overlaps time off from %s to %s. Consider adding an override.",
+				c.Shift.Start.In(loc).Format(timeFmt),
+				c.Shift.End.In(loc).Format(timeFmt),
+				c.TimeOff.Start.In(loc).Format(timeFmt),
+				c.TimeOff.End.In(loc).Format(timeFmt),
+			),
+		})
+	}
+
+	return notices, nil
+}
+
 func (s *Schedule) TemporarySchedules(ctx context.Context, raw *schedule.Schedule) ([]schedule.TemporarySchedule, error) {
 	id, err := parseUUID("ScheduleID", raw.ID)
 	if err != nil {
@@ -167,6 +217,9 @@ func (m *Mutation) UpdateSchedule(ctx context.Context, input graphql2.UpdateSche
 		if loc != nil {
 			sched.TimeZone = loc
 		}
+		if input.TeamID != nil {
+			sched.TeamID = *input.TeamID
+		}
 
 		return m.ScheduleStore.UpdateTx(ctx, tx, sched)
 	})
@@ -220,6 +273,9 @@ func (m *Mutation) CreateSchedule(ctx context.Context, input graphql2.CreateSche
 		if input.Description != nil {
 			s.Description = *input.Description
 		}
+		if input.TeamID != nil {
+			s.TeamID = *input.TeamID
+		}
 		sched, err = m.ScheduleStore.CreateScheduleTx(ctx, tx, s)
 		if err != nil {
 			return err
@@ -326,3 +382,10 @@ func (q *Query) Schedules(ctx context.Context, opts *graphql2.ScheduleSearchOpti
 func (s *Schedule) IsFavorite(ctx context.Context, raw *schedule.Schedule) (bool, error) {
 	return raw.IsUserFavorite(), nil
 }
+
+func (s *Schedule) TeamID(ctx context.Context, raw *schedule.Schedule) (*string, error) {
+	return ownerTeamID(raw.TeamID), nil
+}
+func (s *Schedule) Team(ctx context.Context, raw *schedule.Schedule) (*team.Team, error) {
+	return (*App)(s).findOwnerTeam(ctx, raw.TeamID)
+}
@@ -5,7 +5,9 @@ import (
 	"database/sql"
 	"strconv"
 
+	"github.com/target/goalert/alert"
 	"github.com/target/goalert/assignment"
+	"github.com/target/goalert/audit"
 	"github.com/target/goalert/escalation"
 	"github.com/target/goalert/graphql2"
 	"github.com/target/goalert/heartbeat"
@@ -15,6 +17,7 @@ import (
 	"github.com/target/goalert/permission"
 	"github.com/target/goalert/search"
 	"github.com/target/goalert/service"
+	"github.com/target/goalert/team"
 	"github.com/target/goalert/validation"
 	"github.com/target/goalert/validation/validate"
 )
@@ -105,6 +108,10 @@ func (s *Service) HeartbeatMonitors(ctx context.Context, raw *service.Service) (
 	return s.HeartbeatStore.FindAllByService(ctx, raw.ID)
 }
 
+func (s *Service) AlertStorm(ctx context.Context, raw *service.Service) (*alert.Storm, error) {
+	return s.AlertStore.Storm(ctx, raw.ID)
+}
+
 func (m *Mutation) CreateService(ctx context.Context, input graphql2.CreateServiceInput) (result *service.Service, err error) {
 	if input.NewEscalationPolicy != nil && input.EscalationPolicyID != nil && *input.EscalationPolicyID != "" {
 		return nil, validation.NewFieldError("newEscalationPolicy", "cannot be used with `escalationPolicyID`.")
@@ -120,6 +127,21 @@ func (m *Mutation) CreateService(ctx context.Context, input graphql2.CreateServi
 		if input.Description != nil {
 			svc.Description = *input.Description
 		}
+		if input.AckTimeoutMinutes != nil {
+			svc.AckTimeoutMinutes = *input.AckTimeoutMinutes
+		}
+		if input.AckTimeoutLimit != nil {
+			svc.AckTimeoutLimit = *input.AckTimeoutLimit
+		}
+		if input.AlertStormThreshold != nil {
+			svc.AlertStormThreshold = *input.AlertStormThreshold
+		}
+		if input.AlertStormWindowMinutes != nil {
+			svc.AlertStormWindowMinutes = *input.AlertStormWindowMinutes
+		}
+		if input.TeamID != nil {
+			svc.TeamID = *input.TeamID
+		}
 		if input.NewEscalationPolicy != nil {
 			// Set tempUUID so that Normalize won't fail on the yet-to-be-created
 			// escalation policy.
@@ -198,6 +220,11 @@ func (a *Mutation) UpdateService(ctx context.Context, input graphql2.UpdateServi
 	}
 	defer tx.Rollback()
 
+	err = audit.SetActorTx(ctx, tx)
+	if err != nil {
+		return false, err
+	}
+
 	svc, err := a.ServiceStore.FindOneForUpdate(ctx, tx, input.ID)
 	if err != nil {
 		return false, err
@@ -212,6 +239,21 @@ func (a *Mutation) UpdateService(ctx context.Context, input graphql2.UpdateServi
 	if input.EscalationPolicyID != nil {
 		svc.EscalationPolicyID = *input.EscalationPolicyID
 	}
+	if input.AckTimeoutMinutes != nil {
+		svc.AckTimeoutMinutes = *input.AckTimeoutMinutes
+	}
+	if input.AckTimeoutLimit != nil {
+		svc.AckTimeoutLimit = *input.AckTimeoutLimit
+	}
+	if input.AlertStormThreshold != nil {
+		svc.AlertStormThreshold = *input.AlertStormThreshold
+	}
+	if input.AlertStormWindowMinutes != nil {
+		svc.AlertStormWindowMinutes = *input.AlertStormWindowMinutes
+	}
+	if input.TeamID != nil {
+		svc.TeamID = *input.TeamID
+	}
 
 	err = a.ServiceStore.UpdateTx(ctx, tx, svc)
 	if err != nil {
@@ -225,3 +267,10 @@ func (a *Mutation) UpdateService(ctx context.Context, input graphql2.UpdateServi
 
 	return true, nil
 }
+
+func (s *Service) TeamID(ctx context.Context, raw *service.Service) (*string, error) {
+	return ownerTeamID(raw.TeamID), nil
+}
+func (s *Service) Team(ctx context.Context, raw *service.Service) (*team.Team, error) {
+	return (*App)(s).findOwnerTeam(ctx, raw.TeamID)
+}
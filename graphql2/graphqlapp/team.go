@@ -0,0 +1,127 @@
+package graphqlapp
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/target/goalert/graphql2"
+	"github.com/target/goalert/team"
+	"github.com/target/goalert/user"
+)
+
+type Team App
+
+func (a *App) Team() graphql2.TeamResolver { return (*Team)(a) }
+
+func (t *Team) Members(ctx context.Context, obj *team.Team) ([]user.User, error) {
+	ids, err := t.TeamStore.MemberIDs(ctx, obj.ID)
+	if err != nil {
+		return nil, err
+	}
+	if len(ids) == 0 {
+		return []user.User{}, nil
+	}
+
+	return t.UserStore.FindMany(ctx, ids)
+}
+
+// ownerTeamID returns the team ID for a GraphQL `teamID` field, or nil if unowned.
+func ownerTeamID(teamID string) *string {
+	if teamID == "" {
+		return nil
+	}
+	return &teamID
+}
+
+// findOwnerTeam returns the owning team for a resource, or nil if unowned.
+func (a *App) findOwnerTeam(ctx context.Context, teamID string) (*team.Team, error) {
+	if teamID == "" {
+		return nil, nil
+	}
+	return a.TeamStore.FindOne(ctx, teamID)
+}
+
+func (q *Query) Team(ctx context.Context, id string) (*team.Team, error) {
+	return q.TeamStore.FindOne(ctx, id)
+}
+func (q *Query) Teams(ctx context.Context) ([]team.Team, error) {
+	return q.TeamStore.FindAll(ctx)
+}
+
+func (m *Mutation) CreateTeam(ctx context.Context, input graphql2.CreateTeamInput) (t *team.Team, err error) {
+	t = &team.Team{Name: input.Name}
+	if input.Description != nil {
+		t.Description = *input.Description
+	}
+
+	err = withContextTx(ctx, m.DB, func(ctx context.Context, tx *sql.Tx) error {
+		t, err = m.TeamStore.CreateTx(ctx, tx, t)
+		if err != nil {
+			return err
+		}
+		if len(input.UserIDs) == 0 {
+			return nil
+		}
+
+		return m.TeamStore.AddMembersTx(ctx, tx, t.ID, input.UserIDs)
+	})
+
+	return t, err
+}
+
+func (m *Mutation) UpdateTeam(ctx context.Context, input graphql2.UpdateTeamInput) (bool, error) {
+	err := withContextTx(ctx, m.DB, func(ctx context.Context, tx *sql.Tx) error {
+		t, err := m.TeamStore.FindOne(ctx, input.ID)
+		if err != nil {
+			return err
+		}
+		if input.Name != nil {
+			t.Name = *input.Name
+		}
+		if input.Description != nil {
+			t.Description = *input.Description
+		}
+
+		return m.TeamStore.UpdateTx(ctx, tx, t)
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (m *Mutation) DeleteTeams(ctx context.Context, ids []string) (bool, error) {
+	err := withContextTx(ctx, m.DB, func(ctx context.Context, tx *sql.Tx) error {
+		return m.TeamStore.DeleteManyTx(ctx, tx, ids)
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (m *Mutation) UpdateTeamMembers(ctx context.Context, input graphql2.UpdateTeamMembersInput) (bool, error) {
+	err := withContextTx(ctx, m.DB, func(ctx context.Context, tx *sql.Tx) error {
+		if len(input.AddUserIDs) > 0 {
+			err := m.TeamStore.AddMembersTx(ctx, tx, input.TeamID, input.AddUserIDs)
+			if err != nil {
+				return err
+			}
+		}
+		if len(input.RemoveUserIDs) > 0 {
+			err := m.TeamStore.RemoveMembersTx(ctx, tx, input.TeamID, input.RemoveUserIDs)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
@@ -3,6 +3,8 @@ package graphqlapp
 import (
 	context "context"
 	"database/sql"
+
+	"github.com/target/goalert/audit"
 	"github.com/target/goalert/util/errutil"
 )
 
@@ -34,6 +36,11 @@ func withContextTx(ctx context.Context, db *sql.DB, fn func(context.Context, *sq
 		}
 		defer tx.Rollback()
 
+		err = audit.SetActorTx(ctx, tx)
+		if err != nil {
+			return err
+		}
+
 		err = fn(context.WithValue(ctx, txKey, tx), tx)
 		if err != nil {
 			return err
@@ -4,8 +4,10 @@ import (
 	context "context"
 	"database/sql"
 
+	"github.com/target/goalert/apikey"
 	"github.com/target/goalert/auth"
 	"github.com/target/goalert/calendarsubscription"
+	"github.com/target/goalert/team"
 	"github.com/target/goalert/validation/validate"
 
 	"github.com/pkg/errors"
@@ -57,6 +59,13 @@ func (a *User) NotificationRules(ctx context.Context, obj *user.User) ([]notific
 func (a *User) CalendarSubscriptions(ctx context.Context, obj *user.User) ([]calendarsubscription.CalendarSubscription, error) {
 	return a.CalSubStore.FindAllByUser(ctx, obj.ID)
 }
+func (a *User) APIKeys(ctx context.Context, obj *user.User) ([]apikey.APIKey, error) {
+	return a.APIKeyStore.FindAllByUser(ctx, obj.ID)
+}
+
+func (a *User) Teams(ctx context.Context, obj *user.User) ([]team.Team, error) {
+	return a.TeamStore.FindAllByUser(ctx, obj.ID)
+}
 
 func (a *User) OnCallSteps(ctx context.Context, obj *user.User) ([]escalation.Step, error) {
 	return a.PolicyStore.FindAllOnCallStepsForUserTx(ctx, nil, obj.ID)
@@ -113,7 +122,9 @@ func (a *Mutation) CreateUser(ctx context.Context, input graphql2.CreateUserInpu
 }
 
 func (a *Mutation) DeleteUser(ctx context.Context, id string) (bool, error) {
-	err := a.UserStore.Delete(ctx, id)
+	err := withContextTx(ctx, a.DB, func(ctx context.Context, tx *sql.Tx) error {
+		return a.UserStore.DeleteTx(ctx, tx, id)
+	})
 	if err != nil {
 		return false, err
 	}
@@ -0,0 +1,44 @@
+package graphqlapp
+
+import (
+	context "context"
+	"database/sql"
+
+	"github.com/target/goalert/graphql2"
+	"github.com/target/goalert/user"
+	"github.com/target/goalert/user/timeoff"
+)
+
+type UserTimeOff App
+
+func (a *App) UserTimeOff() graphql2.UserTimeOffResolver { return (*UserTimeOff)(a) }
+
+func (t *UserTimeOff) User(ctx context.Context, raw *timeoff.TimeOff) (*user.User, error) {
+	return (*App)(t).FindOneUser(ctx, raw.UserID)
+}
+
+func (a *User) TimeOff(ctx context.Context, obj *user.User) ([]timeoff.TimeOff, error) {
+	return a.TimeOffStore.FindAllByUser(ctx, obj.ID)
+}
+
+func (m *Mutation) CreateUserTimeOff(ctx context.Context, input graphql2.CreateUserTimeOffInput) (*timeoff.TimeOff, error) {
+	t := &timeoff.TimeOff{
+		UserID: input.UserID,
+		Start:  input.Start,
+		End:    input.End,
+	}
+	if input.Description != nil {
+		t.Description = *input.Description
+	}
+
+	err := withContextTx(ctx, m.DB, func(ctx context.Context, tx *sql.Tx) error {
+		var err error
+		t, err = m.TimeOffStore.CreateTx(ctx, tx, t)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
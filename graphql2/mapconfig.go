@@ -15,6 +15,9 @@ func MapConfigHints(cfg config.Hints) []ConfigHint {
 	return []ConfigHint{
 		{ID: "GitHub.AuthCallbackURL", Value: cfg.GitHub.AuthCallbackURL},
 		{ID: "OIDC.RedirectURL", Value: cfg.OIDC.RedirectURL},
+		{ID: "SAML.MetadataURL", Value: cfg.SAML.MetadataURL},
+		{ID: "SAML.ACSURL", Value: cfg.SAML.ACSURL},
+		{ID: "SCIM.BaseURL", Value: cfg.SCIM.BaseURL},
 		{ID: "Mailgun.ForwardURL", Value: cfg.Mailgun.ForwardURL},
 		{ID: "Twilio.MessageWebhookURL", Value: cfg.Twilio.MessageWebhookURL},
 		{ID: "Twilio.VoiceWebhookURL", Value: cfg.Twilio.VoiceWebhookURL},
@@ -37,14 +40,24 @@ func MapConfigValues(cfg config.Config) []ConfigValue {
 		{ID: "Maintenance.AlertCleanupDays", Type: ConfigTypeInteger, Description: "Closed alerts will be deleted after this many days (0 means disable cleanup).", Value: fmt.Sprintf("%d", cfg.Maintenance.AlertCleanupDays)},
 		{ID: "Maintenance.APIKeyExpireDays", Type: ConfigTypeInteger, Description: "Unused calendar API keys will be disabled after this many days (0 means disable cleanup).", Value: fmt.Sprintf("%d", cfg.Maintenance.APIKeyExpireDays)},
 		{ID: "Maintenance.ScheduleCleanupDays", Type: ConfigTypeInteger, Description: "Schedule on-call history will be deleted after this many days (0 means disable cleanup).", Value: fmt.Sprintf("%d", cfg.Maintenance.ScheduleCleanupDays)},
+		{ID: "Maintenance.AuditLogCleanupDays", Type: ConfigTypeInteger, Description: "Audit log entries will be deleted after this many days (0 means disable cleanup).", Value: fmt.Sprintf("%d", cfg.Maintenance.AuditLogCleanupDays)},
+		{ID: "Maintenance.UnusedIntegrationKeyDays", Type: ConfigTypeInteger, Description: "Integration keys that have not been used for this many days will be flagged (0 means disable).", Value: fmt.Sprintf("%d", cfg.Maintenance.UnusedIntegrationKeyDays)},
+		{ID: "SafetyNet.Enable", Type: ConfigTypeBoolean, Description: "Escalate immediately past steps that are unable to notify anyone (or whose notifications all failed), and notify the safety net once the final step is reached without any successful notification.", Value: fmt.Sprintf("%t", cfg.SafetyNet.Enable)},
+		{ID: "SafetyNet.SlackChannelID", Type: ConfigTypeString, Description: "Slack channel ID to notify when an escalation policy does not successfully notify anyone. The channel must already be added to an escalation policy.", Value: cfg.SafetyNet.SlackChannelID},
+		{ID: "SafetyNet.NotifyAdmins", Type: ConfigTypeBoolean, Description: "Notify all admins when an escalation policy does not successfully notify anyone.", Value: fmt.Sprintf("%t", cfg.SafetyNet.NotifyAdmins)},
 		{ID: "Auth.RefererURLs", Type: ConfigTypeStringList, Description: "Allowed referer URLs for auth and redirects.", Value: strings.Join(cfg.Auth.RefererURLs, "\n")},
 		{ID: "Auth.DisableBasic", Type: ConfigTypeBoolean, Description: "Disallow username/password login.", Value: fmt.Sprintf("%t", cfg.Auth.DisableBasic)},
+		{ID: "Auth.RequireMFA", Type: ConfigTypeBoolean, Description: "Require multi-factor authentication (TOTP) for username/password login. Users without it will be asked to set it up on their next login.", Value: fmt.Sprintf("%t", cfg.Auth.RequireMFA)},
+		{ID: "Auth.IdleSessionTimeoutMinutes", Type: ConfigTypeInteger, Description: "Sessions will end after this many minutes without activity (0 means 30 days, otherwise at least 5).", Value: fmt.Sprintf("%d", cfg.Auth.IdleSessionTimeoutMinutes)},
+		{ID: "Auth.MaxSessionAgeHours", Type: ConfigTypeInteger, Description: "Sessions will end this many hours after login, regardless of activity (0 means no limit).", Value: fmt.Sprintf("%d", cfg.Auth.MaxSessionAgeHours)},
 		{ID: "GitHub.Enable", Type: ConfigTypeBoolean, Description: "Enable GitHub authentication.", Value: fmt.Sprintf("%t", cfg.GitHub.Enable)},
 		{ID: "GitHub.NewUsers", Type: ConfigTypeBoolean, Description: "Allow new user creation via GitHub authentication.", Value: fmt.Sprintf("%t", cfg.GitHub.NewUsers)},
 		{ID: "GitHub.ClientID", Type: ConfigTypeString, Description: "", Value: cfg.GitHub.ClientID},
 		{ID: "GitHub.ClientSecret", Type: ConfigTypeString, Description: "", Value: cfg.GitHub.ClientSecret, Password: true},
 		{ID: "GitHub.AllowedUsers", Type: ConfigTypeStringList, Description: "Allow any of the listed GitHub usernames to authenticate. Use '*' to allow any user.", Value: strings.Join(cfg.GitHub.AllowedUsers, "\n")},
 		{ID: "GitHub.AllowedOrgs", Type: ConfigTypeStringList, Description: "Allow any member of any listed GitHub org (or team, using the format 'org/team') to authenticate.", Value: strings.Join(cfg.GitHub.AllowedOrgs, "\n")},
+		{ID: "GitHub.AdminOrgs", Type: ConfigTypeStringList, Description: "Members of any listed GitHub org (or team, using the format 'org/team') will be granted the admin role on login, and others will have it revoked. If left blank, roles are managed manually.", Value: strings.Join(cfg.GitHub.AdminOrgs, "\n")},
+		{ID: "GitHub.TeamOrgs", Type: ConfigTypeStringList, Description: "Sync GoAlert team membership on login, using the format 'org/team=Team Name'.", Value: strings.Join(cfg.GitHub.TeamOrgs, "\n")},
 		{ID: "GitHub.EnterpriseURL", Type: ConfigTypeString, Description: "GitHub URL (without /api) when used with GitHub Enterprise.", Value: cfg.GitHub.EnterpriseURL},
 		{ID: "OIDC.Enable", Type: ConfigTypeBoolean, Description: "Enable OpenID Connect authentication.", Value: fmt.Sprintf("%t", cfg.OIDC.Enable)},
 		{ID: "OIDC.NewUsers", Type: ConfigTypeBoolean, Description: "Allow new user creation via OIDC authentication.", Value: fmt.Sprintf("%t", cfg.OIDC.NewUsers)},
@@ -56,6 +69,34 @@ func MapConfigValues(cfg config.Config) []ConfigValue {
 		{ID: "OIDC.UserInfoEmailPath", Type: ConfigTypeString, Description: "JMESPath expression to find email address in UserInfo. If set, the email claim will be ignored in favor of this. (suggestion: email).", Value: cfg.OIDC.UserInfoEmailPath},
 		{ID: "OIDC.UserInfoEmailVerifiedPath", Type: ConfigTypeString, Description: "JMESPath expression to find email verification state in UserInfo. If set, the email_verified claim will be ignored in favor of this. (suggestion: email_verified).", Value: cfg.OIDC.UserInfoEmailVerifiedPath},
 		{ID: "OIDC.UserInfoNamePath", Type: ConfigTypeString, Description: "JMESPath expression to find full name in UserInfo. If set, the name claim will be ignored in favor of this. (suggestion: name || cn || join(' ', [firstname, lastname]))", Value: cfg.OIDC.UserInfoNamePath},
+		{ID: "OIDC.UserInfoGroupsPath", Type: ConfigTypeString, Description: "JMESPath expression to find the list of group names in UserInfo. If set, the groups claim will be ignored in favor of this. (suggestion: groups)", Value: cfg.OIDC.UserInfoGroupsPath},
+		{ID: "OIDC.AdminGroups", Type: ConfigTypeStringList, Description: "Members of any listed group will be granted the admin role on login, and others will have it revoked. If left blank, roles are managed manually.", Value: strings.Join(cfg.OIDC.AdminGroups, "\n")},
+		{ID: "OIDC.TeamGroups", Type: ConfigTypeStringList, Description: "Sync GoAlert team membership on login, using the format 'group=Team Name'.", Value: strings.Join(cfg.OIDC.TeamGroups, "\n")},
+		{ID: "SAML.Enable", Type: ConfigTypeBoolean, Description: "Enable SAML 2.0 authentication.", Value: fmt.Sprintf("%t", cfg.SAML.Enable)},
+		{ID: "SAML.NewUsers", Type: ConfigTypeBoolean, Description: "Allow new user creation via SAML authentication.", Value: fmt.Sprintf("%t", cfg.SAML.NewUsers)},
+		{ID: "SAML.OverrideName", Type: ConfigTypeString, Description: "Set the name/label on the login page to something other than SAML.", Value: cfg.SAML.OverrideName},
+		{ID: "SAML.IDPMetadataURL", Type: ConfigTypeString, Description: "URL of the identity provider's SAML metadata.", Value: cfg.SAML.IDPMetadataURL},
+		{ID: "SAML.EntityID", Type: ConfigTypeString, Description: "Service provider entity ID. If left blank, the service provider metadata URL will be used.", Value: cfg.SAML.EntityID},
+		{ID: "SAML.Certificate", Type: ConfigTypeString, Description: "PEM-encoded X.509 certificate used to sign authentication requests.", Value: cfg.SAML.Certificate},
+		{ID: "SAML.PrivateKey", Type: ConfigTypeString, Description: "PEM-encoded RSA private key for the Certificate.", Value: cfg.SAML.PrivateKey, Password: true},
+		{ID: "SAML.NameAttribute", Type: ConfigTypeString, Description: "Assertion attribute (Name or FriendlyName) containing the user's full name. If left blank, displayName will be used.", Value: cfg.SAML.NameAttribute},
+		{ID: "SAML.EmailAttribute", Type: ConfigTypeString, Description: "Assertion attribute (Name or FriendlyName) containing the user's email address. If left blank, mail will be used, falling back to the NameID if it is an email address.", Value: cfg.SAML.EmailAttribute},
+		{ID: "LDAP.Enable", Type: ConfigTypeBoolean, Description: "Enable LDAP authentication.", Value: fmt.Sprintf("%t", cfg.LDAP.Enable)},
+		{ID: "LDAP.NewUsers", Type: ConfigTypeBoolean, Description: "Allow new user creation via LDAP authentication.", Value: fmt.Sprintf("%t", cfg.LDAP.NewUsers)},
+		{ID: "LDAP.OverrideName", Type: ConfigTypeString, Description: "Set the name/label on the login page to something other than LDAP.", Value: cfg.LDAP.OverrideName},
+		{ID: "LDAP.URL", Type: ConfigTypeString, Description: "LDAP server URL (e.g., ldaps://ldap.example.com or ldap://ldap.example.com).", Value: cfg.LDAP.URL},
+		{ID: "LDAP.StartTLS", Type: ConfigTypeBoolean, Description: "Upgrade ldap:// connections to TLS using StartTLS.", Value: fmt.Sprintf("%t", cfg.LDAP.StartTLS)},
+		{ID: "LDAP.SkipVerify", Type: ConfigTypeBoolean, Description: "Disables certificate validation for TLS/StartTLS (insecure).", Value: fmt.Sprintf("%t", cfg.LDAP.SkipVerify)},
+		{ID: "LDAP.BindDN", Type: ConfigTypeString, Description: "DN to bind as when searching for users. If left blank, an anonymous search is performed.", Value: cfg.LDAP.BindDN},
+		{ID: "LDAP.BindPassword", Type: ConfigTypeString, Description: "Password for the BindDN.", Value: cfg.LDAP.BindPassword, Password: true},
+		{ID: "LDAP.BaseDN", Type: ConfigTypeString, Description: "Base DN to search for users.", Value: cfg.LDAP.BaseDN},
+		{ID: "LDAP.UserFilter", Type: ConfigTypeString, Description: "Search filter to find a user, with {username} replaced by the login username. If left blank, (uid={username}) will be used. For Active Directory use (sAMAccountName={username}).", Value: cfg.LDAP.UserFilter},
+		{ID: "LDAP.NameAttribute", Type: ConfigTypeString, Description: "User attribute containing the user's full name. If left blank, displayName will be used.", Value: cfg.LDAP.NameAttribute},
+		{ID: "LDAP.EmailAttribute", Type: ConfigTypeString, Description: "User attribute containing the user's email address. If left blank, mail will be used.", Value: cfg.LDAP.EmailAttribute},
+		{ID: "LDAP.AllowedGroups", Type: ConfigTypeStringList, Description: "Only allow members of any of the listed group DNs to authenticate. If empty, any user found may authenticate.", Value: strings.Join(cfg.LDAP.AllowedGroups, "\n")},
+		{ID: "SCIM.Enable", Type: ConfigTypeBoolean, Description: "Enable the SCIM 2.0 user provisioning API. Requests must be authorized with an admin-scoped API key.", Value: fmt.Sprintf("%t", cfg.SCIM.Enable)},
+		{ID: "SCIM.AuthProviderID", Type: ConfigTypeString, Description: "ID of the auth provider provisioned users login with (e.g., oidc, saml, or ldap). If set, an auth subject is created for each provisioned user.", Value: cfg.SCIM.AuthProviderID},
+		{ID: "SCIM.SubjectAttribute", Type: ConfigTypeString, Description: "SCIM attribute used as the auth subject ID, either externalId or userName. If left blank, externalId will be used.", Value: cfg.SCIM.SubjectAttribute},
 		{ID: "Mailgun.Enable", Type: ConfigTypeBoolean, Description: "", Value: fmt.Sprintf("%t", cfg.Mailgun.Enable)},
 		{ID: "Mailgun.APIKey", Type: ConfigTypeString, Description: "", Value: cfg.Mailgun.APIKey, Password: true},
 		{ID: "Mailgun.EmailDomain", Type: ConfigTypeString, Description: "The TO address for all incoming alerts.", Value: cfg.Mailgun.EmailDomain},
@@ -102,9 +143,16 @@ func MapPublicConfigValues(cfg config.Config) []ConfigValue {
 		{ID: "Maintenance.AlertCleanupDays", Type: ConfigTypeInteger, Description: "Closed alerts will be deleted after this many days (0 means disable cleanup).", Value: fmt.Sprintf("%d", cfg.Maintenance.AlertCleanupDays)},
 		{ID: "Maintenance.APIKeyExpireDays", Type: ConfigTypeInteger, Description: "Unused calendar API keys will be disabled after this many days (0 means disable cleanup).", Value: fmt.Sprintf("%d", cfg.Maintenance.APIKeyExpireDays)},
 		{ID: "Maintenance.ScheduleCleanupDays", Type: ConfigTypeInteger, Description: "Schedule on-call history will be deleted after this many days (0 means disable cleanup).", Value: fmt.Sprintf("%d", cfg.Maintenance.ScheduleCleanupDays)},
+		{ID: "Maintenance.AuditLogCleanupDays", Type: ConfigTypeInteger, Description: "Audit log entries will be deleted after this many days (0 means disable cleanup).", Value: fmt.Sprintf("%d", cfg.Maintenance.AuditLogCleanupDays)},
+		{ID: "Maintenance.UnusedIntegrationKeyDays", Type: ConfigTypeInteger, Description: "Integration keys that have not been used for this many days will be flagged (0 means disable).", Value: fmt.Sprintf("%d", cfg.Maintenance.UnusedIntegrationKeyDays)},
+		{ID: "SafetyNet.Enable", Type: ConfigTypeBoolean, Description: "Escalate immediately past steps that are unable to notify anyone (or whose notifications all failed), and notify the safety net once the final step is reached without any successful notification.", Value: fmt.Sprintf("%t", cfg.SafetyNet.Enable)},
 		{ID: "Auth.DisableBasic", Type: ConfigTypeBoolean, Description: "Disallow username/password login.", Value: fmt.Sprintf("%t", cfg.Auth.DisableBasic)},
+		{ID: "Auth.RequireMFA", Type: ConfigTypeBoolean, Description: "Require multi-factor authentication (TOTP) for username/password login. Users without it will be asked to set it up on their next login.", Value: fmt.Sprintf("%t", cfg.Auth.RequireMFA)},
 		{ID: "GitHub.Enable", Type: ConfigTypeBoolean, Description: "Enable GitHub authentication.", Value: fmt.Sprintf("%t", cfg.GitHub.Enable)},
 		{ID: "OIDC.Enable", Type: ConfigTypeBoolean, Description: "Enable OpenID Connect authentication.", Value: fmt.Sprintf("%t", cfg.OIDC.Enable)},
+		{ID: "SAML.Enable", Type: ConfigTypeBoolean, Description: "Enable SAML 2.0 authentication.", Value: fmt.Sprintf("%t", cfg.SAML.Enable)},
+		{ID: "LDAP.Enable", Type: ConfigTypeBoolean, Description: "Enable LDAP authentication.", Value: fmt.Sprintf("%t", cfg.LDAP.Enable)},
+		{ID: "SCIM.Enable", Type: ConfigTypeBoolean, Description: "Enable the SCIM 2.0 user provisioning API. Requests must be authorized with an admin-scoped API key.", Value: fmt.Sprintf("%t", cfg.SCIM.Enable)},
 		{ID: "Mailgun.Enable", Type: ConfigTypeBoolean, Description: "", Value: fmt.Sprintf("%t", cfg.Mailgun.Enable)},
 		{ID: "Slack.Enable", Type: ConfigTypeBoolean, Description: "", Value: fmt.Sprintf("%t", cfg.Slack.Enable)},
 		{ID: "Twilio.Enable", Type: ConfigTypeBoolean, Description: "Enables sending and processing of Voice and SMS messages through the Twilio notification provider.", Value: fmt.Sprintf("%t", cfg.Twilio.Enable)},
@@ -201,6 +249,32 @@ func ApplyConfigValues(cfg config.Config, vals []ConfigValueInput) (config.Confi
 				return cfg, err
 			}
 			cfg.Maintenance.ScheduleCleanupDays = val
+		case "Maintenance.AuditLogCleanupDays":
+			val, err := parseInt(v.ID, v.Value)
+			if err != nil {
+				return cfg, err
+			}
+			cfg.Maintenance.AuditLogCleanupDays = val
+		case "Maintenance.UnusedIntegrationKeyDays":
+			val, err := parseInt(v.ID, v.Value)
+			if err != nil {
+				return cfg, err
+			}
+			cfg.Maintenance.UnusedIntegrationKeyDays = val
+		case "SafetyNet.Enable":
+			val, err := parseBool(v.ID, v.Value)
+			if err != nil {
+				return cfg, err
+			}
+			cfg.SafetyNet.Enable = val
+		case "SafetyNet.SlackChannelID":
+			cfg.SafetyNet.SlackChannelID = v.Value
+		case "SafetyNet.NotifyAdmins":
+			val, err := parseBool(v.ID, v.Value)
+			if err != nil {
+				return cfg, err
+			}
+			cfg.SafetyNet.NotifyAdmins = val
 		case "Auth.RefererURLs":
 			cfg.Auth.RefererURLs = parseStringList(v.Value)
 		case "Auth.DisableBasic":
@@ -209,6 +283,24 @@ func ApplyConfigValues(cfg config.Config, vals []ConfigValueInput) (config.Confi
 				return cfg, err
 			}
 			cfg.Auth.DisableBasic = val
+		case "Auth.RequireMFA":
+			val, err := parseBool(v.ID, v.Value)
+			if err != nil {
+				return cfg, err
+			}
+			cfg.Auth.RequireMFA = val
+		case "Auth.IdleSessionTimeoutMinutes":
+			val, err := parseInt(v.ID, v.Value)
+			if err != nil {
+				return cfg, err
+			}
+			cfg.Auth.IdleSessionTimeoutMinutes = val
+		case "Auth.MaxSessionAgeHours":
+			val, err := parseInt(v.ID, v.Value)
+			if err != nil {
+				return cfg, err
+			}
+			cfg.Auth.MaxSessionAgeHours = val
 		case "GitHub.Enable":
 			val, err := parseBool(v.ID, v.Value)
 			if err != nil {
@@ -229,6 +321,10 @@ func ApplyConfigValues(cfg config.Config, vals []ConfigValueInput) (config.Confi
 			cfg.GitHub.AllowedUsers = parseStringList(v.Value)
 		case "GitHub.AllowedOrgs":
 			cfg.GitHub.AllowedOrgs = parseStringList(v.Value)
+		case "GitHub.AdminOrgs":
+			cfg.GitHub.AdminOrgs = parseStringList(v.Value)
+		case "GitHub.TeamOrgs":
+			cfg.GitHub.TeamOrgs = parseStringList(v.Value)
 		case "GitHub.EnterpriseURL":
 			cfg.GitHub.EnterpriseURL = v.Value
 		case "OIDC.Enable":
@@ -259,6 +355,90 @@ func ApplyConfigValues(cfg config.Config, vals []ConfigValueInput) (config.Confi
 			cfg.OIDC.UserInfoEmailVerifiedPath = v.Value
 		case "OIDC.UserInfoNamePath":
 			cfg.OIDC.UserInfoNamePath = v.Value
+		case "OIDC.UserInfoGroupsPath":
+			cfg.OIDC.UserInfoGroupsPath = v.Value
+		case "OIDC.AdminGroups":
+			cfg.OIDC.AdminGroups = parseStringList(v.Value)
+		case "OIDC.TeamGroups":
+			cfg.OIDC.TeamGroups = parseStringList(v.Value)
+		case "SAML.Enable":
+			val, err := parseBool(v.ID, v.Value)
+			if err != nil {
+				return cfg, err
+			}
+			cfg.SAML.Enable = val
+		case "SAML.NewUsers":
+			val, err := parseBool(v.ID, v.Value)
+			if err != nil {
+				return cfg, err
+			}
+			cfg.SAML.NewUsers = val
+		case "SAML.OverrideName":
+			cfg.SAML.OverrideName = v.Value
+		case "SAML.IDPMetadataURL":
+			cfg.SAML.IDPMetadataURL = v.Value
+		case "SAML.EntityID":
+			cfg.SAML.EntityID = v.Value
+		case "SAML.Certificate":
+			cfg.SAML.Certificate = v.Value
+		case "SAML.PrivateKey":
+			cfg.SAML.PrivateKey = v.Value
+		case "SAML.NameAttribute":
+			cfg.SAML.NameAttribute = v.Value
+		case "SAML.EmailAttribute":
+			cfg.SAML.EmailAttribute = v.Value
+		case "LDAP.Enable":
+			val, err := parseBool(v.ID, v.Value)
+			if err != nil {
+				return cfg, err
+			}
+			cfg.LDAP.Enable = val
+		case "LDAP.NewUsers":
+			val, err := parseBool(v.ID, v.Value)
+			if err != nil {
+				return cfg, err
+			}
+			cfg.LDAP.NewUsers = val
+		case "LDAP.OverrideName":
+			cfg.LDAP.OverrideName = v.Value
+		case "LDAP.URL":
+			cfg.LDAP.URL = v.Value
+		case "LDAP.StartTLS":
+			val, err := parseBool(v.ID, v.Value)
+			if err != nil {
+				return cfg, err
+			}
+			cfg.LDAP.StartTLS = val
+		case "LDAP.SkipVerify":
+			val, err := parseBool(v.ID, v.Value)
+			if err != nil {
+				return cfg, err
+			}
+			cfg.LDAP.SkipVerify = val
+		case "LDAP.BindDN":
+			cfg.LDAP.BindDN = v.Value
+		case "LDAP.BindPassword":
+			cfg.LDAP.BindPassword = v.Value
+		case "LDAP.BaseDN":
+			cfg.LDAP.BaseDN = v.Value
+		case "LDAP.UserFilter":
+			cfg.LDAP.UserFilter = v.Value
+		case "LDAP.NameAttribute":
+			cfg.LDAP.NameAttribute = v.Value
+		case "LDAP.EmailAttribute":
+			cfg.LDAP.EmailAttribute = v.Value
+		case "LDAP.AllowedGroups":
+			cfg.LDAP.AllowedGroups = parseStringList(v.Value)
+		case "SCIM.Enable":
+			val, err := parseBool(v.ID, v.Value)
+			if err != nil {
+				return cfg, err
+			}
+			cfg.SCIM.Enable = val
+		case "SCIM.AuthProviderID":
+			cfg.SCIM.AuthProviderID = v.Value
+		case "SCIM.SubjectAttribute":
+			cfg.SCIM.SubjectAttribute = v.Value
 		case "Mailgun.Enable":
 			val, err := parseBool(v.ID, v.Value)
 			if err != nil {
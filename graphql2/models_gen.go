@@ -10,8 +10,11 @@ import (
 
 	"github.com/target/goalert/alert"
 	alertlog "github.com/target/goalert/alert/log"
+	"github.com/target/goalert/apikey"
 	"github.com/target/goalert/assignment"
+	"github.com/target/goalert/audit"
 	"github.com/target/goalert/escalation"
+	"github.com/target/goalert/integrationkey"
 	"github.com/target/goalert/label"
 	"github.com/target/goalert/limit"
 	"github.com/target/goalert/notification/slack"
@@ -58,6 +61,22 @@ type AlertSearchOptions struct {
 	NotCreatedBefore  *time.Time       `json:"notCreatedBefore"`
 }
 
+type AuditLogConnection struct {
+	Nodes    []audit.Entry `json:"nodes"`
+	PageInfo *PageInfo     `json:"pageInfo"`
+}
+
+type AuditLogSearchOptions struct {
+	First               *int                 `json:"first"`
+	After               *string              `json:"after"`
+	FilterByObjectType  []AuditLogObjectType `json:"filterByObjectType"`
+	FilterByObjectID    *string              `json:"filterByObjectID"`
+	FilterByActorUserID []string             `json:"filterByActorUserID"`
+	FilterByAction      []AuditLogAction     `json:"filterByAction"`
+	Start               *time.Time           `json:"start"`
+	End                 *time.Time           `json:"end"`
+}
+
 type AuthSubjectConnection struct {
 	Nodes    []user.AuthSubject `json:"nodes"`
 	PageInfo *PageInfo          `json:"pageInfo"`
@@ -77,6 +96,12 @@ type ClearTemporarySchedulesInput struct {
 	End        time.Time `json:"end"`
 }
 
+type CloneEscalationPolicyInput struct {
+	ID       string `json:"id"`
+	Name     string `json:"name"`
+	Favorite *bool  `json:"favorite"`
+}
+
 type ConfigHint struct {
 	ID    string `json:"id"`
 	Value string `json:"value"`
@@ -95,6 +120,15 @@ type ConfigValueInput struct {
 	Value string `json:"value"`
 }
 
+type CreateAPIKeyInput struct {
+	Name           string       `json:"name"`
+	Description    *string      `json:"description"`
+	UserID         *string      `json:"userID"`
+	ServiceAccount *bool        `json:"serviceAccount"`
+	Scope          apikey.Scope `json:"scope"`
+	ExpiresAt      *time.Time   `json:"expiresAt"`
+}
+
 type CreateAlertInput struct {
 	Summary   string  `json:"summary"`
 	Details   *string `json:"details"`
@@ -102,20 +136,51 @@ type CreateAlertInput struct {
 	Sanitize  *bool   `json:"sanitize"`
 }
 
+type CreateEscalationPolicyFromTemplateInput struct {
+	TemplateID  string                               `json:"templateID"`
+	Name        string                               `json:"name"`
+	Description *string                              `json:"description"`
+	Favorite    *bool                                `json:"favorite"`
+	Params      []EscalationPolicyTemplateParamInput `json:"params"`
+}
+
 type CreateEscalationPolicyInput struct {
-	Name        string                            `json:"name"`
-	Description *string                           `json:"description"`
-	Repeat      *int                              `json:"repeat"`
-	Favorite    *bool                             `json:"favorite"`
-	Steps       []CreateEscalationPolicyStepInput `json:"steps"`
+	Name                 string                            `json:"name"`
+	Description          *string                           `json:"description"`
+	Repeat               *int                              `json:"repeat"`
+	RepeatBackoffMinutes *int                              `json:"repeatBackoffMinutes"`
+	FallbackTarget       *assignment.RawTarget             `json:"fallbackTarget"`
+	Favorite             *bool                             `json:"favorite"`
+	TeamID               *string                           `json:"teamID"`
+	Steps                []CreateEscalationPolicyStepInput `json:"steps"`
 }
 
 type CreateEscalationPolicyStepInput struct {
-	EscalationPolicyID *string                `json:"escalationPolicyID"`
-	DelayMinutes       int                    `json:"delayMinutes"`
-	Targets            []assignment.RawTarget `json:"targets"`
-	NewRotation        *CreateRotationInput   `json:"newRotation"`
-	NewSchedule        *CreateScheduleInput   `json:"newSchedule"`
+	EscalationPolicyID     *string                       `json:"escalationPolicyID"`
+	DelayMinutes           int                           `json:"delayMinutes"`
+	Targets                []assignment.RawTarget        `json:"targets"`
+	NewRotation            *CreateRotationInput          `json:"newRotation"`
+	NewSchedule            *CreateScheduleInput          `json:"newSchedule"`
+	Condition              *EscalationStepConditionInput `json:"condition"`
+	TargetMode             *EscalationStepTargetMode     `json:"targetMode"`
+	SequentialDelayMinutes *int                          `json:"sequentialDelayMinutes"`
+}
+
+type CreateEscalationPolicyTemplateInput struct {
+	Name                 string                                    `json:"name"`
+	Description          *string                                   `json:"description"`
+	Repeat               *int                                      `json:"repeat"`
+	RepeatBackoffMinutes *int                                      `json:"repeatBackoffMinutes"`
+	TeamID               *string                                   `json:"teamID"`
+	Steps                []CreateEscalationPolicyTemplateStepInput `json:"steps"`
+}
+
+type CreateEscalationPolicyTemplateStepInput struct {
+	DelayMinutes           int                       `json:"delayMinutes"`
+	TargetMode             *EscalationStepTargetMode `json:"targetMode"`
+	SequentialDelayMinutes *int                      `json:"sequentialDelayMinutes"`
+	Targets                []assignment.RawTarget    `json:"targets"`
+	Params                 []string                  `json:"params"`
 }
 
 type CreateHeartbeatMonitorInput struct {
@@ -128,6 +193,7 @@ type CreateIntegrationKeyInput struct {
 	ServiceID *string            `json:"serviceID"`
 	Type      IntegrationKeyType `json:"type"`
 	Name      string             `json:"name"`
+	ExpiresAt *time.Time         `json:"expiresAt"`
 }
 
 type CreateRotationInput struct {
@@ -138,6 +204,7 @@ type CreateRotationInput struct {
 	Favorite    *bool         `json:"favorite"`
 	Type        rotation.Type `json:"type"`
 	ShiftLength *int          `json:"shiftLength"`
+	TeamID      *string       `json:"teamID"`
 	UserIDs     []string      `json:"userIDs"`
 }
 
@@ -146,26 +213,38 @@ type CreateScheduleInput struct {
 	Description      *string                   `json:"description"`
 	TimeZone         string                    `json:"timeZone"`
 	Favorite         *bool                     `json:"favorite"`
+	TeamID           *string                   `json:"teamID"`
 	Targets          []ScheduleTargetInput     `json:"targets"`
 	NewUserOverrides []CreateUserOverrideInput `json:"newUserOverrides"`
 }
 
 type CreateServiceInput struct {
-	Name                 string                        `json:"name"`
-	Description          *string                       `json:"description"`
-	Favorite             *bool                         `json:"favorite"`
-	EscalationPolicyID   *string                       `json:"escalationPolicyID"`
-	NewEscalationPolicy  *CreateEscalationPolicyInput  `json:"newEscalationPolicy"`
-	NewIntegrationKeys   []CreateIntegrationKeyInput   `json:"newIntegrationKeys"`
-	Labels               []SetLabelInput               `json:"labels"`
-	NewHeartbeatMonitors []CreateHeartbeatMonitorInput `json:"newHeartbeatMonitors"`
+	Name                    string                        `json:"name"`
+	Description             *string                       `json:"description"`
+	Favorite                *bool                         `json:"favorite"`
+	EscalationPolicyID      *string                       `json:"escalationPolicyID"`
+	NewEscalationPolicy     *CreateEscalationPolicyInput  `json:"newEscalationPolicy"`
+	AckTimeoutMinutes       *int                          `json:"ackTimeoutMinutes"`
+	AckTimeoutLimit         *int                          `json:"ackTimeoutLimit"`
+	AlertStormThreshold     *int                          `json:"alertStormThreshold"`
+	AlertStormWindowMinutes *int                          `json:"alertStormWindowMinutes"`
+	TeamID                  *string                       `json:"teamID"`
+	NewIntegrationKeys      []CreateIntegrationKeyInput   `json:"newIntegrationKeys"`
+	Labels                  []SetLabelInput               `json:"labels"`
+	NewHeartbeatMonitors    []CreateHeartbeatMonitorInput `json:"newHeartbeatMonitors"`
+}
+
+type CreateTeamInput struct {
+	Name        string   `json:"name"`
+	Description *string  `json:"description"`
+	UserIDs     []string `json:"userIDs"`
 }
 
 type CreateUserCalendarSubscriptionInput struct {
-	Name            string `json:"name"`
-	ReminderMinutes []int  `json:"reminderMinutes"`
-	ScheduleID      string `json:"scheduleID"`
-	Disabled        *bool  `json:"disabled"`
+	Name            string  `json:"name"`
+	ReminderMinutes []int   `json:"reminderMinutes"`
+	ScheduleID      *string `json:"scheduleID"`
+	Disabled        *bool   `json:"disabled"`
 }
 
 type CreateUserContactMethodInput struct {
@@ -199,6 +278,13 @@ type CreateUserOverrideInput struct {
 	RemoveUserID *string   `json:"removeUserID"`
 }
 
+type CreateUserTimeOffInput struct {
+	UserID      string    `json:"userID"`
+	Start       time.Time `json:"start"`
+	End         time.Time `json:"end"`
+	Description *string   `json:"description"`
+}
+
 type DebugCarrierInfoInput struct {
 	Number string `json:"number"`
 }
@@ -259,6 +345,50 @@ type EscalationPolicySearchOptions struct {
 	FavoritesFirst *bool    `json:"favoritesFirst"`
 }
 
+type EscalationPolicyTemplateParamInput struct {
+	Name   string                `json:"name"`
+	Target *assignment.RawTarget `json:"target"`
+}
+
+type EscalationStepConditionInput struct {
+	Source       *string                   `json:"source"`
+	SummaryRegex *string                   `json:"summaryRegex"`
+	Hours        *EscalationStepHoursInput `json:"hours"`
+}
+
+type EscalationStepHoursInput struct {
+	TimeZone      string                 `json:"timeZone"`
+	Start         timeutil.Clock         `json:"start"`
+	End           timeutil.Clock         `json:"end"`
+	WeekdayFilter timeutil.WeekdayFilter `json:"weekdayFilter"`
+	Outside       *bool                  `json:"outside"`
+}
+
+type IntegrationKeyRouteConditionInput struct {
+	Field IntegrationKeyRouteField `json:"field"`
+	Key   *string                  `json:"key"`
+	Regex string                   `json:"regex"`
+}
+
+type IntegrationKeyRouteMetaInput struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+type IntegrationKeyRouteResult struct {
+	Rule      *integrationkey.RoutingRule `json:"rule"`
+	RuleIndex int                         `json:"ruleIndex"`
+	Dropped   bool                        `json:"dropped"`
+	Service   *service.Service            `json:"service"`
+}
+
+type IntegrationKeyRoutingRuleInput struct {
+	Name       string                              `json:"name"`
+	Conditions []IntegrationKeyRouteConditionInput `json:"conditions"`
+	Action     IntegrationKeyRouteAction           `json:"action"`
+	ServiceID  *string                             `json:"serviceID"`
+}
+
 type LabelConnection struct {
 	Nodes    []label.Label `json:"nodes"`
 	PageInfo *PageInfo     `json:"pageInfo"`
@@ -293,6 +423,22 @@ type NotificationState struct {
 	FormattedSrcValue string              `json:"formattedSrcValue"`
 }
 
+type OnCallSnapshotInput struct {
+	ServiceID          *string   `json:"serviceID"`
+	EscalationPolicyID *string   `json:"escalationPolicyID"`
+	Time               time.Time `json:"time"`
+}
+
+type OnCallWorkloadReportInput struct {
+	Start         time.Time       `json:"start"`
+	End           time.Time       `json:"end"`
+	ScheduleIDs   []string        `json:"scheduleIDs"`
+	UserIDs       []string        `json:"userIDs"`
+	TimeZone      *string         `json:"timeZone"`
+	BusinessStart *timeutil.Clock `json:"businessStart"`
+	BusinessEnd   *timeutil.Clock `json:"businessEnd"`
+}
+
 type PageInfo struct {
 	EndCursor   *string `json:"endCursor"`
 	HasNextPage bool    `json:"hasNextPage"`
@@ -307,6 +453,11 @@ type PhoneNumberInfo struct {
 	Error       string `json:"error"`
 }
 
+type RotateIntegrationKeyInput struct {
+	ID               string `json:"id"`
+	GracePeriodHours *int   `json:"gracePeriodHours"`
+}
+
 type RotationConnection struct {
 	Nodes    []rotation.Rotation `json:"nodes"`
 	PageInfo *PageInfo           `json:"pageInfo"`
@@ -378,6 +529,16 @@ type SetFavoriteInput struct {
 	Favorite bool                  `json:"favorite"`
 }
 
+type SetIntegrationKeyExpirationInput struct {
+	ID        string     `json:"id"`
+	ExpiresAt *time.Time `json:"expiresAt"`
+}
+
+type SetIntegrationKeyRoutingRulesInput struct {
+	IntegrationKeyID string                           `json:"integrationKeyID"`
+	Rules            []IntegrationKeyRoutingRuleInput `json:"rules"`
+}
+
 type SetLabelInput struct {
 	Target *assignment.RawTarget `json:"target"`
 	Key    string                `json:"key"`
@@ -398,6 +559,26 @@ type SetTemporaryScheduleInput struct {
 	Shifts     []schedule.FixedShift `json:"shifts"`
 }
 
+type SimulateEscalationPolicyInput struct {
+	EscalationPolicyID   *string                             `json:"escalationPolicyID"`
+	Steps                []SimulateEscalationPolicyStepInput `json:"steps"`
+	Repeat               *int                                `json:"repeat"`
+	RepeatBackoffMinutes *int                                `json:"repeatBackoffMinutes"`
+	FallbackTarget       *assignment.RawTarget               `json:"fallbackTarget"`
+	Start                time.Time                           `json:"start"`
+	End                  *time.Time                          `json:"end"`
+	AlertSource          *string                             `json:"alertSource"`
+	AlertSummary         *string                             `json:"alertSummary"`
+}
+
+type SimulateEscalationPolicyStepInput struct {
+	DelayMinutes           int                           `json:"delayMinutes"`
+	Targets                []assignment.RawTarget        `json:"targets"`
+	Condition              *EscalationStepConditionInput `json:"condition"`
+	TargetMode             *EscalationStepTargetMode     `json:"targetMode"`
+	SequentialDelayMinutes *int                          `json:"sequentialDelayMinutes"`
+}
+
 type SlackChannelConnection struct {
 	Nodes    []slack.Channel `json:"nodes"`
 	PageInfo *PageInfo       `json:"pageInfo"`
@@ -410,6 +591,11 @@ type SlackChannelSearchOptions struct {
 	Omit   []string `json:"omit"`
 }
 
+type SnoozeAlertInput struct {
+	AlertID int       `json:"alertID"`
+	Until   time.Time `json:"until"`
+}
+
 type StringConnection struct {
 	Nodes    []string  `json:"nodes"`
 	PageInfo *PageInfo `json:"pageInfo"`
@@ -426,6 +612,14 @@ type SystemLimitInput struct {
 	Value int      `json:"value"`
 }
 
+type TestIntegrationKeyRouteInput struct {
+	IntegrationKeyID string                           `json:"integrationKeyID"`
+	Summary          string                           `json:"summary"`
+	Details          *string                          `json:"details"`
+	Meta             []IntegrationKeyRouteMetaInput   `json:"meta"`
+	Rules            []IntegrationKeyRoutingRuleInput `json:"rules"`
+}
+
 type TimeZone struct {
 	ID string `json:"id"`
 }
@@ -453,17 +647,24 @@ type UpdateAlertsInput struct {
 }
 
 type UpdateEscalationPolicyInput struct {
-	ID          string   `json:"id"`
-	Name        *string  `json:"name"`
-	Description *string  `json:"description"`
-	Repeat      *int     `json:"repeat"`
-	StepIDs     []string `json:"stepIDs"`
+	ID                   string                `json:"id"`
+	Name                 *string               `json:"name"`
+	Description          *string               `json:"description"`
+	Repeat               *int                  `json:"repeat"`
+	RepeatBackoffMinutes *int                  `json:"repeatBackoffMinutes"`
+	FallbackTarget       *assignment.RawTarget `json:"fallbackTarget"`
+	ClearFallbackTarget  *bool                 `json:"clearFallbackTarget"`
+	StepIDs              []string              `json:"stepIDs"`
+	TeamID               *string               `json:"teamID"`
 }
 
 type UpdateEscalationPolicyStepInput struct {
-	ID           string                 `json:"id"`
-	DelayMinutes *int                   `json:"delayMinutes"`
-	Targets      []assignment.RawTarget `json:"targets"`
+	ID                     string                        `json:"id"`
+	DelayMinutes           *int                          `json:"delayMinutes"`
+	Targets                []assignment.RawTarget        `json:"targets"`
+	Condition              *EscalationStepConditionInput `json:"condition"`
+	TargetMode             *EscalationStepTargetMode     `json:"targetMode"`
+	SequentialDelayMinutes *int                          `json:"sequentialDelayMinutes"`
 }
 
 type UpdateHeartbeatMonitorInput struct {
@@ -480,6 +681,7 @@ type UpdateRotationInput struct {
 	Start           *time.Time     `json:"start"`
 	Type            *rotation.Type `json:"type"`
 	ShiftLength     *int           `json:"shiftLength"`
+	TeamID          *string        `json:"teamID"`
 	ActiveUserIndex *int           `json:"activeUserIndex"`
 	UserIDs         []string       `json:"userIDs"`
 }
@@ -489,13 +691,31 @@ type UpdateScheduleInput struct {
 	Name        *string `json:"name"`
 	Description *string `json:"description"`
 	TimeZone    *string `json:"timeZone"`
+	TeamID      *string `json:"teamID"`
 }
 
 type UpdateServiceInput struct {
-	ID                 string  `json:"id"`
-	Name               *string `json:"name"`
-	Description        *string `json:"description"`
-	EscalationPolicyID *string `json:"escalationPolicyID"`
+	ID                      string  `json:"id"`
+	Name                    *string `json:"name"`
+	Description             *string `json:"description"`
+	EscalationPolicyID      *string `json:"escalationPolicyID"`
+	AckTimeoutMinutes       *int    `json:"ackTimeoutMinutes"`
+	AckTimeoutLimit         *int    `json:"ackTimeoutLimit"`
+	AlertStormThreshold     *int    `json:"alertStormThreshold"`
+	AlertStormWindowMinutes *int    `json:"alertStormWindowMinutes"`
+	TeamID                  *string `json:"teamID"`
+}
+
+type UpdateTeamInput struct {
+	ID          string  `json:"id"`
+	Name        *string `json:"name"`
+	Description *string `json:"description"`
+}
+
+type UpdateTeamMembersInput struct {
+	TeamID        string   `json:"teamID"`
+	AddUserIDs    []string `json:"addUserIDs"`
+	RemoveUserIDs []string `json:"removeUserIDs"`
 }
 
 type UpdateUserCalendarSubscriptionInput struct {
@@ -506,9 +726,11 @@ type UpdateUserCalendarSubscriptionInput struct {
 }
 
 type UpdateUserContactMethodInput struct {
-	ID    string  `json:"id"`
-	Name  *string `json:"name"`
-	Value *string `json:"value"`
+	ID                        string  `json:"id"`
+	Name                      *string `json:"name"`
+	Value                     *string `json:"value"`
+	ShiftStartReminderMinutes *int    `json:"shiftStartReminderMinutes"`
+	ShiftEndNotification      *bool   `json:"shiftEndNotification"`
 }
 
 type UpdateUserInput struct {
@@ -651,6 +873,155 @@ func (e AlertStatus) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
+type AuditLogAction string
+
+const (
+	AuditLogActionCreate AuditLogAction = "create"
+	AuditLogActionUpdate AuditLogAction = "update"
+	AuditLogActionDelete AuditLogAction = "delete"
+)
+
+var AllAuditLogAction = []AuditLogAction{
+	AuditLogActionCreate,
+	AuditLogActionUpdate,
+	AuditLogActionDelete,
+}
+
+func (e AuditLogAction) IsValid() bool {
+	switch e {
+	case AuditLogActionCreate, AuditLogActionUpdate, AuditLogActionDelete:
+		return true
+	}
+	return false
+}
+
+func (e AuditLogAction) String() string {
+	return string(e)
+}
+
+func (e *AuditLogAction) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = AuditLogAction(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid AuditLogAction", str)
+	}
+	return nil
+}
+
+func (e AuditLogAction) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type AuditLogActorType string
+
+const (
+	AuditLogActorTypeSystem         AuditLogActorType = "system"
+	AuditLogActorTypeUser           AuditLogActorType = "user"
+	AuditLogActorTypeAPIKey         AuditLogActorType = "apiKey"
+	AuditLogActorTypeIntegrationKey AuditLogActorType = "integrationKey"
+)
+
+var AllAuditLogActorType = []AuditLogActorType{
+	AuditLogActorTypeSystem,
+	AuditLogActorTypeUser,
+	AuditLogActorTypeAPIKey,
+	AuditLogActorTypeIntegrationKey,
+}
+
+func (e AuditLogActorType) IsValid() bool {
+	switch e {
+	case AuditLogActorTypeSystem, AuditLogActorTypeUser, AuditLogActorTypeAPIKey, AuditLogActorTypeIntegrationKey:
+		return true
+	}
+	return false
+}
+
+func (e AuditLogActorType) String() string {
+	return string(e)
+}
+
+func (e *AuditLogActorType) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = AuditLogActorType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid AuditLogActorType", str)
+	}
+	return nil
+}
+
+func (e AuditLogActorType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type AuditLogObjectType string
+
+const (
+	AuditLogObjectTypeService                    AuditLogObjectType = "service"
+	AuditLogObjectTypeIntegrationKey             AuditLogObjectType = "integrationKey"
+	AuditLogObjectTypeEscalationPolicy           AuditLogObjectType = "escalationPolicy"
+	AuditLogObjectTypeEscalationPolicyStep       AuditLogObjectType = "escalationPolicyStep"
+	AuditLogObjectTypeEscalationPolicyStepTarget AuditLogObjectType = "escalationPolicyStepTarget"
+	AuditLogObjectTypeSchedule                   AuditLogObjectType = "schedule"
+	AuditLogObjectTypeScheduleRule               AuditLogObjectType = "scheduleRule"
+	AuditLogObjectTypeRotation                   AuditLogObjectType = "rotation"
+	AuditLogObjectTypeRotationParticipant        AuditLogObjectType = "rotationParticipant"
+	AuditLogObjectTypeUser                       AuditLogObjectType = "user"
+	AuditLogObjectTypeContactMethod              AuditLogObjectType = "contactMethod"
+	AuditLogObjectTypeConfig                     AuditLogObjectType = "config"
+)
+
+var AllAuditLogObjectType = []AuditLogObjectType{
+	AuditLogObjectTypeService,
+	AuditLogObjectTypeIntegrationKey,
+	AuditLogObjectTypeEscalationPolicy,
+	AuditLogObjectTypeEscalationPolicyStep,
+	AuditLogObjectTypeEscalationPolicyStepTarget,
+	AuditLogObjectTypeSchedule,
+	AuditLogObjectTypeScheduleRule,
+	AuditLogObjectTypeRotation,
+	AuditLogObjectTypeRotationParticipant,
+	AuditLogObjectTypeUser,
+	AuditLogObjectTypeContactMethod,
+	AuditLogObjectTypeConfig,
+}
+
+func (e AuditLogObjectType) IsValid() bool {
+	switch e {
+	case AuditLogObjectTypeService, AuditLogObjectTypeIntegrationKey, AuditLogObjectTypeEscalationPolicy, AuditLogObjectTypeEscalationPolicyStep, AuditLogObjectTypeEscalationPolicyStepTarget, AuditLogObjectTypeSchedule, AuditLogObjectTypeScheduleRule, AuditLogObjectTypeRotation, AuditLogObjectTypeRotationParticipant, AuditLogObjectTypeUser, AuditLogObjectTypeContactMethod, AuditLogObjectTypeConfig:
+		return true
+	}
+	return false
+}
+
+func (e AuditLogObjectType) String() string {
+	return string(e)
+}
+
+func (e *AuditLogObjectType) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = AuditLogObjectType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid AuditLogObjectType", str)
+	}
+	return nil
+}
+
+func (e AuditLogObjectType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 type ConfigType string
 
 const (
@@ -696,6 +1067,133 @@ func (e ConfigType) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
+type EscalationStepTargetMode string
+
+const (
+	EscalationStepTargetModeAll        EscalationStepTargetMode = "all"
+	EscalationStepTargetModeRoundRobin EscalationStepTargetMode = "round_robin"
+	EscalationStepTargetModeSequential EscalationStepTargetMode = "sequential"
+)
+
+var AllEscalationStepTargetMode = []EscalationStepTargetMode{
+	EscalationStepTargetModeAll,
+	EscalationStepTargetModeRoundRobin,
+	EscalationStepTargetModeSequential,
+}
+
+func (e EscalationStepTargetMode) IsValid() bool {
+	switch e {
+	case EscalationStepTargetModeAll, EscalationStepTargetModeRoundRobin, EscalationStepTargetModeSequential:
+		return true
+	}
+	return false
+}
+
+func (e EscalationStepTargetMode) String() string {
+	return string(e)
+}
+
+func (e *EscalationStepTargetMode) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = EscalationStepTargetMode(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid EscalationStepTargetMode", str)
+	}
+	return nil
+}
+
+func (e EscalationStepTargetMode) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type IntegrationKeyRouteAction string
+
+const (
+	IntegrationKeyRouteActionRedirect IntegrationKeyRouteAction = "redirect"
+	IntegrationKeyRouteActionDrop     IntegrationKeyRouteAction = "drop"
+)
+
+var AllIntegrationKeyRouteAction = []IntegrationKeyRouteAction{
+	IntegrationKeyRouteActionRedirect,
+	IntegrationKeyRouteActionDrop,
+}
+
+func (e IntegrationKeyRouteAction) IsValid() bool {
+	switch e {
+	case IntegrationKeyRouteActionRedirect, IntegrationKeyRouteActionDrop:
+		return true
+	}
+	return false
+}
+
+func (e IntegrationKeyRouteAction) String() string {
+	return string(e)
+}
+
+func (e *IntegrationKeyRouteAction) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = IntegrationKeyRouteAction(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid IntegrationKeyRouteAction", str)
+	}
+	return nil
+}
+
+func (e IntegrationKeyRouteAction) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type IntegrationKeyRouteField string
+
+const (
+	IntegrationKeyRouteFieldSummary IntegrationKeyRouteField = "summary"
+	IntegrationKeyRouteFieldDetails IntegrationKeyRouteField = "details"
+	IntegrationKeyRouteFieldMeta    IntegrationKeyRouteField = "meta"
+)
+
+var AllIntegrationKeyRouteField = []IntegrationKeyRouteField{
+	IntegrationKeyRouteFieldSummary,
+	IntegrationKeyRouteFieldDetails,
+	IntegrationKeyRouteFieldMeta,
+}
+
+func (e IntegrationKeyRouteField) IsValid() bool {
+	switch e {
+	case IntegrationKeyRouteFieldSummary, IntegrationKeyRouteFieldDetails, IntegrationKeyRouteFieldMeta:
+		return true
+	}
+	return false
+}
+
+func (e IntegrationKeyRouteField) String() string {
+	return string(e)
+}
+
+func (e *IntegrationKeyRouteField) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = IntegrationKeyRouteField(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid IntegrationKeyRouteField", str)
+	}
+	return nil
+}
+
+func (e IntegrationKeyRouteField) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 type IntegrationKeyType string
 
 const (
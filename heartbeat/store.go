@@ -25,6 +25,8 @@ type Store struct {
 	getSvcID   *sql.Stmt
 	findOneUpd *sql.Stmt
 	heartbeat  *sql.Stmt
+
+	findTeamIDs *sql.Stmt
 }
 
 // NewStore creates a new Store and prepares all sql statements.
@@ -76,6 +78,16 @@ func NewStore(ctx context.Context, db *sql.DB) (*Store, error) {
 			set last_heartbeat = now()
 			where id = $1
 		`),
+
+		findTeamIDs: p.P(`
+			select array(
+				select distinct coalesce(team_id::text, '')
+				from services
+				where
+					id = any($1) or
+					id in (select service_id from heartbeat_monitors where id = any($1))
+			)
+		`),
 	}, p.Err
 }
 
@@ -96,6 +108,11 @@ func (s *Store) CreateTx(ctx context.Context, tx *sql.Tx, m *Monitor) (*Monitor,
 		return nil, err
 	}
 
+	err = s.checkOwner(ctx, tx, n.ServiceID)
+	if err != nil {
+		return nil, err
+	}
+
 	n.ID = uuid.New().String()
 	n.lastState = StateInactive
 	_, err = tx.StmtContext(ctx, s.create).ExecContext(ctx, n.ID, n.Name, n.ServiceID, &timeout)
@@ -130,6 +147,11 @@ func (s *Store) DeleteTx(ctx context.Context, tx *sql.Tx, ids ...string) error {
 		return err
 	}
 
+	err = s.checkOwner(ctx, tx, ids...)
+	if err != nil {
+		return err
+	}
+
 	stmt := s.delete
 	if tx != nil {
 		stmt = tx.StmtContext(ctx, stmt)
@@ -159,6 +181,11 @@ func (s *Store) UpdateTx(ctx context.Context, tx *sql.Tx, m *Monitor) error {
 		return err
 	}
 
+	err = s.checkOwner(ctx, tx, n.ID)
+	if err != nil {
+		return err
+	}
+
 	stmt := s.update
 	if tx != nil {
 		stmt = tx.StmtContext(ctx, stmt)
@@ -186,6 +213,11 @@ func (s *Store) FindOneTx(ctx context.Context, tx *sql.Tx, id string) (*Monitor,
 		return nil, err
 	}
 
+	err = s.checkOwner(ctx, tx, id)
+	if err != nil {
+		return nil, err
+	}
+
 	row := tx.StmtContext(ctx, s.findOneUpd).QueryRowContext(ctx, id)
 
 	var m Monitor
@@ -271,3 +303,20 @@ func (s *Store) FindAllByService(ctx context.Context, serviceID string) ([]Monit
 
 	return monitors, nil
 }
+
+// checkOwner will return an error if the context may not modify the given services or the
+// services of the given monitors.
+func (s *Store) checkOwner(ctx context.Context, tx *sql.Tx, ids ...string) error {
+	stmt := s.findTeamIDs
+	if tx != nil {
+		stmt = tx.StmtContext(ctx, stmt)
+	}
+
+	var teamIDs sqlutil.StringArray
+	err := stmt.QueryRowContext(ctx, sqlutil.UUIDArray(ids)).Scan(&teamIDs)
+	if err != nil {
+		return err
+	}
+
+	return permission.LimitCheckOwner(ctx, teamIDs...)
+}
@@ -1,6 +1,8 @@
 package integrationkey
 
 import (
+	"time"
+
 	"github.com/target/goalert/validation/validate"
 )
 
@@ -9,6 +11,20 @@ type IntegrationKey struct {
 	Name      string `json:"name"`
 	Type      Type   `json:"type"`
 	ServiceID string `json:"service_id"`
+
+	// Secret is the token used to authorize requests. It is the same as ID until the key is rotated.
+	Secret string `json:"-"`
+
+	// ExpiresAt, if set, is the time after which the key will no longer be accepted.
+	ExpiresAt time.Time `json:"expires_at,omitempty"`
+
+	// PrevSecretExpiresAt, if set, is the end of the grace period during which the secret in use
+	// before the last rotation is still accepted.
+	PrevSecretExpiresAt time.Time `json:"-"`
+
+	CreatedAt    time.Time `json:"-"`
+	LastUsedAt   time.Time `json:"-"`
+	RequestCount int64     `json:"-"`
 }
 
 func (i IntegrationKey) Normalize() (*IntegrationKey, error) {
@@ -0,0 +1,67 @@
+package integrationkey
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/target/goalert/notice"
+)
+
+// expiryNoticeWindow is how far ahead of expiration a key will be flagged.
+const expiryNoticeWindow = 7 * 24 * time.Hour
+
+// Notices returns any notices about the state of the key as of now, such as an upcoming expiration
+// or the key not being used for at least unusedDays (0 disables the check).
+func (i IntegrationKey) Notices(unusedDays int, now time.Time) []notice.Notice {
+	const timeFmt = "Jan 2, 2006 3:04PM MST"
+	notices := []notice.Notice{}
+
+	switch {
+	case i.ExpiresAt.IsZero():
+	case !i.ExpiresAt.After(now):
+		notices = append(notices, notice.Notice{
+			Type:    notice.TypeError,
+			Message: "Key has expired",
+			Details: fmt.Sprintf("Requests using this key have been rejected since %s.", i.ExpiresAt.Format(timeFmt)),
+		})
+	case i.ExpiresAt.Sub(now) <= expiryNoticeWindow:
+		notices = append(notices, notice.Notice{
+			Type:    notice.TypeWarning,
+			Message: "Key expires soon",
+			Details: fmt.Sprintf("Requests using this key will be rejected after %s.", i.ExpiresAt.Format(timeFmt)),
+		})
+	}
+
+	if i.PrevSecretExpiresAt.After(now) {
+		notices = append(notices, notice.Notice{
+			Type:    notice.TypeInfo,
+			Message: "Previous secret still accepted",
+			Details: fmt.Sprintf("The secret in use before the last rotation will be accepted until %s.", i.PrevSecretExpiresAt.Format(timeFmt)),
+		})
+	}
+
+	if unusedDays <= 0 {
+		return notices
+	}
+
+	lastUsed := i.LastUsedAt
+	if lastUsed.IsZero() {
+		lastUsed = i.CreatedAt
+	}
+	if !lastUsed.IsZero() && now.Sub(lastUsed) >= time.Duration(unusedDays)*24*time.Hour {
+		msg := fmt.Sprintf("Key has not been used in %d days", unusedDays)
+		details := "Consider deleting it if it is no longer needed."
+		if i.LastUsedAt.IsZero() {
+			msg = "Key has never been used"
+		} else {
+			details = fmt.Sprintf("Last used %s. %s", i.LastUsedAt.Format(timeFmt), details)
+		}
+		notices = append(notices, notice.Notice{
+			Type:    notice.TypeWarning,
+			Message: msg,
+			Details: details,
+		})
+	}
+
+	return notices
+}
@@ -0,0 +1,49 @@
+package integrationkey
+
+import (
+	"testing"
+	"time"
+
+	"github.com/target/goalert/notice"
+)
+
+func TestIntegrationKey_Notices(t *testing.T) {
+	now := time.Date(2021, 9, 19, 12, 0, 0, 0, time.UTC)
+	day := 24 * time.Hour
+
+	check := func(name string, k IntegrationKey, unusedDays int, exp ...notice.Type) {
+		t.Helper()
+		t.Run(name, func(t *testing.T) {
+			t.Helper()
+			n := k.Notices(unusedDays, now)
+			if len(n) != len(exp) {
+				t.Fatalf("got %d notices %+v; want %d", len(n), n, len(exp))
+			}
+			for i, typ := range exp {
+				if n[i].Type != typ {
+					t.Errorf("notice[%d].Type = %s; want %s", i, n[i].Type, typ)
+				}
+			}
+		})
+	}
+
+	check("new key", IntegrationKey{CreatedAt: now.Add(-day)}, 30)
+	check("never used", IntegrationKey{CreatedAt: now.Add(-31 * day)}, 30, notice.TypeWarning)
+	check("recently used", IntegrationKey{CreatedAt: now.Add(-100 * day), LastUsedAt: now.Add(-day)}, 30)
+	check("unused", IntegrationKey{CreatedAt: now.Add(-100 * day), LastUsedAt: now.Add(-30 * day)}, 30, notice.TypeWarning)
+	check("unused check disabled", IntegrationKey{CreatedAt: now.Add(-100 * day)}, 0)
+
+	check("expired", IntegrationKey{CreatedAt: now, ExpiresAt: now.Add(-time.Minute)}, 30, notice.TypeError)
+	check("expires soon", IntegrationKey{CreatedAt: now, ExpiresAt: now.Add(6 * day)}, 30, notice.TypeWarning)
+	check("expires later", IntegrationKey{CreatedAt: now, ExpiresAt: now.Add(8 * day)}, 30)
+
+	check("grace period", IntegrationKey{CreatedAt: now, PrevSecretExpiresAt: now.Add(time.Hour)}, 30, notice.TypeInfo)
+	check("grace period ended", IntegrationKey{CreatedAt: now, PrevSecretExpiresAt: now.Add(-time.Hour)}, 30)
+
+	check("all", IntegrationKey{
+		CreatedAt:           now.Add(-100 * day),
+		LastUsedAt:          now.Add(-50 * day),
+		ExpiresAt:           now.Add(day),
+		PrevSecretExpiresAt: now.Add(day),
+	}, 30, notice.TypeWarning, notice.TypeInfo, notice.TypeWarning)
+}
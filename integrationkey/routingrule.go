@@ -0,0 +1,171 @@
+package integrationkey
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/target/goalert/validation"
+	"github.com/target/goalert/validation/validate"
+)
+
+// MaxRoutingRules is the maximum number of routing rules for a single integration key.
+const MaxRoutingRules = 50
+
+// RouteAction is the action taken when a routing rule matches.
+type RouteAction string
+
+// Route actions
+const (
+	RouteActionRedirect RouteAction = "redirect" // create the alert on a different service
+	RouteActionDrop     RouteAction = "drop"     // discard the alert
+)
+
+// RouteField is the alert field a routing condition is matched against.
+type RouteField string
+
+// Route fields
+const (
+	RouteFieldSummary RouteField = "summary"
+	RouteFieldDetails RouteField = "details"
+	RouteFieldMeta    RouteField = "meta" // integration-provided metadata, like Alertmanager labels
+)
+
+// RouteCondition matches a single alert field against a regular expression.
+type RouteCondition struct {
+	Field RouteField `json:"field"`
+
+	// Key is the metadata key, only used with RouteFieldMeta. Keys are matched
+	// exactly as provided by the integration (i.e., case-sensitive).
+	Key string `json:"key,omitempty"`
+
+	Regex string `json:"regex"`
+
+	re *regexp.Regexp
+}
+
+// compile will compile the condition regex, so it is only done once when rules are loaded.
+func (c *RouteCondition) compile() (err error) {
+	c.re, err = regexp.Compile(c.Regex)
+	return err
+}
+
+// A RoutingRule will redirect or drop alerts created with an integration key.
+//
+// Rules are evaluated in order, and the first matching rule is applied.
+type RoutingRule struct {
+	ID               string
+	IntegrationKeyID string
+	Name             string
+
+	// Conditions must all match for the rule to apply. A rule without
+	// conditions matches all alerts.
+	Conditions []RouteCondition
+
+	Action RouteAction
+
+	// ServiceID is the service alerts are redirected to.
+	ServiceID string
+}
+
+// RouteInput contains the alert data routing rules are evaluated against.
+type RouteInput struct {
+	Summary string
+	Details string
+
+	// Meta contains additional key-value pairs provided by the integration.
+	Meta map[string]string
+}
+
+// Route is the result of evaluating routing rules.
+type Route struct {
+	// Rule is the rule that matched, nil if none did.
+	Rule *RoutingRule
+
+	// ServiceID is the service the alert should be created on, empty if it should be dropped.
+	ServiceID string
+}
+
+// Drop returns true if the alert should be discarded.
+func (r Route) Drop() bool { return r.ServiceID == "" }
+
+// Normalize will validate the routing rule.
+func (r RoutingRule) Normalize() (*RoutingRule, error) {
+	err := validate.Many(
+		validate.UUID("IntegrationKeyID", r.IntegrationKeyID),
+		validate.IDName("Name", r.Name),
+		validate.OneOf("Action", r.Action, RouteActionRedirect, RouteActionDrop),
+		validate.Range("Conditions", len(r.Conditions), 0, 10),
+	)
+	if r.Action == RouteActionRedirect {
+		err = validate.Many(err, validate.UUID("ServiceID", r.ServiceID))
+	} else {
+		r.ServiceID = ""
+	}
+	for i, c := range r.Conditions {
+		fname := fmt.Sprintf("Conditions[%d].", i)
+		err = validate.Many(err,
+			validate.OneOf(fname+"Field", c.Field, RouteFieldSummary, RouteFieldDetails, RouteFieldMeta),
+			validate.RequiredText(fname+"Regex", c.Regex, 1, 255),
+			validate.Regexp(fname+"Regex", c.Regex),
+		)
+		if c.Field == RouteFieldMeta {
+			err = validate.Many(err, validate.RequiredText(fname+"Key", c.Key, 1, 255))
+		} else if c.Key != "" {
+			err = validate.Many(err, validation.NewFieldError(fname+"Key", "only allowed for meta field"))
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+// Matches will return true if all conditions match the input.
+func (r RoutingRule) Matches(in RouteInput) bool {
+	for _, c := range r.Conditions {
+		var value string
+		switch c.Field {
+		case RouteFieldSummary:
+			value = in.Summary
+		case RouteFieldDetails:
+			value = in.Details
+		case RouteFieldMeta:
+			var ok bool
+			value, ok = in.Meta[c.Key]
+			if !ok {
+				return false
+			}
+		default:
+			return false
+		}
+
+		re := c.re
+		if re == nil {
+			var err error
+			re, err = regexp.Compile(c.Regex)
+			if err != nil {
+				return false
+			}
+		}
+		if !re.MatchString(value) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// EvaluateRules will return the route for the first matching rule. If no rules
+// match, the alert is routed to serviceID.
+func EvaluateRules(rules []RoutingRule, serviceID string, in RouteInput) Route {
+	for i, r := range rules {
+		if !r.Matches(in) {
+			continue
+		}
+
+		return Route{Rule: &rules[i], ServiceID: r.ServiceID}
+	}
+
+	return Route{ServiceID: serviceID}
+}
@@ -0,0 +1,76 @@
+package integrationkey
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRoutingRule_Normalize(t *testing.T) {
+	const keyID = "a81facc0-4764-012d-7bfb-002500d5d678"
+	const svcID = "b81facc0-4764-012d-7bfb-002500d5d678"
+
+	_, err := RoutingRule{IntegrationKeyID: keyID, Name: "Database", Action: RouteActionRedirect, ServiceID: svcID}.Normalize()
+	assert.NoError(t, err)
+
+	_, err = RoutingRule{IntegrationKeyID: keyID, Name: "Database", Action: RouteActionRedirect}.Normalize()
+	assert.Error(t, err, "redirect without service")
+
+	r, err := RoutingRule{IntegrationKeyID: keyID, Name: "Noise", Action: RouteActionDrop, ServiceID: svcID}.Normalize()
+	assert.NoError(t, err)
+	assert.Empty(t, r.ServiceID, "drop should clear service")
+
+	_, err = RoutingRule{IntegrationKeyID: keyID, Name: "Noise", Action: RouteActionDrop, Conditions: []RouteCondition{
+		{Field: RouteFieldMeta, Regex: "^db$"},
+	}}.Normalize()
+	assert.Error(t, err, "meta condition without key")
+
+	_, err = RoutingRule{IntegrationKeyID: keyID, Name: "Noise", Action: RouteActionDrop, Conditions: []RouteCondition{
+		{Field: RouteFieldSummary, Regex: "foo(bar"},
+	}}.Normalize()
+	assert.Error(t, err, "invalid regex")
+}
+
+func TestEvaluateRules(t *testing.T) {
+	rules := []RoutingRule{
+		{Name: "Noise", Action: RouteActionDrop, Conditions: []RouteCondition{
+			{Field: RouteFieldMeta, Key: "severity", Regex: "^info$"},
+		}},
+		{Name: "Database", Action: RouteActionRedirect, ServiceID: "db", Conditions: []RouteCondition{
+			{Field: RouteFieldMeta, Key: "team", Regex: "^db$"},
+			{Field: RouteFieldSummary, Regex: "(?i)postgres"},
+		}},
+		{Name: "Details", Action: RouteActionRedirect, ServiceID: "web", Conditions: []RouteCondition{
+			{Field: RouteFieldDetails, Regex: "nginx"},
+		}},
+	}
+
+	route := EvaluateRules(rules, "default", RouteInput{Summary: "Postgres down", Meta: map[string]string{"team": "db", "severity": "info"}})
+	assert.True(t, route.Drop())
+	assert.Equal(t, &rules[0], route.Rule)
+
+	route = EvaluateRules(rules, "default", RouteInput{Summary: "Postgres down", Meta: map[string]string{"team": "db"}})
+	assert.Equal(t, "db", route.ServiceID)
+
+	route = EvaluateRules(rules, "default", RouteInput{Summary: "MySQL down", Meta: map[string]string{"team": "db"}})
+	assert.Equal(t, "default", route.ServiceID, "all conditions must match")
+	assert.Nil(t, route.Rule)
+
+	route = EvaluateRules(rules, "default", RouteInput{Summary: "Postgres down", Details: "nginx error"})
+	assert.Equal(t, "web", route.ServiceID, "missing meta key does not match")
+
+	route = EvaluateRules(nil, "default", RouteInput{Summary: "anything"})
+	assert.Equal(t, "default", route.ServiceID)
+}
+
+func TestRouteCondition_compile(t *testing.T) {
+	c := RouteCondition{Field: RouteFieldSummary, Regex: "^db"}
+	assert.NoError(t, c.compile())
+
+	r := RoutingRule{Conditions: []RouteCondition{c}}
+	assert.True(t, r.Matches(RouteInput{Summary: "db down"}))
+	assert.False(t, r.Matches(RouteInput{Summary: "web down"}))
+
+	c = RouteCondition{Field: RouteFieldSummary, Regex: "foo(bar"}
+	assert.Error(t, c.compile())
+}
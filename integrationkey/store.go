@@ -3,10 +3,15 @@ package integrationkey
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
 
 	"github.com/target/goalert/auth/authtoken"
 	"github.com/target/goalert/permission"
 	"github.com/target/goalert/util"
+	"github.com/target/goalert/util/log"
 	"github.com/target/goalert/util/sqlutil"
 	"github.com/target/goalert/validation"
 	"github.com/target/goalert/validation/validate"
@@ -25,47 +30,157 @@ type Store interface {
 	Delete(ctx context.Context, id string) error
 	DeleteTx(ctx context.Context, tx *sql.Tx, id string) error
 	DeleteManyTx(ctx context.Context, tx *sql.Tx, ids []string) error
+
+	// RotateSecretTx will generate a new secret for the key. The previous secret will continue
+	// to be accepted until the grace period has elapsed. A key may not be rotated again with a
+	// grace period while the previous secret is still accepted.
+	RotateSecretTx(ctx context.Context, tx *sql.Tx, id string, grace time.Duration) (*IntegrationKey, error)
+
+	// SetExpirationTx will set the time after which the key will no longer be accepted. A zero
+	// value means the key never expires.
+	SetExpirationTx(ctx context.Context, tx *sql.Tx, id string, expiresAt time.Time) error
+
+	FindRoutingRules(ctx context.Context, id string) ([]RoutingRule, error)
+	SetRoutingRulesTx(ctx context.Context, tx *sql.Tx, id string, rules []RoutingRule) error
+
+	// RouteAlert will evaluate the routing rules of the integration key that authorized ctx. The returned
+	// context is authorized for the service the alert should be created on.
+	RouteAlert(ctx context.Context, in RouteInput) (context.Context, *Route, error)
 }
 
 type DB struct {
 	db *sql.DB
 
 	getServiceID     *sql.Stmt
+	authorize        *sql.Stmt
+	recordUsage      *sql.Stmt
 	create           *sql.Stmt
 	findOne          *sql.Stmt
 	findAllByService *sql.Stmt
 	delete           *sql.Stmt
+	rotate           *sql.Stmt
+	setExpiration    *sql.Stmt
+
+	findRules   *sql.Stmt
+	deleteRules *sql.Stmt
+	insertRule  *sql.Stmt
+
+	findServices *sql.Stmt
+	findTeamIDs  *sql.Stmt
 }
 
+const keyColumns = `
+	k.id, k.name, k.type, k.service_id, coalesce(k.secret, k.id),
+	k.expires_at, k.prev_secret_expires_at, k.created_at, u.last_used_at, coalesce(u.request_count, 0)
+`
+
+// keyFrom must be used with keyColumns, as usage is tracked in a separate table.
+const keyFrom = `
+	integration_keys k
+	LEFT JOIN integration_key_usage u ON u.integration_key_id = k.id
+`
+
 func NewDB(ctx context.Context, db *sql.DB) (*DB, error) {
 	p := &util.Prepare{DB: db, Ctx: ctx}
 
 	return &DB{
 		db: db,
 
-		getServiceID:     p.P("SELECT service_id FROM integration_keys WHERE id = $1 AND type = $2"),
-		create:           p.P("INSERT INTO integration_keys (id, name, type, service_id) VALUES ($1, $2, $3, $4)"),
-		findOne:          p.P("SELECT id, name, type, service_id FROM integration_keys WHERE id = $1"),
-		findAllByService: p.P("SELECT id, name, type, service_id FROM integration_keys WHERE service_id = $1"),
+		getServiceID: p.P("SELECT service_id FROM integration_keys WHERE id = $1 AND type = $2"),
+		authorize: p.P(`
+			SELECT id, service_id
+			FROM integration_keys
+			WHERE
+				type = $2 AND
+				(expires_at ISNULL OR expires_at > now()) AND
+				(
+					secret = $1 OR
+					(secret ISNULL AND id = $1) OR
+					(prev_secret = $1 AND prev_secret_expires_at > now())
+				)
+		`),
+		recordUsage: p.P(`
+			INSERT INTO integration_key_usage (integration_key_id, request_count)
+			VALUES ($1, 1)
+			ON CONFLICT (integration_key_id) DO UPDATE
+			SET last_used_at = now(), request_count = integration_key_usage.request_count + 1
+		`),
+		create:           p.P("INSERT INTO integration_keys (id, name, type, service_id, expires_at) VALUES ($1, $2, $3, $4, $5)"),
+		findOne:          p.P("SELECT " + keyColumns + " FROM " + keyFrom + " WHERE k.id = $1"),
+		findAllByService: p.P("SELECT " + keyColumns + " FROM " + keyFrom + " WHERE k.service_id = $1"),
 		delete:           p.P("DELETE FROM integration_keys WHERE id = any($1)"),
+		rotate: p.P(`
+			WITH k AS (
+				UPDATE integration_keys
+				SET
+					prev_secret = coalesce(secret, id),
+					prev_secret_expires_at = now() + $2::float8 * interval '1 second',
+					secret = $3
+				WHERE
+					id = $1 AND
+					-- don't drop a previous secret that is still in use, unless rotating without a grace period
+					($2 = 0 OR prev_secret_expires_at ISNULL OR prev_secret_expires_at <= now())
+				RETURNING *
+			)
+			SELECT ` + keyColumns + `
+			FROM k
+			LEFT JOIN integration_key_usage u ON u.integration_key_id = k.id
+		`),
+		setExpiration: p.P("UPDATE integration_keys SET expires_at = $2 WHERE id = $1"),
+
+		findRules: p.P(`
+			SELECT id, integration_key_id, name, conditions, action, service_id
+			FROM integration_key_routing_rules
+			WHERE integration_key_id = $1
+			ORDER BY position
+		`),
+		deleteRules: p.P(`DELETE FROM integration_key_routing_rules WHERE integration_key_id = $1`),
+		insertRule: p.P(`
+			INSERT INTO integration_key_routing_rules (id, integration_key_id, position, name, conditions, action, service_id)
+			VALUES ($1, $2, $3, $4, $5, $6, $7)
+		`),
+
+		findServices: p.P(`SELECT array(SELECT id::text FROM services WHERE id = any($1))`),
+
+		findTeamIDs: p.P(`
+			SELECT array(
+				SELECT DISTINCT coalesce(team_id::text, '')
+				FROM services
+				WHERE
+					id = any($1) OR
+					id IN (SELECT service_id FROM integration_keys WHERE id = any($1))
+			)
+		`),
 	}, p.Err
 }
 
+// Authorize will return a context authorized for the service of the integration key matching tok.
+// The token may be the current secret of the key or, during the grace period after a rotation,
+// the previous one. Usage of the key is recorded on success.
 func (db *DB) Authorize(ctx context.Context, tok authtoken.Token, t Type) (context.Context, error) {
-	var serviceID string
-	var err error
-	permission.SudoContext(ctx, func(c context.Context) {
-		serviceID, err = db.GetServiceID(c, tok.ID.String(), t)
-	})
+	err := validate.OneOf("IntegrationType", t, TypeGrafana, TypeSite24x7, TypePrometheusAlertmanager, TypeGeneric, TypeEmail)
+	if err != nil {
+		return ctx, err
+	}
+
+	var keyID, serviceID string
+	err = db.authorize.QueryRowContext(ctx, tok.ID.String(), t).Scan(&keyID, &serviceID)
 	if errors.Is(err, sql.ErrNoRows) {
 		return ctx, validation.NewFieldError("IntegrationKeyID", "not found")
 	}
 	if err != nil {
 		return ctx, errors.Wrap(err, "lookup serviceID")
 	}
+
+	_, err = db.recordUsage.ExecContext(ctx, keyID)
+	if err != nil {
+		// usage is informational, alerts should still be accepted
+		log.Log(ctx, errors.Wrap(err, "record integration key usage"))
+	}
+
 	ctx = permission.ServiceSourceContext(ctx, serviceID, &permission.SourceInfo{
 		Type: permission.SourceTypeIntegrationKey,
-		ID:   tok.ID.String(),
+		ID:   keyID,
 	})
 	return ctx, nil
 }
@@ -111,6 +226,13 @@ func (db *DB) CreateKeyTx(ctx context.Context, tx *sql.Tx, i *IntegrationKey) (*
 	if err != nil {
 		return nil, err
 	}
+	if !n.ExpiresAt.IsZero() && !n.ExpiresAt.After(time.Now()) {
+		return nil, validation.NewFieldError("ExpiresAt", "must be in the future")
+	}
+	err = db.checkOwner(ctx, tx, n.ServiceID)
+	if err != nil {
+		return nil, err
+	}
 
 	stmt := db.create
 	if tx != nil {
@@ -118,13 +240,100 @@ func (db *DB) CreateKeyTx(ctx context.Context, tx *sql.Tx, i *IntegrationKey) (*
 	}
 
 	n.ID = uuid.New().String()
-	_, err = stmt.ExecContext(ctx, n.ID, n.Name, n.Type, n.ServiceID)
+	_, err = stmt.ExecContext(ctx, n.ID, n.Name, n.Type, n.ServiceID, sqlutil.NullTime{Time: n.ExpiresAt, Valid: !n.ExpiresAt.IsZero()})
 	if err != nil {
 		return nil, err
 	}
+	n.Secret = n.ID
 	return n, nil
 }
 
+// maxRotationGracePeriod is the longest time a previous secret may be accepted after rotation.
+const maxRotationGracePeriod = 7 * 24 * time.Hour
+
+// RotateSecretTx will generate a new secret for the key. The previous secret will continue
+// to be accepted until the grace period has elapsed.
+//
+// Rotating with a grace period is rejected while the secret from before the last rotation is
+// still accepted, as it would otherwise be dropped early. Rotating without a grace period
+// revokes all previous secrets immediately.
+func (db *DB) RotateSecretTx(ctx context.Context, tx *sql.Tx, id string, grace time.Duration) (*IntegrationKey, error) {
+	err := permission.LimitCheckAny(ctx, permission.Admin, permission.User)
+	if err != nil {
+		return nil, err
+	}
+	err = validate.Many(
+		validate.UUID("IntegrationKeyID", id),
+		validate.Duration("GracePeriod", grace, 0, maxRotationGracePeriod),
+	)
+	if err != nil {
+		return nil, err
+	}
+	err = db.checkOwner(ctx, tx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	stmt := db.rotate
+	if tx != nil {
+		stmt = tx.StmtContext(ctx, stmt)
+	}
+
+	var i IntegrationKey
+	err = scanFrom(&i, stmt.QueryRowContext(ctx, id, grace.Seconds(), uuid.New()).Scan)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, db.rotateNotFound(ctx, tx, id)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &i, nil
+}
+
+// rotateNotFound returns the reason a key could not be rotated.
+func (db *DB) rotateNotFound(ctx context.Context, tx *sql.Tx, id string) error {
+	stmt := db.findOne
+	if tx != nil {
+		stmt = tx.StmtContext(ctx, stmt)
+	}
+
+	var i IntegrationKey
+	err := scanFrom(&i, stmt.QueryRowContext(ctx, id).Scan)
+	if errors.Is(err, sql.ErrNoRows) {
+		return validation.NewFieldError("IntegrationKeyID", "not found")
+	}
+	if err != nil {
+		return err
+	}
+
+	return validation.NewFieldError("GracePeriod", fmt.Sprintf("previous secret is still accepted until %s; rotate without a grace period to revoke it immediately", i.PrevSecretExpiresAt.UTC().Format(time.RFC3339)))
+}
+
+// SetExpirationTx will set the time after which the key will no longer be accepted. A zero
+// value means the key never expires.
+func (db *DB) SetExpirationTx(ctx context.Context, tx *sql.Tx, id string, expiresAt time.Time) error {
+	err := permission.LimitCheckAny(ctx, permission.Admin, permission.User)
+	if err != nil {
+		return err
+	}
+	err = validate.UUID("IntegrationKeyID", id)
+	if err != nil {
+		return err
+	}
+	err = db.checkOwner(ctx, tx, id)
+	if err != nil {
+		return err
+	}
+
+	stmt := db.setExpiration
+	if tx != nil {
+		stmt = tx.StmtContext(ctx, stmt)
+	}
+	_, err = stmt.ExecContext(ctx, id, sqlutil.NullTime{Time: expiresAt, Valid: !expiresAt.IsZero()})
+	return err
+}
+
 func (db *DB) Delete(ctx context.Context, id string) error {
 	return db.DeleteTx(ctx, nil, id)
 }
@@ -140,6 +349,10 @@ func (db *DB) DeleteManyTx(ctx context.Context, tx *sql.Tx, ids []string) error
 	if err != nil {
 		return err
 	}
+	err = db.checkOwner(ctx, tx, ids...)
+	if err != nil {
+		return err
+	}
 
 	s := db.delete
 	if tx != nil {
@@ -193,8 +406,33 @@ func (db *DB) FindAllByService(ctx context.Context, serviceID string) ([]Integra
 	return scanAllFrom(rows)
 }
 
+// checkOwner will return an error if the context may not modify the given services or the
+// services of the given integration keys.
+func (db *DB) checkOwner(ctx context.Context, tx *sql.Tx, ids ...string) error {
+	stmt := db.findTeamIDs
+	if tx != nil {
+		stmt = tx.StmtContext(ctx, stmt)
+	}
+
+	var teamIDs sqlutil.StringArray
+	err := stmt.QueryRowContext(ctx, sqlutil.UUIDArray(ids)).Scan(&teamIDs)
+	if err != nil {
+		return err
+	}
+
+	return permission.LimitCheckOwner(ctx, teamIDs...)
+}
+
 func scanFrom(i *IntegrationKey, f func(args ...interface{}) error) error {
-	return f(&i.ID, &i.Name, &i.Type, &i.ServiceID)
+	var expiresAt, prevExpiresAt, lastUsedAt sqlutil.NullTime
+	err := f(&i.ID, &i.Name, &i.Type, &i.ServiceID, &i.Secret, &expiresAt, &prevExpiresAt, &i.CreatedAt, &lastUsedAt, &i.RequestCount)
+	if err != nil {
+		return err
+	}
+	i.ExpiresAt = expiresAt.Time
+	i.PrevSecretExpiresAt = prevExpiresAt.Time
+	i.LastUsedAt = lastUsedAt.Time
+	return nil
 }
 
 func scanAllFrom(rows *sql.Rows) (integrationKeys []IntegrationKey, err error) {
@@ -206,5 +444,165 @@ func scanAllFrom(rows *sql.Rows) (integrationKeys []IntegrationKey, err error) {
 		}
 		integrationKeys = append(integrationKeys, i)
 	}
-	return integrationKeys, nil
+	return integrationKeys, rows.Err()
+}
+
+// FindRoutingRules will return the routing rules for an integration key, in evaluation order.
+func (db *DB) FindRoutingRules(ctx context.Context, id string) ([]RoutingRule, error) {
+	err := validate.UUID("IntegrationKeyID", id)
+	if err != nil {
+		return nil, err
+	}
+
+	err = permission.LimitCheckAny(ctx, permission.System, permission.Admin, permission.User)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := db.findRules.QueryContext(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var rules []RoutingRule
+	for rows.Next() {
+		var r RoutingRule
+		var conds []byte
+		var svcID sql.NullString
+		err = rows.Scan(&r.ID, &r.IntegrationKeyID, &r.Name, &conds, &r.Action, &svcID)
+		if err != nil {
+			return nil, err
+		}
+		err = json.Unmarshal(conds, &r.Conditions)
+		if err != nil {
+			return nil, errors.Wrap(err, "decode routing rule conditions")
+		}
+		for i := range r.Conditions {
+			err = r.Conditions[i].compile()
+			if err != nil {
+				return nil, errors.Wrapf(err, "compile routing rule '%s' condition", r.ID)
+			}
+		}
+		r.ServiceID = svcID.String
+		rules = append(rules, r)
+	}
+
+	return rules, rows.Err()
+}
+
+// SetRoutingRulesTx will replace all routing rules for an integration key.
+func (db *DB) SetRoutingRulesTx(ctx context.Context, tx *sql.Tx, id string, rules []RoutingRule) error {
+	err := permission.LimitCheckAny(ctx, permission.Admin, permission.User)
+	if err != nil {
+		return err
+	}
+	err = validate.Many(
+		validate.UUID("IntegrationKeyID", id),
+		validate.Range("Rules", len(rules), 0, MaxRoutingRules),
+	)
+	if err != nil {
+		return err
+	}
+	err = db.checkOwner(ctx, tx, id)
+	if err != nil {
+		return err
+	}
+
+	normalized := make([]RoutingRule, len(rules))
+	var svcIDs []string
+	for i, r := range rules {
+		r.IntegrationKeyID = id
+		n, err := r.Normalize()
+		if err != nil {
+			return validation.AddPrefix(fmt.Sprintf("Rules[%d].", i), err)
+		}
+		normalized[i] = *n
+		if n.ServiceID != "" {
+			svcIDs = append(svcIDs, n.ServiceID)
+		}
+	}
+
+	if len(svcIDs) > 0 {
+		var found sqlutil.StringArray
+		err = tx.StmtContext(ctx, db.findServices).QueryRowContext(ctx, sqlutil.UUIDArray(svcIDs)).Scan(&found)
+		if err != nil {
+			return errors.Wrap(err, "lookup routing rule services")
+		}
+		exists := make(map[string]bool, len(found))
+		for _, id := range found {
+			exists[id] = true
+		}
+		for i, r := range normalized {
+			if r.ServiceID != "" && !exists[strings.ToLower(r.ServiceID)] {
+				return validation.NewFieldError(fmt.Sprintf("Rules[%d].ServiceID", i), "service does not exist")
+			}
+		}
+
+		// alerts may only be redirected to services the caller could otherwise modify
+		err = db.checkOwner(ctx, tx, svcIDs...)
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err = tx.StmtContext(ctx, db.deleteRules).ExecContext(ctx, id)
+	if err != nil {
+		return errors.Wrap(err, "delete existing routing rules")
+	}
+
+	insert := tx.StmtContext(ctx, db.insertRule)
+	for i, r := range normalized {
+		conds, err := json.Marshal(r.Conditions)
+		if err != nil {
+			return errors.Wrap(err, "encode routing rule conditions")
+		}
+		if r.Conditions == nil {
+			conds = []byte("[]")
+		}
+		_, err = insert.ExecContext(ctx, uuid.New(), id, i, r.Name, conds, r.Action, sql.NullString{String: r.ServiceID, Valid: r.ServiceID != ""})
+		if err != nil {
+			return validation.AddPrefix(fmt.Sprintf("Rules[%d].", i), err)
+		}
+	}
+
+	return nil
+}
+
+// RouteAlert will evaluate the routing rules of the integration key that authorized ctx.
+//
+// If ctx was not authorized by an integration key, or no rules match, the alert is routed
+// to the service of ctx.
+func (db *DB) RouteAlert(ctx context.Context, in RouteInput) (context.Context, *Route, error) {
+	err := permission.LimitCheckAny(ctx, permission.Service)
+	if err != nil {
+		return ctx, nil, err
+	}
+	serviceID := permission.ServiceID(ctx)
+
+	src := permission.Source(ctx)
+	if src == nil || src.Type != permission.SourceTypeIntegrationKey {
+		return ctx, &Route{ServiceID: serviceID}, nil
+	}
+
+	var rules []RoutingRule
+	permission.SudoContext(ctx, func(ctx context.Context) {
+		rules, err = db.FindRoutingRules(ctx, src.ID)
+	})
+	if err != nil {
+		return ctx, nil, errors.Wrap(err, "lookup routing rules")
+	}
+
+	route := EvaluateRules(rules, serviceID, in)
+	if route.Rule != nil {
+		ctx = log.WithFields(ctx, log.Fields{
+			"RoutingRuleID":     route.Rule.ID,
+			"RoutingRuleAction": route.Rule.Action,
+		})
+	}
+	if route.Drop() || route.ServiceID == serviceID {
+		return ctx, &route, nil
+	}
+
+	return permission.ServiceSourceContext(ctx, route.ServiceID, src), &route, nil
 }
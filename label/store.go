@@ -7,6 +7,7 @@ import (
 	"github.com/target/goalert/assignment"
 	"github.com/target/goalert/permission"
 	"github.com/target/goalert/util"
+	"github.com/target/goalert/util/sqlutil"
 	"github.com/target/goalert/validation/validate"
 
 	"github.com/pkg/errors"
@@ -30,6 +31,7 @@ type DB struct {
 	delete           *sql.Stmt
 	findAllByService *sql.Stmt
 	uniqueKeys       *sql.Stmt
+	findTeamIDs      *sql.Stmt
 }
 
 // NewDB will Set a DB backend from a sql.DB. An error will be returned if statements fail to prepare.
@@ -59,6 +61,7 @@ func NewDB(ctx context.Context, db *sql.DB) (*DB, error) {
 			FROM labels
 			ORDER BY key ASC
 		`),
+		findTeamIDs: p.P(`SELECT array(SELECT DISTINCT coalesce(team_id::text, '') FROM services WHERE id = any($1))`),
 	}, p.Err
 }
 
@@ -75,6 +78,11 @@ func (db *DB) SetTx(ctx context.Context, tx *sql.Tx, label *Label) error {
 		return err
 	}
 
+	err = db.checkOwner(ctx, tx, n.Target.TargetID())
+	if err != nil {
+		return err
+	}
+
 	if n.Value == "" {
 		// Delete Operation
 		stmt := db.delete
@@ -170,3 +178,19 @@ func (db *DB) UniqueKeysTx(ctx context.Context, tx *sql.Tx) ([]string, error) {
 func (db *DB) UniqueKeys(ctx context.Context) ([]string, error) {
 	return db.UniqueKeysTx(ctx, nil)
 }
+
+// checkOwner will return an error if the context may not modify the given services.
+func (db *DB) checkOwner(ctx context.Context, tx *sql.Tx, serviceIDs ...string) error {
+	stmt := db.findTeamIDs
+	if tx != nil {
+		stmt = tx.StmtContext(ctx, stmt)
+	}
+
+	var teamIDs sqlutil.StringArray
+	err := stmt.QueryRowContext(ctx, sqlutil.UUIDArray(serviceIDs)).Scan(&teamIDs)
+	if err != nil {
+		return errors.Wrap(err, "lookup service teams")
+	}
+
+	return permission.LimitCheckOwner(ctx, teamIDs...)
+}
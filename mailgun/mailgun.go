@@ -20,7 +20,6 @@ import (
 	"github.com/target/goalert/auth/authtoken"
 	"github.com/target/goalert/config"
 	"github.com/target/goalert/integrationkey"
-	"github.com/target/goalert/permission"
 	"github.com/target/goalert/retry"
 	"github.com/target/goalert/util/errutil"
 	"github.com/target/goalert/util/log"
@@ -180,10 +179,24 @@ func (h *ingressHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	err = retry.DoTemporaryError(func(_ int) error {
 		if newAlert.ServiceID == "" {
 			ctx, err = h.intKeys.Authorize(ctx, tok, integrationkey.TypeEmail)
-			newAlert.ServiceID = permission.ServiceID(ctx)
-		}
-		if err != nil {
-			return err
+			if err != nil {
+				return err
+			}
+
+			var route *integrationkey.Route
+			ctx, route, err = h.intKeys.RouteAlert(ctx, integrationkey.RouteInput{
+				Summary: newAlert.Summary,
+				Details: newAlert.Details,
+				Meta:    map[string]string{"from": r.FormValue("from")},
+			})
+			if err != nil {
+				return err
+			}
+			if route.Drop() {
+				log.Logf(ctx, "alert dropped by routing rule")
+				return nil
+			}
+			newAlert.ServiceID = route.ServiceID
 		}
 		_, err = h.alerts.CreateOrUpdate(ctx, newAlert)
 		err = errors.Wrap(err, "create/update alert")
@@ -0,0 +1,24 @@
+-- +migrate Up
+CREATE TABLE user_time_off (
+    id UUID PRIMARY KEY,
+    user_id UUID NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    start_time TIMESTAMP WITH TIME ZONE NOT NULL,
+    end_time TIMESTAMP WITH TIME ZONE NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+
+    CONSTRAINT user_time_off_valid_range CHECK (end_time > start_time)
+);
+
+CREATE INDEX idx_user_time_off_user_end ON user_time_off (user_id, end_time);
+
+UPDATE engine_processing_versions
+SET version = 4
+WHERE type_id = 'schedule';
+
+-- +migrate Down
+UPDATE engine_processing_versions
+SET version = 3
+WHERE type_id = 'schedule';
+
+DROP TABLE user_time_off;
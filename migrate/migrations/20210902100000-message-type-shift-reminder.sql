@@ -0,0 +1,6 @@
+-- +migrate Up notransaction
+
+ALTER TYPE enum_outgoing_messages_type ADD VALUE IF NOT EXISTS 'shift_start_reminder';
+ALTER TYPE enum_outgoing_messages_type ADD VALUE IF NOT EXISTS 'shift_end_notification';
+
+-- +migrate Down
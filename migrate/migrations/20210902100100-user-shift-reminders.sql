@@ -0,0 +1,34 @@
+-- +migrate Up
+UPDATE engine_processing_versions
+SET version = 5
+WHERE type_id = 'schedule';
+
+UPDATE engine_processing_versions
+SET version = 10
+WHERE type_id = 'message';
+
+ALTER TABLE user_contact_methods
+    ADD COLUMN shift_start_reminder_minutes INT CHECK (shift_start_reminder_minutes BETWEEN 1 AND 1440),
+    ADD COLUMN shift_end_notification BOOLEAN NOT NULL DEFAULT FALSE;
+
+ALTER TABLE outgoing_messages
+    ADD COLUMN shift_time TIMESTAMPTZ;
+
+-- +migrate Down
+UPDATE engine_processing_versions
+SET version = 4
+WHERE type_id = 'schedule';
+
+UPDATE engine_processing_versions
+SET version = 9
+WHERE type_id = 'message';
+
+DELETE FROM outgoing_messages
+WHERE message_type IN ('shift_start_reminder', 'shift_end_notification');
+
+ALTER TABLE outgoing_messages
+    DROP COLUMN shift_time;
+
+ALTER TABLE user_contact_methods
+    DROP COLUMN shift_start_reminder_minutes,
+    DROP COLUMN shift_end_notification;
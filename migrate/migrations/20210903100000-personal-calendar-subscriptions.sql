@@ -0,0 +1,16 @@
+-- +migrate Up
+ALTER TABLE user_calendar_subscriptions
+    ALTER COLUMN schedule_id DROP NOT NULL;
+
+CREATE UNIQUE INDEX user_calendar_subscriptions_name_personal
+    ON user_calendar_subscriptions (user_id, name)
+    WHERE schedule_id ISNULL;
+
+-- +migrate Down
+DROP INDEX user_calendar_subscriptions_name_personal;
+
+DELETE FROM user_calendar_subscriptions
+WHERE schedule_id ISNULL;
+
+ALTER TABLE user_calendar_subscriptions
+    ALTER COLUMN schedule_id SET NOT NULL;
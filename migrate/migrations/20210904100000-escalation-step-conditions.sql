@@ -0,0 +1,67 @@
+-- +migrate Up
+UPDATE engine_processing_versions
+SET version = 4
+WHERE type_id = 'escalation';
+
+ALTER TABLE escalation_policy_steps
+    ADD COLUMN condition_source enum_alert_source,
+    ADD COLUMN condition_summary_regex TEXT CHECK (('' ~ condition_summary_regex) NOTNULL OR condition_summary_regex ISNULL),
+    ADD COLUMN condition_time_zone TEXT,
+    ADD COLUMN condition_start_time TIME,
+    ADD COLUMN condition_end_time TIME,
+    ADD COLUMN condition_weekdays TEXT CHECK (condition_weekdays ~ '^[01]{7}$'),
+    ADD COLUMN condition_outside_hours BOOLEAN NOT NULL DEFAULT FALSE,
+    ADD CONSTRAINT ep_step_condition_hours CHECK (
+        (condition_time_zone ISNULL) = (condition_start_time ISNULL) AND
+        (condition_time_zone ISNULL) = (condition_end_time ISNULL) AND
+        (condition_time_zone ISNULL) = (condition_weekdays ISNULL)
+    );
+
+-- +migrate StatementBegin
+CREATE FUNCTION fn_ep_step_matches(_step_id UUID, _alert_id BIGINT) RETURNS BOOLEAN AS $$
+    SELECT
+        (step.condition_source ISNULL OR step.condition_source = a.source) AND
+        (step.condition_summary_regex ISNULL OR a.summary ~ step.condition_summary_regex) AND
+        (step.condition_time_zone ISNULL OR step.condition_outside_hours != (
+            WITH local AS (
+                SELECT
+                    CAST(now() AT TIME ZONE step.condition_time_zone AS TIME) clock,
+                    CAST(extract(dow FROM now() AT TIME ZONE step.condition_time_zone) AS INT) dow
+            )
+            SELECT CASE
+                WHEN step.condition_start_time < step.condition_end_time THEN
+                    substr(step.condition_weekdays, local.dow + 1, 1) = '1' AND
+                    local.clock >= step.condition_start_time AND
+                    local.clock < step.condition_end_time
+                ELSE
+                    (
+                        substr(step.condition_weekdays, local.dow + 1, 1) = '1' AND
+                        local.clock >= step.condition_start_time
+                    ) OR (
+                        substr(step.condition_weekdays, (local.dow + 6) % 7 + 1, 1) = '1' AND
+                        local.clock < step.condition_end_time
+                    )
+                END
+            FROM local
+        ))
+    FROM escalation_policy_steps step, alerts a
+    WHERE step.id = _step_id AND a.id = _alert_id
+$$ LANGUAGE SQL STABLE;
+-- +migrate StatementEnd
+
+-- +migrate Down
+UPDATE engine_processing_versions
+SET version = 3
+WHERE type_id = 'escalation';
+
+DROP FUNCTION fn_ep_step_matches(UUID, BIGINT);
+
+ALTER TABLE escalation_policy_steps
+    DROP CONSTRAINT ep_step_condition_hours,
+    DROP COLUMN condition_source,
+    DROP COLUMN condition_summary_regex,
+    DROP COLUMN condition_time_zone,
+    DROP COLUMN condition_start_time,
+    DROP COLUMN condition_end_time,
+    DROP COLUMN condition_weekdays,
+    DROP COLUMN condition_outside_hours;
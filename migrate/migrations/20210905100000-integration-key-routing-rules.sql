@@ -0,0 +1,18 @@
+-- +migrate Up
+CREATE TABLE integration_key_routing_rules (
+    id UUID PRIMARY KEY,
+    integration_key_id UUID NOT NULL REFERENCES integration_keys (id) ON DELETE CASCADE,
+    position INT NOT NULL,
+    name TEXT NOT NULL,
+    conditions JSONB NOT NULL DEFAULT '[]',
+    action TEXT NOT NULL CHECK (action IN ('redirect', 'drop')),
+    service_id UUID REFERENCES services (id) ON DELETE CASCADE,
+
+    UNIQUE (integration_key_id, position),
+    CONSTRAINT integration_key_routing_rules_redirect_service CHECK ((action = 'redirect') = (service_id NOTNULL))
+);
+
+CREATE INDEX idx_integration_key_routing_rules_service ON integration_key_routing_rules (service_id);
+
+-- +migrate Down
+DROP TABLE integration_key_routing_rules;
@@ -0,0 +1,38 @@
+-- +migrate Up
+UPDATE engine_processing_versions
+SET version = 5
+WHERE type_id = 'escalation';
+
+CREATE TYPE enum_ep_step_target_mode AS ENUM (
+    'all',
+    'round_robin',
+    'sequential'
+);
+
+ALTER TABLE escalation_policy_steps
+    ADD COLUMN target_mode enum_ep_step_target_mode NOT NULL DEFAULT 'all',
+    ADD COLUMN sequential_delay_minutes INT NOT NULL DEFAULT 1 CHECK (sequential_delay_minutes BETWEEN 1 AND 60);
+
+ALTER TABLE escalation_policy_state
+    ADD COLUMN step_target_index INT NOT NULL DEFAULT 0;
+
+CREATE TABLE ep_step_round_robin (
+    ep_step_id UUID PRIMARY KEY REFERENCES escalation_policy_steps (id) ON DELETE CASCADE,
+    next_index BIGINT NOT NULL DEFAULT 0
+);
+
+-- +migrate Down
+UPDATE engine_processing_versions
+SET version = 4
+WHERE type_id = 'escalation';
+
+DROP TABLE ep_step_round_robin;
+
+ALTER TABLE escalation_policy_state
+    DROP COLUMN step_target_index;
+
+ALTER TABLE escalation_policy_steps
+    DROP COLUMN target_mode,
+    DROP COLUMN sequential_delay_minutes;
+
+DROP TYPE enum_ep_step_target_mode;
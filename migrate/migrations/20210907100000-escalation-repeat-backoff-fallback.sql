@@ -0,0 +1,31 @@
+-- +migrate Up
+UPDATE engine_processing_versions
+SET version = 6
+WHERE type_id = 'escalation';
+
+ALTER TABLE escalation_policies
+    ADD COLUMN repeat_backoff_minutes INT NOT NULL DEFAULT 0 CHECK (repeat_backoff_minutes BETWEEN 0 AND 1440),
+    ADD COLUMN fallback_user_id UUID REFERENCES users (id) ON DELETE SET NULL,
+    ADD COLUMN fallback_schedule_id UUID REFERENCES schedules (id) ON DELETE SET NULL,
+    ADD COLUMN fallback_rotation_id UUID REFERENCES rotations (id) ON DELETE SET NULL,
+    ADD CONSTRAINT escalation_policies_single_fallback CHECK (
+        num_nonnulls(fallback_user_id, fallback_schedule_id, fallback_rotation_id) <= 1
+    );
+
+ALTER TABLE escalation_policy_state
+    ADD COLUMN fallback_notified BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- +migrate Down
+UPDATE engine_processing_versions
+SET version = 5
+WHERE type_id = 'escalation';
+
+ALTER TABLE escalation_policy_state
+    DROP COLUMN fallback_notified;
+
+ALTER TABLE escalation_policies
+    DROP CONSTRAINT escalation_policies_single_fallback,
+    DROP COLUMN repeat_backoff_minutes,
+    DROP COLUMN fallback_user_id,
+    DROP COLUMN fallback_schedule_id,
+    DROP COLUMN fallback_rotation_id;
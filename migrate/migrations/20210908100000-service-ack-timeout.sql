@@ -0,0 +1,53 @@
+-- +migrate Up
+UPDATE engine_processing_versions
+SET version = 7
+WHERE type_id = 'escalation';
+
+ALTER TABLE services
+    ADD COLUMN ack_timeout_minutes INT NOT NULL DEFAULT 0 CHECK (ack_timeout_minutes BETWEEN 0 AND 10080),
+    ADD COLUMN ack_timeout_limit INT NOT NULL DEFAULT 3 CHECK (ack_timeout_limit BETWEEN 1 AND 100);
+
+ALTER TABLE escalation_policy_state
+    ADD COLUMN ack_time TIMESTAMPTZ,
+    ADD COLUMN ack_timeout_count INT NOT NULL DEFAULT 0;
+
+UPDATE escalation_policy_state state
+SET ack_time = now()
+FROM alerts a
+WHERE a.id = state.alert_id AND a.status = 'active';
+
+-- +migrate StatementBegin
+CREATE OR REPLACE FUNCTION fn_set_ep_state_ack_time() RETURNS TRIGGER AS
+$$
+BEGIN
+
+    UPDATE escalation_policy_state
+    SET ack_time = CASE WHEN NEW.status = 'active' THEN now() END
+    WHERE alert_id = NEW.id;
+
+    RETURN NEW;
+END;
+$$ LANGUAGE 'plpgsql';
+-- +migrate StatementEnd
+
+CREATE TRIGGER trg_30_set_ep_state_ack_time
+AFTER UPDATE ON public.alerts
+FOR EACH ROW
+WHEN (new.status != old.status)
+EXECUTE PROCEDURE fn_set_ep_state_ack_time();
+
+-- +migrate Down
+UPDATE engine_processing_versions
+SET version = 6
+WHERE type_id = 'escalation';
+
+DROP TRIGGER trg_30_set_ep_state_ack_time ON alerts;
+DROP FUNCTION fn_set_ep_state_ack_time();
+
+ALTER TABLE escalation_policy_state
+    DROP COLUMN ack_time,
+    DROP COLUMN ack_timeout_count;
+
+ALTER TABLE services
+    DROP COLUMN ack_timeout_minutes,
+    DROP COLUMN ack_timeout_limit;
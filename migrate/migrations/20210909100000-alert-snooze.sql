@@ -0,0 +1,45 @@
+-- +migrate Up
+UPDATE engine_processing_versions
+SET version = 8
+WHERE type_id = 'escalation';
+
+ALTER TABLE escalation_policy_state
+    ADD COLUMN snooze_until TIMESTAMPTZ;
+
+-- +migrate StatementBegin
+CREATE OR REPLACE FUNCTION fn_set_ep_state_ack_time() RETURNS TRIGGER AS
+$$
+BEGIN
+
+    UPDATE escalation_policy_state
+    SET
+        ack_time = CASE WHEN NEW.status = 'active' THEN now() END,
+        snooze_until = NULL
+    WHERE alert_id = NEW.id;
+
+    RETURN NEW;
+END;
+$$ LANGUAGE 'plpgsql';
+-- +migrate StatementEnd
+
+-- +migrate Down
+UPDATE engine_processing_versions
+SET version = 7
+WHERE type_id = 'escalation';
+
+-- +migrate StatementBegin
+CREATE OR REPLACE FUNCTION fn_set_ep_state_ack_time() RETURNS TRIGGER AS
+$$
+BEGIN
+
+    UPDATE escalation_policy_state
+    SET ack_time = CASE WHEN NEW.status = 'active' THEN now() END
+    WHERE alert_id = NEW.id;
+
+    RETURN NEW;
+END;
+$$ LANGUAGE 'plpgsql';
+-- +migrate StatementEnd
+
+ALTER TABLE escalation_policy_state
+    DROP COLUMN snooze_until;
@@ -0,0 +1,34 @@
+-- +migrate Up
+UPDATE engine_processing_versions
+SET version = 9
+WHERE type_id = 'escalation';
+
+ALTER TABLE escalation_policy_state
+    ADD COLUMN safety_net_notified BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- +migrate StatementBegin
+CREATE FUNCTION fn_ep_step_reachable(_step_id UUID) RETURNS BOOLEAN AS $$
+    SELECT
+        EXISTS (
+            SELECT 1
+            FROM escalation_policy_actions act
+            WHERE act.escalation_policy_step_id = _step_id AND act.channel_id NOTNULL
+        ) OR EXISTS (
+            SELECT 1
+            FROM ep_step_on_call_users oc
+            JOIN user_notification_rules rule ON rule.user_id = oc.user_id
+            JOIN user_contact_methods cm ON cm.id = rule.contact_method_id AND NOT cm.disabled
+            WHERE oc.ep_step_id = _step_id AND oc.end_time ISNULL
+        )
+$$ LANGUAGE SQL STABLE;
+-- +migrate StatementEnd
+
+-- +migrate Down
+UPDATE engine_processing_versions
+SET version = 8
+WHERE type_id = 'escalation';
+
+DROP FUNCTION fn_ep_step_reachable(UUID);
+
+ALTER TABLE escalation_policy_state
+    DROP COLUMN safety_net_notified;
@@ -0,0 +1,14 @@
+-- +migrate Up
+CREATE TABLE escalation_policy_templates (
+    id UUID PRIMARY KEY,
+    name TEXT NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    repeat INT NOT NULL DEFAULT 0,
+    repeat_backoff_minutes INT NOT NULL DEFAULT 0,
+    steps JSONB NOT NULL DEFAULT '[]'
+);
+
+CREATE UNIQUE INDEX idx_ep_template_name ON escalation_policy_templates (lower(name));
+
+-- +migrate Down
+DROP TABLE escalation_policy_templates;
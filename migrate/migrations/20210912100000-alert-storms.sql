@@ -0,0 +1,23 @@
+-- +migrate Up
+ALTER TABLE services
+    ADD COLUMN alert_storm_threshold INT NOT NULL DEFAULT 0 CHECK (alert_storm_threshold BETWEEN 0 AND 10000),
+    ADD COLUMN alert_storm_window_minutes INT NOT NULL DEFAULT 5 CHECK (alert_storm_window_minutes BETWEEN 1 AND 1440);
+
+CREATE TABLE alert_storms (
+    service_id UUID PRIMARY KEY REFERENCES services (id) ON DELETE CASCADE,
+    alert_id BIGINT NOT NULL REFERENCES alerts (id) ON DELETE CASCADE,
+    alert_count INT NOT NULL DEFAULT 0,
+    started_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    last_alert_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX idx_alert_storm_alert ON alert_storms (alert_id);
+CREATE INDEX idx_alert_service_created ON alerts (service_id, created_at);
+
+-- +migrate Down
+DROP INDEX idx_alert_service_created;
+DROP TABLE alert_storms;
+
+ALTER TABLE services
+    DROP COLUMN alert_storm_threshold,
+    DROP COLUMN alert_storm_window_minutes;
@@ -0,0 +1,16 @@
+-- +migrate Up
+CREATE TABLE api_keys (
+    id UUID PRIMARY KEY,
+    name TEXT NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    user_id UUID REFERENCES users (id) ON DELETE CASCADE,
+    scope TEXT NOT NULL CHECK (scope IN ('read_only', 'alert_ops', 'admin')),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    expires_at TIMESTAMPTZ,
+    last_used_at TIMESTAMPTZ
+);
+
+CREATE INDEX idx_api_keys_user ON api_keys (user_id);
+
+-- +migrate Down
+DROP TABLE api_keys;
@@ -0,0 +1,35 @@
+-- +migrate Up
+CREATE TABLE teams (
+    id UUID PRIMARY KEY,
+    name TEXT NOT NULL,
+    description TEXT NOT NULL DEFAULT ''
+);
+
+CREATE UNIQUE INDEX idx_team_name ON teams (lower(name));
+
+CREATE TABLE team_members (
+    team_id UUID NOT NULL REFERENCES teams (id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    PRIMARY KEY (team_id, user_id)
+);
+
+CREATE INDEX idx_team_members_user ON team_members (user_id);
+
+ALTER TABLE services ADD COLUMN team_id UUID REFERENCES teams (id) ON DELETE SET NULL;
+ALTER TABLE schedules ADD COLUMN team_id UUID REFERENCES teams (id) ON DELETE SET NULL;
+ALTER TABLE escalation_policies ADD COLUMN team_id UUID REFERENCES teams (id) ON DELETE SET NULL;
+ALTER TABLE rotations ADD COLUMN team_id UUID REFERENCES teams (id) ON DELETE SET NULL;
+
+CREATE INDEX idx_services_team ON services (team_id);
+CREATE INDEX idx_schedules_team ON schedules (team_id);
+CREATE INDEX idx_escalation_policies_team ON escalation_policies (team_id);
+CREATE INDEX idx_rotations_team ON rotations (team_id);
+
+-- +migrate Down
+ALTER TABLE services DROP COLUMN team_id;
+ALTER TABLE schedules DROP COLUMN team_id;
+ALTER TABLE escalation_policies DROP COLUMN team_id;
+ALTER TABLE rotations DROP COLUMN team_id;
+
+DROP TABLE team_members;
+DROP TABLE teams;
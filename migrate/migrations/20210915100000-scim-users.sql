@@ -0,0 +1,18 @@
+-- +migrate Up
+ALTER TABLE users ADD COLUMN disabled BOOLEAN NOT NULL DEFAULT false;
+
+CREATE TABLE scim_users (
+    user_id UUID PRIMARY KEY REFERENCES users (id) ON DELETE CASCADE,
+    user_name TEXT NOT NULL,
+    external_id TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE UNIQUE INDEX idx_scim_user_name ON scim_users (lower(user_name));
+CREATE INDEX idx_scim_external_id ON scim_users (external_id);
+
+-- +migrate Down
+DROP TABLE scim_users;
+
+ALTER TABLE users DROP COLUMN disabled;
@@ -0,0 +1,21 @@
+-- +migrate Up
+CREATE TABLE auth_basic_mfa (
+    user_id UUID PRIMARY KEY REFERENCES auth_basic_users (user_id) ON DELETE CASCADE,
+    totp_secret BYTEA NOT NULL,
+    enabled BOOLEAN NOT NULL DEFAULT false,
+    last_counter BIGINT NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE TABLE auth_basic_recovery_codes (
+    id BIGSERIAL PRIMARY KEY,
+    user_id UUID NOT NULL REFERENCES auth_basic_mfa (user_id) ON DELETE CASCADE,
+    code_hash BYTEA NOT NULL,
+    used_at TIMESTAMPTZ
+);
+
+CREATE INDEX idx_auth_basic_recovery_codes_user ON auth_basic_recovery_codes (user_id);
+
+-- +migrate Down
+DROP TABLE auth_basic_recovery_codes;
+DROP TABLE auth_basic_mfa;
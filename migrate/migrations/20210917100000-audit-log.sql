@@ -0,0 +1,109 @@
+-- +migrate Up
+CREATE TYPE enum_audit_log_action AS ENUM (
+    'create',
+    'update',
+    'delete'
+);
+
+-- actor columns default to the transaction-local settings applied by the audit package
+CREATE TABLE audit_logs (
+    id BIGSERIAL PRIMARY KEY,
+    timestamp TIMESTAMPTZ NOT NULL DEFAULT now(),
+    actor_type TEXT NOT NULL DEFAULT coalesce(nullif(current_setting('goalert.audit_actor_type', true), ''), 'system'),
+    actor_id TEXT NOT NULL DEFAULT coalesce(current_setting('goalert.audit_actor_id', true), ''),
+    actor_user_id UUID DEFAULT nullif(current_setting('goalert.audit_actor_user_id', true), '')::UUID,
+    object_type TEXT NOT NULL,
+    object_id TEXT NOT NULL,
+    action enum_audit_log_action NOT NULL,
+    before JSONB,
+    after JSONB
+);
+
+CREATE INDEX idx_audit_logs_timestamp ON audit_logs (timestamp);
+CREATE INDEX idx_audit_logs_object ON audit_logs (object_type, object_id);
+CREATE INDEX idx_audit_logs_actor_user ON audit_logs (actor_user_id);
+
+-- TG_ARGV[0] is the object type, any additional arguments are columns to ignore
+-- (e.g., values updated by the engine rather than a person).
+-- +migrate StatementBegin
+CREATE OR REPLACE FUNCTION fn_audit_log() RETURNS TRIGGER AS
+$$
+DECLARE
+    ignored TEXT[] := TG_ARGV[1:];
+    old_data JSONB;
+    new_data JSONB;
+BEGIN
+    IF TG_OP <> 'INSERT' THEN
+        old_data := to_jsonb(OLD) - ignored;
+    END IF;
+    IF TG_OP <> 'DELETE' THEN
+        new_data := to_jsonb(NEW) - ignored;
+    END IF;
+
+    IF TG_OP = 'UPDATE' AND old_data = new_data THEN
+        RETURN NULL;
+    END IF;
+
+    INSERT INTO audit_logs (object_type, object_id, action, before, after)
+    VALUES (
+        TG_ARGV[0],
+        coalesce(new_data, old_data)->>'id',
+        (CASE TG_OP WHEN 'INSERT' THEN 'create' WHEN 'UPDATE' THEN 'update' ELSE 'delete' END)::enum_audit_log_action,
+        old_data,
+        new_data
+    );
+
+    RETURN NULL;
+END;
+$$ LANGUAGE 'plpgsql';
+-- +migrate StatementEnd
+
+CREATE TRIGGER trg_audit_log AFTER INSERT OR UPDATE OR DELETE ON services
+FOR EACH ROW EXECUTE PROCEDURE fn_audit_log('service');
+
+CREATE TRIGGER trg_audit_log AFTER INSERT OR UPDATE OR DELETE ON integration_keys
+FOR EACH ROW EXECUTE PROCEDURE fn_audit_log('integrationKey');
+
+CREATE TRIGGER trg_audit_log AFTER INSERT OR UPDATE OR DELETE ON escalation_policies
+FOR EACH ROW EXECUTE PROCEDURE fn_audit_log('escalationPolicy');
+
+CREATE TRIGGER trg_audit_log AFTER INSERT OR UPDATE OR DELETE ON escalation_policy_steps
+FOR EACH ROW EXECUTE PROCEDURE fn_audit_log('escalationPolicyStep');
+
+CREATE TRIGGER trg_audit_log AFTER INSERT OR UPDATE OR DELETE ON escalation_policy_actions
+FOR EACH ROW EXECUTE PROCEDURE fn_audit_log('escalationPolicyStepTarget');
+
+CREATE TRIGGER trg_audit_log AFTER INSERT OR UPDATE OR DELETE ON schedules
+FOR EACH ROW EXECUTE PROCEDURE fn_audit_log('schedule');
+
+CREATE TRIGGER trg_audit_log AFTER INSERT OR UPDATE OR DELETE ON schedule_rules
+FOR EACH ROW EXECUTE PROCEDURE fn_audit_log('scheduleRule');
+
+CREATE TRIGGER trg_audit_log AFTER INSERT OR UPDATE OR DELETE ON rotations
+FOR EACH ROW EXECUTE PROCEDURE fn_audit_log('rotation');
+
+CREATE TRIGGER trg_audit_log AFTER INSERT OR UPDATE OR DELETE ON rotation_participants
+FOR EACH ROW EXECUTE PROCEDURE fn_audit_log('rotationParticipant');
+
+CREATE TRIGGER trg_audit_log AFTER INSERT OR UPDATE OR DELETE ON users
+FOR EACH ROW EXECUTE PROCEDURE fn_audit_log('user');
+
+CREATE TRIGGER trg_audit_log AFTER INSERT OR UPDATE OR DELETE ON user_contact_methods
+FOR EACH ROW EXECUTE PROCEDURE fn_audit_log('contactMethod', 'metadata', 'last_test_verify_at');
+
+-- +migrate Down
+DROP TRIGGER trg_audit_log ON services;
+DROP TRIGGER trg_audit_log ON integration_keys;
+DROP TRIGGER trg_audit_log ON escalation_policies;
+DROP TRIGGER trg_audit_log ON escalation_policy_steps;
+DROP TRIGGER trg_audit_log ON escalation_policy_actions;
+DROP TRIGGER trg_audit_log ON schedules;
+DROP TRIGGER trg_audit_log ON schedule_rules;
+DROP TRIGGER trg_audit_log ON rotations;
+DROP TRIGGER trg_audit_log ON rotation_participants;
+DROP TRIGGER trg_audit_log ON users;
+DROP TRIGGER trg_audit_log ON user_contact_methods;
+
+DROP FUNCTION fn_audit_log();
+DROP TABLE audit_logs;
+DROP TYPE enum_audit_log_action;
@@ -0,0 +1,5 @@
+-- +migrate Up
+ALTER TABLE auth_user_sessions ADD COLUMN ip_address TEXT NOT NULL DEFAULT '';
+
+-- +migrate Down
+ALTER TABLE auth_user_sessions DROP COLUMN ip_address;
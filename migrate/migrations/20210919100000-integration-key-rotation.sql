@@ -0,0 +1,29 @@
+-- +migrate Up
+-- secret is NULL until the key is rotated, in which case the ID is used as the secret
+ALTER TABLE integration_keys
+    ADD COLUMN secret UUID UNIQUE,
+    ADD COLUMN prev_secret UUID UNIQUE,
+    ADD COLUMN prev_secret_expires_at TIMESTAMPTZ,
+    ADD COLUMN expires_at TIMESTAMPTZ,
+    ADD COLUMN created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    ADD COLUMN last_used_at TIMESTAMPTZ,
+    ADD COLUMN request_count BIGINT NOT NULL DEFAULT 0;
+
+-- secrets and usage tracking are not recorded in the audit log
+DROP TRIGGER trg_audit_log ON integration_keys;
+CREATE TRIGGER trg_audit_log AFTER INSERT OR UPDATE OR DELETE ON integration_keys
+FOR EACH ROW EXECUTE PROCEDURE fn_audit_log('integrationKey', 'secret', 'prev_secret', 'last_used_at', 'request_count');
+
+-- +migrate Down
+DROP TRIGGER trg_audit_log ON integration_keys;
+CREATE TRIGGER trg_audit_log AFTER INSERT OR UPDATE OR DELETE ON integration_keys
+FOR EACH ROW EXECUTE PROCEDURE fn_audit_log('integrationKey');
+
+ALTER TABLE integration_keys
+    DROP COLUMN secret,
+    DROP COLUMN prev_secret,
+    DROP COLUMN prev_secret_expires_at,
+    DROP COLUMN expires_at,
+    DROP COLUMN created_at,
+    DROP COLUMN last_used_at,
+    DROP COLUMN request_count;
@@ -0,0 +1,9 @@
+-- +migrate Up
+ALTER TABLE auth_basic_mfa
+    ADD COLUMN failed_attempts INT NOT NULL DEFAULT 0,
+    ADD COLUMN locked_at TIMESTAMPTZ;
+
+-- +migrate Down
+ALTER TABLE auth_basic_mfa
+    DROP COLUMN failed_attempts,
+    DROP COLUMN locked_at;
@@ -0,0 +1,19 @@
+-- +migrate Up
+-- step and participant counts are maintained by triggers, and are already recorded as
+-- changes to steps and participants
+DROP TRIGGER trg_audit_log ON escalation_policies;
+CREATE TRIGGER trg_audit_log AFTER INSERT OR UPDATE OR DELETE ON escalation_policies
+FOR EACH ROW EXECUTE PROCEDURE fn_audit_log('escalationPolicy', 'step_count');
+
+DROP TRIGGER trg_audit_log ON rotations;
+CREATE TRIGGER trg_audit_log AFTER INSERT OR UPDATE OR DELETE ON rotations
+FOR EACH ROW EXECUTE PROCEDURE fn_audit_log('rotation', 'participant_count');
+
+-- +migrate Down
+DROP TRIGGER trg_audit_log ON escalation_policies;
+CREATE TRIGGER trg_audit_log AFTER INSERT OR UPDATE OR DELETE ON escalation_policies
+FOR EACH ROW EXECUTE PROCEDURE fn_audit_log('escalationPolicy');
+
+DROP TRIGGER trg_audit_log ON rotations;
+CREATE TRIGGER trg_audit_log AFTER INSERT OR UPDATE OR DELETE ON rotations
+FOR EACH ROW EXECUTE PROCEDURE fn_audit_log('rotation');
@@ -0,0 +1,43 @@
+-- +migrate Up
+UPDATE engine_processing_versions
+SET version = 10
+WHERE type_id = 'escalation';
+
+-- fn_ep_step_failed returns true if every notification for the alert since _since has
+-- failed, and no further notification rules of notified users are pending.
+-- +migrate StatementBegin
+CREATE FUNCTION fn_ep_step_failed(_alert_id BIGINT, _since TIMESTAMPTZ) RETURNS BOOLEAN AS $$
+    SELECT
+        EXISTS (
+            SELECT 1
+            FROM outgoing_messages om
+            WHERE
+                om.alert_id = _alert_id AND
+                om.message_type = 'alert_notification' AND
+                om.created_at >= _since
+        ) AND NOT EXISTS (
+            SELECT 1
+            FROM outgoing_messages om
+            WHERE
+                om.alert_id = _alert_id AND
+                om.message_type = 'alert_notification' AND
+                om.created_at >= _since AND
+                om.last_status <> 'failed'
+        ) AND NOT EXISTS (
+            SELECT 1
+            FROM notification_policy_cycles cycle
+            JOIN user_notification_rules rule ON rule.user_id = cycle.user_id
+            JOIN user_contact_methods cm ON cm.id = rule.contact_method_id AND NOT cm.disabled
+            WHERE
+                cycle.alert_id = _alert_id AND
+                cycle.started_at + rule.delay_minutes * interval '1 minute' > now()
+        )
+$$ LANGUAGE SQL STABLE;
+-- +migrate StatementEnd
+
+-- +migrate Down
+UPDATE engine_processing_versions
+SET version = 9
+WHERE type_id = 'escalation';
+
+DROP FUNCTION fn_ep_step_failed(BIGINT, TIMESTAMPTZ);
@@ -0,0 +1,36 @@
+-- +migrate Up
+-- usage is tracked separately, so authorizing requests doesn't lock or audit the key itself
+CREATE TABLE integration_key_usage (
+    integration_key_id UUID PRIMARY KEY REFERENCES integration_keys (id) ON DELETE CASCADE,
+    last_used_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    request_count BIGINT NOT NULL DEFAULT 0
+);
+
+INSERT INTO integration_key_usage (integration_key_id, last_used_at, request_count)
+SELECT id, last_used_at, request_count
+FROM integration_keys
+WHERE last_used_at NOTNULL;
+
+DROP TRIGGER trg_audit_log ON integration_keys;
+CREATE TRIGGER trg_audit_log AFTER INSERT OR UPDATE OR DELETE ON integration_keys
+FOR EACH ROW EXECUTE PROCEDURE fn_audit_log('integrationKey', 'secret', 'prev_secret');
+
+ALTER TABLE integration_keys
+    DROP COLUMN last_used_at,
+    DROP COLUMN request_count;
+
+-- +migrate Down
+ALTER TABLE integration_keys
+    ADD COLUMN last_used_at TIMESTAMPTZ,
+    ADD COLUMN request_count BIGINT NOT NULL DEFAULT 0;
+
+UPDATE integration_keys k
+SET last_used_at = u.last_used_at, request_count = u.request_count
+FROM integration_key_usage u
+WHERE u.integration_key_id = k.id;
+
+DROP TRIGGER trg_audit_log ON integration_keys;
+CREATE TRIGGER trg_audit_log AFTER INSERT OR UPDATE OR DELETE ON integration_keys
+FOR EACH ROW EXECUTE PROCEDURE fn_audit_log('integrationKey', 'secret', 'prev_secret', 'last_used_at', 'request_count');
+
+DROP TABLE integration_key_usage;
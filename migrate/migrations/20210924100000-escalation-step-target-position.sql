@@ -0,0 +1,33 @@
+-- +migrate Up
+UPDATE engine_processing_versions
+SET version = 11
+WHERE type_id = 'escalation';
+
+ALTER TABLE escalation_policy_actions
+    ADD COLUMN position INT NOT NULL DEFAULT 0;
+
+-- keep the previous order (by name) for existing steps
+UPDATE escalation_policy_actions act
+SET position = ordered.position
+FROM (
+    SELECT
+        act.id,
+        row_number() OVER (
+            PARTITION BY act.escalation_policy_step_id
+            ORDER BY coalesce(u.name, rot.name, sched.name, chan.name), act.id
+        ) - 1 AS position
+    FROM escalation_policy_actions act
+    LEFT JOIN users u ON u.id = act.user_id
+    LEFT JOIN rotations rot ON rot.id = act.rotation_id
+    LEFT JOIN schedules sched ON sched.id = act.schedule_id
+    LEFT JOIN notification_channels chan ON chan.id = act.channel_id
+) ordered
+WHERE act.id = ordered.id;
+
+-- +migrate Down
+UPDATE engine_processing_versions
+SET version = 10
+WHERE type_id = 'escalation';
+
+ALTER TABLE escalation_policy_actions
+    DROP COLUMN position;
@@ -0,0 +1,16 @@
+-- +migrate Up
+ALTER TABLE user_contact_methods
+    ADD COLUMN disabled_by_deactivation BOOLEAN NOT NULL DEFAULT false;
+
+-- contact methods of currently deactivated users were disabled on deactivation
+UPDATE user_contact_methods cm
+SET disabled_by_deactivation = true
+FROM users u
+WHERE
+    u.id = cm.user_id AND
+    u.disabled AND
+    cm.disabled;
+
+-- +migrate Down
+ALTER TABLE user_contact_methods
+    DROP COLUMN disabled_by_deactivation;
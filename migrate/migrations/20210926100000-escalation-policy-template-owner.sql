@@ -0,0 +1,11 @@
+-- +migrate Up
+ALTER TABLE escalation_policy_templates
+    ADD COLUMN team_id UUID REFERENCES teams (id) ON DELETE SET NULL,
+    ADD COLUMN created_by_user_id UUID REFERENCES users (id) ON DELETE SET NULL;
+
+CREATE INDEX idx_ep_templates_team ON escalation_policy_templates (team_id);
+
+-- +migrate Down
+ALTER TABLE escalation_policy_templates
+    DROP COLUMN team_id,
+    DROP COLUMN created_by_user_id;
@@ -0,0 +1,44 @@
+-- +migrate Up
+UPDATE engine_processing_versions
+SET version = 12
+WHERE type_id = 'escalation';
+
+-- fn_ep_alert_failed returns true if no notification for the alert was sent, or is still
+-- pending, and no further notification rules of notified users are pending.
+-- +migrate StatementBegin
+CREATE FUNCTION fn_ep_alert_failed(_alert_id BIGINT) RETURNS BOOLEAN AS $$
+    SELECT
+        NOT EXISTS (
+            SELECT 1
+            FROM outgoing_messages om
+            WHERE
+                om.alert_id = _alert_id AND
+                om.message_type = 'alert_notification' AND
+                om.last_status <> 'failed'
+        ) AND NOT EXISTS (
+            SELECT 1
+            FROM notification_policy_cycles cycle
+            WHERE
+                cycle.alert_id = _alert_id AND
+                (
+                    -- initial notification rules not yet processed
+                    cycle.last_tick ISNULL OR
+                    EXISTS (
+                        SELECT 1
+                        FROM user_notification_rules rule
+                        JOIN user_contact_methods cm ON cm.id = rule.contact_method_id AND NOT cm.disabled
+                        WHERE
+                            rule.user_id = cycle.user_id AND
+                            cycle.started_at + rule.delay_minutes * interval '1 minute' > now()
+                    )
+                )
+        )
+$$ LANGUAGE SQL STABLE;
+-- +migrate StatementEnd
+
+-- +migrate Down
+UPDATE engine_processing_versions
+SET version = 11
+WHERE type_id = 'escalation';
+
+DROP FUNCTION fn_ep_alert_failed(BIGINT);
@@ -95,6 +95,17 @@ func (s *Sender) Send(ctx context.Context, msg notification.Message) (*notificat
 			},
 		}}
 		e.Body.Outros = []string{"You are receiving this message because you have status updates enabled. Visit your Profile page to change this."}
+	case notification.ShiftReminder:
+		subject = m.Text()
+		e.Body.Title = "On-Call Shift Reminder"
+		e.Body.Intros = []string{m.Text()}
+		e.Body.Actions = []hermes.Action{{
+			Button: hermes.Button{
+				Text: "Open Schedule",
+				Link: m.ScheduleURL,
+			},
+		}}
+		e.Body.Outros = []string{"You are receiving this message because you have shift reminders enabled. Visit your Profile page to change this."}
 	default:
 		return nil, errors.New("message type not supported")
 	}
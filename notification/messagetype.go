@@ -25,6 +25,8 @@ const (
 	// messages are now dropped.
 	MessageTypeAlertStatusBundle
 	MessageTypeScheduleOnCallUsers
+	MessageTypeShiftStartReminder
+	MessageTypeShiftEnded
 )
 
 func (s MessageType) Value() (driver.Value, error) {
@@ -43,6 +45,10 @@ func (s MessageType) Value() (driver.Value, error) {
 		return "alert_status_update_bundle", nil
 	case MessageTypeScheduleOnCallUsers:
 		return "schedule_on_call_notification", nil
+	case MessageTypeShiftStartReminder:
+		return "shift_start_reminder", nil
+	case MessageTypeShiftEnded:
+		return "shift_end_notification", nil
 	}
 	return nil, fmt.Errorf("could not process unknown type for MessageType %s", s)
 }
@@ -65,6 +71,10 @@ func (s *MessageType) Scan(value interface{}) error {
 		*s = MessageTypeAlertStatusBundle
 	case "schedule_on_call_notification":
 		*s = MessageTypeScheduleOnCallUsers
+	case "shift_start_reminder":
+		*s = MessageTypeShiftStartReminder
+	case "shift_end_notification":
+		*s = MessageTypeShiftEnded
 	default:
 		return fmt.Errorf("could not process unknown type for MessageType %str", str)
 	}
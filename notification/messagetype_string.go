@@ -16,15 +16,18 @@ func _() {
 	_ = x[MessageTypeAlertBundle-5]
 	_ = x[MessageTypeAlertStatusBundle-6]
 	_ = x[MessageTypeScheduleOnCallUsers-7]
+	_ = x[MessageTypeShiftStartReminder-8]
+	_ = x[MessageTypeShiftEnded-9]
 }
 
-const _MessageType_name = "MessageTypeUnknownMessageTypeAlertMessageTypeAlertStatusMessageTypeTestMessageTypeVerificationMessageTypeAlertBundleMessageTypeAlertStatusBundleMessageTypeScheduleOnCallUsers"
+const _MessageType_name = "MessageTypeUnknownMessageTypeAlertMessageTypeAlertStatusMessageTypeTestMessageTypeVerificationMessageTypeAlertBundleMessageTypeAlertStatusBundleMessageTypeScheduleOnCallUsersMessageTypeShiftStartReminderMessageTypeShiftEnded"
 
-var _MessageType_index = [...]uint8{0, 18, 34, 56, 71, 94, 116, 144, 174}
+var _MessageType_index = [...]uint8{0, 18, 34, 56, 71, 94, 116, 144, 174, 203, 224}
 
 func (i MessageType) String() string {
-	if i < 0 || i >= MessageType(len(_MessageType_index)-1) {
+	idx := int(i) - 0
+	if i < 0 || idx >= len(_MessageType_index)-1 {
 		return "MessageType(" + strconv.FormatInt(int64(i), 10) + ")"
 	}
-	return _MessageType_name[_MessageType_index[i]:_MessageType_index[i+1]]
+	return _MessageType_name[_MessageType_index[idx]:_MessageType_index[idx+1]]
 }
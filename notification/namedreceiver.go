@@ -1,6 +1,9 @@
 package notification
 
-import "context"
+import (
+	"context"
+	"time"
+)
 
 type namedReceiver struct {
 	r  ResultReceiver
@@ -46,3 +49,15 @@ func (nr *namedReceiver) ReceiveSubject(ctx context.Context, providerID, subject
 	metricRecvTotal.WithLabelValues(nr.ns.destType.String(), result.String())
 	return nr.r.ReceiveSubject(ctx, providerID, subjectID, callbackID, result)
 }
+
+// ReceiveSnooze implements the Receiver interface by calling the underlying Receiver.ReceiveSnooze method.
+func (nr *namedReceiver) ReceiveSnooze(ctx context.Context, callbackID string, dur time.Duration) error {
+	metricRecvTotal.WithLabelValues(nr.ns.destType.String(), "SNOOZE")
+	return nr.r.ReceiveSnooze(ctx, callbackID, dur)
+}
+
+// ReceiveSubjectSnooze implements the Receiver interface by calling the underlying Receiver.ReceiveSubjectSnooze method.
+func (nr *namedReceiver) ReceiveSubjectSnooze(ctx context.Context, providerID, subjectID, callbackID string, dur time.Duration) error {
+	metricRecvTotal.WithLabelValues(nr.ns.destType.String(), "SNOOZE")
+	return nr.r.ReceiveSubjectSnooze(ctx, providerID, subjectID, callbackID, dur)
+}
@@ -3,6 +3,7 @@ package notification
 import (
 	"context"
 	"errors"
+	"time"
 )
 
 // A Receiver processes incoming messages and responses.
@@ -16,6 +17,12 @@ type Receiver interface {
 	// ReceiveSubject records a response to a previously sent message from a provider/subject (e.g. Slack user).
 	ReceiveSubject(ctx context.Context, providerID, subjectID, callbackID string, result Result) error
 
+	// ReceiveSnooze records a request to snooze the alert of a previously sent message for the given duration.
+	ReceiveSnooze(ctx context.Context, callbackID string, dur time.Duration) error
+
+	// ReceiveSubjectSnooze records a snooze request from a provider/subject (e.g. Slack user).
+	ReceiveSubjectSnooze(ctx context.Context, providerID, subjectID, callbackID string, dur time.Duration) error
+
 	// Start indicates a user has opted-in for notifications to this contact method.
 	Start(context.Context, Dest) error
 
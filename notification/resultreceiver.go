@@ -2,6 +2,7 @@ package notification
 
 import (
 	"context"
+	"time"
 )
 
 // A ResultReceiver processes notification responses.
@@ -10,6 +11,8 @@ type ResultReceiver interface {
 
 	Receive(ctx context.Context, callbackID string, result Result) error
 	ReceiveSubject(ctx context.Context, providerID, subjectID, callbackID string, result Result) error
+	ReceiveSnooze(ctx context.Context, callbackID string, dur time.Duration) error
+	ReceiveSubjectSnooze(ctx context.Context, providerID, subjectID, callbackID string, dur time.Duration) error
 	Start(context.Context, Dest) error
 	Stop(context.Context, Dest) error
 
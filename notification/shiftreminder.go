@@ -0,0 +1,54 @@
+package notification
+
+import (
+	"fmt"
+	"time"
+)
+
+// ShiftReminder is a Message that informs a user of an upcoming, or just ended,
+// on-call shift for a Schedule.
+type ShiftReminder struct {
+	Dest       Dest
+	CallbackID string
+
+	ScheduleID   string
+	ScheduleName string
+	ScheduleURL  string
+
+	// Ended indicates the shift has just ended, otherwise the shift starts
+	// after StartsIn.
+	Ended    bool
+	StartsIn time.Duration
+}
+
+var _ Message = &ShiftReminder{}
+
+func (s ShiftReminder) ID() string        { return s.CallbackID }
+func (s ShiftReminder) Destination() Dest { return s.Dest }
+func (s ShiftReminder) Type() MessageType {
+	if s.Ended {
+		return MessageTypeShiftEnded
+	}
+	return MessageTypeShiftStartReminder
+}
+
+// Text returns a short, human-readable description of the reminder.
+func (s ShiftReminder) Text() string {
+	if s.Ended {
+		return fmt.Sprintf("Your on-call shift for %s has ended.", s.ScheduleName)
+	}
+
+	mins := int(s.StartsIn.Round(time.Minute) / time.Minute)
+	switch {
+	case mins <= 0:
+		return fmt.Sprintf("Your on-call shift for %s is starting now.", s.ScheduleName)
+	case mins == 1:
+		return fmt.Sprintf("Your on-call shift for %s starts in 1 minute.", s.ScheduleName)
+	case mins == 60:
+		return fmt.Sprintf("Your on-call shift for %s starts in 1 hour.", s.ScheduleName)
+	case mins%60 == 0:
+		return fmt.Sprintf("Your on-call shift for %s starts in %d hours.", s.ScheduleName, mins/60)
+	}
+
+	return fmt.Sprintf("Your on-call shift for %s starts in %d minutes.", s.ScheduleName, mins)
+}
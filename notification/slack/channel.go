@@ -244,6 +244,10 @@ const (
 	alertResponseBlockID = "block_alert_response"
 	alertCloseActionID   = "action_alert_close"
 	alertAckActionID     = "action_alert_ack"
+	alertSnoozeActionID  = "action_alert_snooze"
+
+	// alertSnoozeDuration is how long an alert is snoozed for from the Slack snooze button.
+	alertSnoozeDuration = time.Hour
 )
 
 // alertMsgOption will return the slack.MsgOption for an alert-type message (e.g., notification or status update).
@@ -261,6 +265,7 @@ func alertMsgOption(ctx context.Context, callbackID string, id int, summary, det
 		actions = []slack.Block{
 			slack.NewDividerBlock(),
 			slack.NewActionBlock(alertResponseBlockID,
+				slack.NewButtonBlockElement(alertSnoozeActionID, callbackID, slack.NewTextBlockObject("plain_text", "Snooze 1h", false, false)),
 				slack.NewButtonBlockElement(alertCloseActionID, callbackID, slack.NewTextBlockObject("plain_text", "Close", false, false)),
 			),
 		}
@@ -270,6 +275,7 @@ func alertMsgOption(ctx context.Context, callbackID string, id int, summary, det
 			slack.NewDividerBlock(),
 			slack.NewActionBlock(alertResponseBlockID,
 				slack.NewButtonBlockElement(alertAckActionID, callbackID, slack.NewTextBlockObject("plain_text", "Acknowledge", false, false)),
+				slack.NewButtonBlockElement(alertSnoozeActionID, callbackID, slack.NewTextBlockObject("plain_text", "Snooze 1h", false, false)),
 				slack.NewButtonBlockElement(alertCloseActionID, callbackID, slack.NewTextBlockObject("plain_text", "Close", false, false)),
 			),
 		}
@@ -107,18 +107,18 @@ func (s *ChannelSender) ServeMessageAction(w http.ResponseWriter, req *http.Requ
 		return
 	}
 
-	var res notification.Result
+	providerID := "slack:" + payload.User.TeamID
 	switch act.ActionID {
 	case alertAckActionID:
-		res = notification.ResultAcknowledge
+		err = s.recv.ReceiveSubject(ctx, providerID, payload.User.ID, act.Value, notification.ResultAcknowledge)
 	case alertCloseActionID:
-		res = notification.ResultResolve
+		err = s.recv.ReceiveSubject(ctx, providerID, payload.User.ID, act.Value, notification.ResultResolve)
+	case alertSnoozeActionID:
+		err = s.recv.ReceiveSubjectSnooze(ctx, providerID, payload.User.ID, act.Value, alertSnoozeDuration)
 	default:
 		errutil.HTTPError(ctx, w, validation.NewFieldErrorf("action_id", "unknown action ID '%s'", act.ActionID))
 		return
 	}
-
-	err = s.recv.ReceiveSubject(ctx, "slack:"+payload.User.TeamID, payload.User.ID, act.Value, res)
 	if errors.Is(err, notification.ErrUnknownSubject) {
 		log.Log(ctx, fmt.Errorf("unknown provider/subject ID for Slack 'slack:%s/%s'", payload.User.TeamID, payload.User.ID))
 		err = s.withClient(ctx, func(c *slack.Client) error {
@@ -28,8 +28,45 @@ var (
 	alertReplyRx = regexp.MustCompile(`^'?\s*(c|close|a|ack[a-z]*)\s*#?\s*([0-9]+)\s*'?$`)
 
 	svcReplyRx = regexp.MustCompile(`^'?\s*([0-9]+)\s*(cc|aa)\s*'?$`)
+
+	// snoozeReplyRx matches a code followed by an optional snooze duration (e.g. '1s', '1s30', '1s2h'),
+	// where the duration is in minutes if no unit is given.
+	snoozeReplyRx = regexp.MustCompile(`^'?\s*([0-9]+)\s*s\s*(?:([0-9]+)\s*(m|h)?)?\s*'?$`)
 )
 
+// defaultSnoozeDuration is used when a snooze reply does not include a duration.
+const defaultSnoozeDuration = time.Hour
+
+// parseSnooze will return the duration of a snooze reply, or zero if invalid.
+func parseSnooze(val, unit string) time.Duration {
+	if val == "" {
+		return defaultSnoozeDuration
+	}
+	n, err := strconv.Atoi(val)
+	if err != nil || n <= 0 {
+		return 0
+	}
+	dur := time.Duration(n) * time.Minute
+	if unit == "h" {
+		dur = time.Duration(n) * time.Hour
+	}
+	if dur > alert.MaxSnoozeDuration {
+		return 0
+	}
+
+	return dur
+}
+
+// snoozeString will format a snooze duration for replies (e.g. 2h, 1h30m).
+func snoozeString(dur time.Duration) string {
+	str := dur.String()
+	str = strings.TrimSuffix(str, "0s")
+	if strings.HasSuffix(str, "h0m") {
+		str = strings.TrimSuffix(str, "0m")
+	}
+	return str
+}
+
 // SMS implements a notification.Sender for Twilio SMS.
 type SMS struct {
 	b *dbSMS
@@ -145,6 +182,8 @@ func (s *SMS) Send(ctx context.Context, msg notification.Message) (*notification
 		message = "Test message."
 	case notification.Verification:
 		message = fmt.Sprintf("Verification code: %d", t.Code)
+	case notification.ShiftReminder:
+		message = t.Text()
 	default:
 		return nil, errors.Errorf("unhandled message type %T", t)
 	}
@@ -308,6 +347,7 @@ func (s *SMS) ServeMessage(w http.ResponseWriter, req *http.Request) {
 	body = strings.ToLower(body)
 	var lookupFn func() (*codeInfo, error)
 	var result notification.Result
+	var snooze time.Duration
 	var isSvc bool
 	if m := lastReplyRx.FindStringSubmatch(body); len(m) == 2 {
 		if strings.HasPrefix(m[1], "a") {
@@ -342,6 +382,15 @@ func (s *SMS) ServeMessage(w http.ResponseWriter, req *http.Request) {
 			ctx = log.WithField(ctx, "AlertID", alertID)
 			lookupFn = func() (*codeInfo, error) { return s.b.LookupByAlertID(ctx, from, alertID) }
 		}
+	} else if m := snoozeReplyRx.FindStringSubmatch(body); len(m) == 4 {
+		snooze = parseSnooze(m[2], m[3])
+		code, err := strconv.Atoi(m[1])
+		if err != nil {
+			log.Debug(ctx, errors.Wrap(err, "parse code"))
+		} else if snooze > 0 {
+			ctx = log.WithField(ctx, "Code", code)
+			lookupFn = func() (*codeInfo, error) { return s.b.LookupByCode(ctx, from, code) }
+		}
 	} else if m := svcReplyRx.FindStringSubmatch(body); len(m) == 3 {
 		isSvc = true
 		if strings.HasPrefix(m[2], "a") {
@@ -366,7 +415,9 @@ func (s *SMS) ServeMessage(w http.ResponseWriter, req *http.Request) {
 	}
 
 	var prefix string
-	if result == notification.ResultAcknowledge {
+	if snooze > 0 {
+		prefix = "Snoozed"
+	} else if result == notification.ResultAcknowledge {
 		prefix = "Acknowledged"
 	} else {
 		prefix = "Closed"
@@ -380,7 +431,11 @@ func (s *SMS) ServeMessage(w http.ResponseWriter, req *http.Request) {
 			return errors.Wrap(err, "lookup code")
 		}
 
-		err = s.r.Receive(ctx, info.CallbackID, result)
+		if snooze > 0 {
+			err = s.r.ReceiveSnooze(ctx, info.CallbackID, snooze)
+		} else {
+			err = s.r.Receive(ctx, info.CallbackID, result)
+		}
 		if err != nil {
 			return fmt.Errorf("process notification response: %w", err)
 		}
@@ -429,6 +484,8 @@ func (s *SMS) ServeMessage(w http.ResponseWriter, req *http.Request) {
 
 	if info.ServiceName != "" {
 		respond(false, fmt.Sprintf("%s all alerts for service '%s'", prefix, info.ServiceName))
+	} else if snooze > 0 {
+		respond(false, fmt.Sprintf("%s alert #%d for %s", prefix, info.AlertID, snoozeString(snooze)))
 	} else {
 		respond(false, fmt.Sprintf("%s alert #%d", prefix, info.AlertID))
 	}
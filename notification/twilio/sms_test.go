@@ -0,0 +1,33 @@
+package twilio
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSnoozeReply(t *testing.T) {
+	check := func(body string, expDur time.Duration) {
+		t.Helper()
+		var dur time.Duration
+		if m := snoozeReplyRx.FindStringSubmatch(body); len(m) == 4 {
+			dur = parseSnooze(m[2], m[3])
+		}
+		assert.Equal(t, expDur, dur, body)
+	}
+
+	check("1s", time.Hour)
+	check("'12 s'", time.Hour)
+	check("1s30", 30*time.Minute)
+	check("1s 45m", 45*time.Minute)
+	check("1s2h", 2*time.Hour)
+	check("1s0", 0)
+	check("1s200h", 0)
+	check("1sh", 0)
+	check("s1", 0)
+
+	assert.Equal(t, "2h", snoozeString(2*time.Hour))
+	assert.Equal(t, "1h30m", snoozeString(90*time.Minute))
+	assert.Equal(t, "30m", snoozeString(30*time.Minute))
+}
@@ -28,11 +28,12 @@ type CallType string
 
 // Supported call types.
 const (
-	CallTypeAlert       = CallType("alert")
-	CallTypeAlertStatus = CallType("alert-status")
-	CallTypeTest        = CallType("test")
-	CallTypeVerify      = CallType("verify")
-	CallTypeStop        = CallType("stop")
+	CallTypeAlert         = CallType("alert")
+	CallTypeAlertStatus   = CallType("alert-status")
+	CallTypeTest          = CallType("test")
+	CallTypeVerify        = CallType("verify")
+	CallTypeStop          = CallType("stop")
+	CallTypeShiftReminder = CallType("shift-reminder")
 )
 
 // We use url encoding with no padding to try and eliminate
@@ -131,6 +132,8 @@ func (v *Voice) ServeCall(w http.ResponseWriter, req *http.Request) {
 		v.ServeStop(w, req)
 	case CallTypeVerify:
 		v.ServeVerify(w, req)
+	case CallTypeShiftReminder:
+		v.ServeShiftReminder(w, req)
 	default:
 		_, call, _ := v.getCall(w, req)
 		if !call.Outbound {
@@ -218,6 +221,9 @@ func (v *Voice) Send(ctx context.Context, msg notification.Message) (*notificati
 			prefix, count, spellNumber(t.Code), count, spellNumber(t.Code),
 		)
 		opts.CallType = CallTypeVerify
+	case notification.ShiftReminder:
+		message = fmt.Sprintf("%s with a shift reminder. %s", prefix, t.Text())
+		opts.CallType = CallTypeShiftReminder
 	default:
 		return nil, errors.Errorf("unhandled message type: %T", t)
 	}
@@ -487,6 +493,33 @@ func (v *Voice) ServeTest(w http.ResponseWriter, req *http.Request) {
 		return
 	}
 }
+
+func (v *Voice) ServeShiftReminder(w http.ResponseWriter, req *http.Request) {
+	if disabled(w, req) {
+		return
+	}
+	ctx, call, _ := v.getCall(w, req)
+	if call == nil {
+		return
+	}
+
+	resp := newTwiMLResponse(w)
+	switch call.Digits {
+	default:
+		resp.SayUnknownDigit()
+		fallthrough
+	case "", digitRepeat:
+		resp.Say(call.msgBody)
+		resp.AddOptions(optionStop)
+		resp.Gather(v.callbackURL(ctx, call.Q, CallTypeShiftReminder))
+		return
+	case digitStop:
+		call.Q.Set("previous", string(CallTypeShiftReminder))
+		resp.Redirect(v.callbackURL(ctx, call.Q, CallTypeStop))
+		return
+	}
+}
+
 func (v *Voice) ServeVerify(w http.ResponseWriter, req *http.Request) {
 	if disabled(w, req) {
 		return
@@ -57,6 +57,17 @@ type POSTDataVerification struct {
 	Code    string
 }
 
+// POSTDataShiftReminder represents fields in outgoing shift reminder notification.
+type POSTDataShiftReminder struct {
+	AppName      string
+	Type         string
+	ScheduleID   string
+	ScheduleName string
+	Ended        bool
+	StartsIn     int // minutes
+	Message      string
+}
+
 // POSTDataTest represents fields in outgoing test notification.
 type POSTDataTest struct {
 	AppName string
@@ -106,6 +117,16 @@ func (s *Sender) Send(ctx context.Context, msg notification.Message) (*notificat
 			AlertID:  m.AlertID,
 			LogEntry: m.LogEntry,
 		}
+	case notification.ShiftReminder:
+		payload = POSTDataShiftReminder{
+			AppName:      cfg.ApplicationName(),
+			Type:         "ShiftReminder",
+			ScheduleID:   m.ScheduleID,
+			ScheduleName: m.ScheduleName,
+			Ended:        m.Ended,
+			StartsIn:     int(m.StartsIn.Round(time.Minute) / time.Minute),
+			Message:      m.Text(),
+		}
 	default:
 		return nil, fmt.Errorf("message type '%s' not supported", m.Type().String())
 	}
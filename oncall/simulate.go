@@ -0,0 +1,443 @@
+package oncall
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/target/goalert/alert"
+	"github.com/target/goalert/assignment"
+	"github.com/target/goalert/escalation"
+	"github.com/target/goalert/permission"
+	"github.com/target/goalert/user/notificationrule"
+	"github.com/target/goalert/util"
+	"github.com/target/goalert/util/sqlutil"
+	"github.com/target/goalert/validation"
+	"github.com/target/goalert/validation/validate"
+)
+
+// MaxSimulationEvents is the maximum number of events returned by a simulation.
+const MaxSimulationEvents = 1000
+
+// MaxSimulationDuration is the maximum length of time that can be simulated.
+const MaxSimulationDuration = 7 * 24 * time.Hour
+
+// SimPolicy is an escalation policy configuration to simulate. It may be
+// an existing policy, or an unsaved definition.
+type SimPolicy struct {
+	Repeat               int
+	RepeatBackoffMinutes int
+
+	// Fallback, if set, is notified once after the final repeat.
+	Fallback assignment.Target
+
+	// Steps must be in step order, each with Targets populated.
+	Steps []escalation.Step
+}
+
+// SimOptions configures an escalation simulation.
+type SimOptions struct {
+	Start time.Time
+	End   time.Time
+
+	// AlertSource and AlertSummary are used to evaluate step conditions.
+	AlertSource  alert.Source
+	AlertSummary string
+}
+
+// SimEvent is a single notification that would be sent during a simulation.
+type SimEvent struct {
+	Time       time.Time
+	StepNumber int
+
+	// LoopCount is the number of times the policy has repeated.
+	LoopCount int
+
+	// UserID and ContactMethodID are set for user notifications.
+	UserID          string
+	ContactMethodID string
+
+	// Target is set for notification channel (e.g., Slack) messages.
+	Target *assignment.RawTarget
+
+	// Fallback indicates the policy fallback was notified.
+	Fallback bool
+}
+
+// OnCallFunc returns the users on call for a target at time t.
+type OnCallFunc func(tgt assignment.Target, t time.Time) []string
+
+// Simulate will calculate the notifications that would be sent for an alert
+// created at opts.Start that is never acknowledged, until opts.End.
+//
+// Step targets must be in position order. Like the engine, users of a step are ordered
+// by the first target they are on call for, then in the order returned by onCall. The
+// next user of a round-robin step depends on prior alerts, so the first user is always used.
+func Simulate(p SimPolicy, opts SimOptions, onCall OnCallFunc, rules map[string][]notificationrule.NotificationRule) []SimEvent {
+	if len(p.Steps) == 0 {
+		return nil
+	}
+
+	matches := func(idx int, t time.Time) bool {
+		return p.Steps[idx].Condition.Matches(opts.AlertSource, opts.AlertSummary, t)
+	}
+
+	canRepeat := func(loop int) bool {
+		return p.Repeat == escalation.RepeatUntilAcknowledged || loop < p.Repeat
+	}
+
+	var events []SimEvent
+	notify := func(t time.Time, stepNumber, loop int, userID string, fallback bool) {
+		for _, r := range rules[userID] {
+			nt := t.Add(time.Duration(r.DelayMinutes) * time.Minute)
+			if !nt.Before(opts.End) {
+				continue
+			}
+			events = append(events, SimEvent{
+				Time:            nt,
+				StepNumber:      stepNumber,
+				LoopCount:       loop,
+				UserID:          userID,
+				ContactMethodID: r.ContactMethodID,
+				Fallback:        fallback,
+			})
+		}
+	}
+
+	// first matching step, or the first step if none match
+	idx := 0
+	for i := range p.Steps {
+		if matches(i, opts.Start) {
+			idx = i
+			break
+		}
+	}
+
+	t := opts.Start
+	var loop int
+	var fallbackDone bool
+	for len(events) < MaxSimulationEvents {
+		step := p.Steps[idx]
+		expires := t.Add(step.Delay())
+
+		// the fallback is notified once the last matching step expires on the final loop
+		isLast := true
+		for i := idx + 1; i < len(p.Steps); i++ {
+			if matches(i, expires) {
+				isLast = false
+				break
+			}
+		}
+		if p.Fallback != nil && !fallbackDone && isLast && expires.Before(opts.End) &&
+			(p.Repeat == escalation.RepeatUntilAcknowledged || loop >= p.Repeat) {
+			fallbackDone = true
+			for _, id := range onCall(p.Fallback, expires) {
+				notify(expires, step.StepNumber, loop, id, true)
+			}
+		}
+
+		backoff := time.Duration(p.RepeatBackoffMinutes*(loop+1)) * time.Minute
+		if backoff > 24*time.Hour {
+			backoff = 24 * time.Hour
+		}
+
+		// find the next step, waiting for a step condition to match if necessary
+		next := expires
+		nextIdx := -1
+		var repeated bool
+		for ; next.Before(opts.End); next = next.Add(time.Minute) {
+			for i := idx + 1; i < len(p.Steps); i++ {
+				if matches(i, next) {
+					nextIdx = i
+					break
+				}
+			}
+			if nextIdx == -1 && canRepeat(loop) && !next.Before(expires.Add(backoff)) {
+				for i := 0; i <= idx; i++ {
+					if matches(i, next) {
+						nextIdx = i
+						repeated = true
+						break
+					}
+				}
+			}
+			if nextIdx != -1 {
+				break
+			}
+		}
+		stepEnd := opts.End
+		if nextIdx != -1 {
+			stepEnd = next
+		}
+
+		var userIDs []string
+		seen := make(map[string]bool)
+		for _, tgt := range step.Targets {
+			switch tgt.TargetType() {
+			case assignment.TargetTypeUser, assignment.TargetTypeSchedule, assignment.TargetTypeRotation:
+				for _, id := range onCall(tgt, t) {
+					if seen[id] {
+						continue
+					}
+					seen[id] = true
+					userIDs = append(userIDs, id)
+				}
+			case assignment.TargetTypeNotificationChannel, assignment.TargetTypeSlackChannel:
+				raw := assignment.NewRawTarget(tgt)
+				events = append(events, SimEvent{Time: t, StepNumber: step.StepNumber, LoopCount: loop, Target: &raw})
+			}
+		}
+
+		switch {
+		case len(userIDs) == 0:
+		case step.TargetMode == escalation.StepTargetModeRoundRobin:
+			notify(t, step.StepNumber, loop, userIDs[0], false)
+		case step.TargetMode == escalation.StepTargetModeSequential:
+			delay := time.Duration(step.SequentialDelayMinutes) * time.Minute
+			for i, id := range userIDs {
+				st := t.Add(time.Duration(i) * delay)
+				if i > 0 && !st.Before(stepEnd) {
+					break
+				}
+				notify(st, step.StepNumber, loop, id, false)
+			}
+		default:
+			for _, id := range userIDs {
+				notify(t, step.StepNumber, loop, id, false)
+			}
+		}
+
+		if nextIdx == -1 {
+			break
+		}
+		if repeated {
+			loop++
+		}
+		idx = nextIdx
+		t = next
+	}
+
+	sort.SliceStable(events, func(i, j int) bool { return events[i].Time.Before(events[j].Time) })
+	if len(events) > MaxSimulationEvents {
+		events = events[:MaxSimulationEvents]
+	}
+
+	return events
+}
+
+// SimulatePolicy will simulate escalation of an alert through the given policy, using
+// the current schedules, overrides, rotations, and user notification rules.
+func (db *DB) SimulatePolicy(ctx context.Context, p SimPolicy, opts SimOptions) ([]SimEvent, error) {
+	err := permission.LimitCheckAny(ctx, permission.User)
+	if err != nil {
+		return nil, err
+	}
+	err = validate.Many(
+		validate.Range("Repeat", p.Repeat, escalation.RepeatUntilAcknowledged, 5),
+		validate.Range("RepeatBackoffMinutes", p.RepeatBackoffMinutes, 0, 1440),
+		validate.Range("Steps", len(p.Steps), 0, 50),
+	)
+	for i, step := range p.Steps {
+		for j, tgt := range step.Targets {
+			err = validate.Many(err, validate.OneOf(fmt.Sprintf("Steps[%d].Targets[%d].Type", i, j), tgt.TargetType(),
+				assignment.TargetTypeUser,
+				assignment.TargetTypeSchedule,
+				assignment.TargetTypeRotation,
+				assignment.TargetTypeNotificationChannel,
+				assignment.TargetTypeSlackChannel,
+			))
+		}
+	}
+	if p.Fallback != nil {
+		err = validate.Many(err, validate.OneOf("Fallback.Type", p.Fallback.TargetType(),
+			assignment.TargetTypeUser,
+			assignment.TargetTypeSchedule,
+			assignment.TargetTypeRotation,
+		))
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !opts.End.After(opts.Start) {
+		return nil, validation.NewFieldError("End", "must be after start")
+	}
+	if opts.End.Sub(opts.Start) > MaxSimulationDuration {
+		return nil, validation.NewFieldError("End", "must be within 7 days of start")
+	}
+
+	var tgts []assignment.Target
+	for _, step := range p.Steps {
+		tgts = append(tgts, step.Targets...)
+	}
+	if p.Fallback != nil {
+		tgts = append(tgts, p.Fallback)
+	}
+
+	var schedIDs, rotIDs, userIDs []string
+	for _, tgt := range tgts {
+		switch tgt.TargetType() {
+		case assignment.TargetTypeSchedule:
+			schedIDs = appendUnique(schedIDs, tgt.TargetID())
+		case assignment.TargetTypeRotation:
+			rotIDs = appendUnique(rotIDs, tgt.TargetID())
+		case assignment.TargetTypeUser:
+			userIDs = appendUnique(userIDs, tgt.TargetID())
+		}
+	}
+
+	schedShifts := make(map[string][]Shift, len(schedIDs))
+	for _, id := range schedIDs {
+		shifts, err := db.HistoryBySchedule(ctx, id, opts.Start, opts.End)
+		if err != nil {
+			return nil, errors.Wrap(err, "calculate schedule shifts")
+		}
+		schedShifts[id] = shifts
+		for _, s := range shifts {
+			userIDs = appendUnique(userIDs, s.UserID)
+		}
+	}
+
+	tx, err := db.db.BeginTx(ctx, &sql.TxOptions{
+		ReadOnly:  true,
+		Isolation: sql.LevelRepeatableRead,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "begin transaction")
+	}
+	defer tx.Rollback()
+
+	rots := make(map[string]*ResolvedRotation)
+	if len(rotIDs) > 0 {
+		rots, err = db.resolveRotations(ctx, tx, rotIDs)
+		if err != nil {
+			return nil, err
+		}
+		for _, rot := range rots {
+			for _, id := range rot.Users {
+				userIDs = appendUnique(userIDs, id)
+			}
+		}
+	}
+
+	rows, err := tx.StmtContext(ctx, db.userNames).QueryContext(ctx, sqlutil.UUIDArray(userIDs))
+	if err != nil {
+		return nil, errors.Wrap(err, "lookup user names")
+	}
+	defer rows.Close()
+	names := make(map[string]string, len(userIDs))
+	for rows.Next() {
+		var id, name string
+		err = rows.Scan(&id, &name)
+		if err != nil {
+			return nil, errors.Wrap(err, "scan user name")
+		}
+		names[id] = name
+	}
+	if err = rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "lookup user names")
+	}
+
+	rows, err = tx.StmtContext(ctx, db.userNotifRules).QueryContext(ctx, sqlutil.UUIDArray(userIDs))
+	if err != nil {
+		return nil, errors.Wrap(err, "lookup notification rules")
+	}
+	defer rows.Close()
+	rules := make(map[string][]notificationrule.NotificationRule, len(userIDs))
+	for rows.Next() {
+		var r notificationrule.NotificationRule
+		err = rows.Scan(&r.ID, &r.UserID, &r.DelayMinutes, &r.ContactMethodID)
+		if err != nil {
+			return nil, errors.Wrap(err, "scan notification rule")
+		}
+		rules[r.UserID] = append(rules[r.UserID], r)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "lookup notification rules")
+	}
+
+	onCall := func(tgt assignment.Target, t time.Time) []string {
+		var ids []string
+		switch tgt.TargetType() {
+		case assignment.TargetTypeUser:
+			if _, ok := names[tgt.TargetID()]; ok {
+				ids = append(ids, tgt.TargetID())
+			}
+		case assignment.TargetTypeRotation:
+			if id := rots[tgt.TargetID()].UserID(t); id != "" {
+				ids = append(ids, id)
+			}
+		case assignment.TargetTypeSchedule:
+			for _, s := range schedShifts[tgt.TargetID()] {
+				if t.Before(s.Start) || (!s.End.IsZero() && !t.Before(s.End)) {
+					continue
+				}
+				ids = appendUnique(ids, s.UserID)
+			}
+		}
+
+		// match the engine, which orders users on call for the same target by name
+		sort.Slice(ids, func(i, j int) bool {
+			if names[ids[i]] != names[ids[j]] {
+				return names[ids[i]] < names[ids[j]]
+			}
+			return ids[i] < ids[j]
+		})
+		return ids
+	}
+
+	return Simulate(p, opts, onCall, rules), nil
+}
+
+// resolveRotations will return the current state of the given rotations, including participants.
+func (db *DB) resolveRotations(ctx context.Context, tx *sql.Tx, rotIDs []string) (map[string]*ResolvedRotation, error) {
+	rows, err := tx.StmtContext(ctx, db.rotInfo).QueryContext(ctx, sqlutil.UUIDArray(rotIDs))
+	if err != nil {
+		return nil, errors.Wrap(err, "lookup rotations")
+	}
+	defer rows.Close()
+
+	rots := make(map[string]*ResolvedRotation)
+	for rows.Next() {
+		var rot ResolvedRotation
+		var rotTZ string
+		err = rows.Scan(&rot.ID, &rot.Type, &rot.Start, &rot.ShiftLength, &rotTZ, &rot.CurrentIndex, &rot.CurrentStart)
+		if err != nil {
+			return nil, errors.Wrap(err, "scan rotation info")
+		}
+		loc, err := util.LoadLocation(rotTZ)
+		if err != nil {
+			return nil, errors.Wrap(err, "load time zone info")
+		}
+		rot.Start = rot.Start.In(loc)
+		rots[rot.ID] = &rot
+	}
+	if err = rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "lookup rotations")
+	}
+	rows.Close()
+
+	rows, err = tx.StmtContext(ctx, db.rotParts).QueryContext(ctx, sqlutil.UUIDArray(rotIDs))
+	if err != nil {
+		return nil, errors.Wrap(err, "lookup rotation participants")
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var rotID, userID string
+		err = rows.Scan(&rotID, &userID)
+		if err != nil {
+			return nil, errors.Wrap(err, "scan rotation participant info")
+		}
+		if rots[rotID] == nil {
+			continue
+		}
+		rots[rotID].Users = append(rots[rotID].Users, userID)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "lookup rotation participants")
+	}
+
+	return rots, nil
+}
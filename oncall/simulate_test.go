@@ -0,0 +1,138 @@
+package oncall
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/target/goalert/alert"
+	"github.com/target/goalert/assignment"
+	"github.com/target/goalert/escalation"
+	"github.com/target/goalert/user/notificationrule"
+)
+
+func TestSimulate(t *testing.T) {
+	start := time.Date(2021, 9, 1, 0, 0, 0, 0, time.UTC)
+	onCall := func(tgt assignment.Target, t time.Time) []string {
+		switch tgt.TargetID() {
+		case "sched":
+			return []string{"a", "b"}
+		}
+		return []string{tgt.TargetID()}
+	}
+	rules := map[string][]notificationrule.NotificationRule{
+		"a": {{ContactMethodID: "a-sms", DelayMinutes: 0}, {ContactMethodID: "a-voice", DelayMinutes: 5}},
+		"b": {{ContactMethodID: "b-sms", DelayMinutes: 0}},
+		"c": {{ContactMethodID: "c-sms", DelayMinutes: 0}},
+	}
+	steps := []escalation.Step{
+		{StepNumber: 0, DelayMinutes: 10, Targets: []assignment.Target{assignment.ScheduleTarget("sched")}},
+		{StepNumber: 1, DelayMinutes: 10, Targets: []assignment.Target{assignment.UserTarget("c"), assignment.NotificationChannelTarget("chan")}},
+	}
+
+	type ev struct {
+		Min  int
+		Step int
+		Loop int
+		Dest string
+	}
+	check := func(t *testing.T, p SimPolicy, opts SimOptions, expected []ev) {
+		t.Helper()
+		var actual []ev
+		for _, e := range Simulate(p, opts, onCall, rules) {
+			dest := e.ContactMethodID
+			if e.Target != nil {
+				dest = e.Target.ID
+			}
+			actual = append(actual, ev{Min: int(e.Time.Sub(start) / time.Minute), Step: e.StepNumber, Loop: e.LoopCount, Dest: dest})
+		}
+		assert.Equal(t, expected, actual)
+	}
+
+	t.Run("no repeat", func(t *testing.T) {
+		check(t, SimPolicy{Steps: steps}, SimOptions{Start: start, End: start.Add(time.Hour)}, []ev{
+			{0, 0, 0, "a-sms"},
+			{0, 0, 0, "b-sms"},
+			{5, 0, 0, "a-voice"},
+			{10, 1, 0, "chan"},
+			{10, 1, 0, "c-sms"},
+		})
+	})
+	t.Run("repeat", func(t *testing.T) {
+		check(t, SimPolicy{Steps: steps, Repeat: 1}, SimOptions{Start: start, End: start.Add(time.Hour)}, []ev{
+			{0, 0, 0, "a-sms"},
+			{0, 0, 0, "b-sms"},
+			{5, 0, 0, "a-voice"},
+			{10, 1, 0, "chan"},
+			{10, 1, 0, "c-sms"},
+			{20, 0, 1, "a-sms"},
+			{20, 0, 1, "b-sms"},
+			{25, 0, 1, "a-voice"},
+			{30, 1, 1, "chan"},
+			{30, 1, 1, "c-sms"},
+		})
+	})
+	t.Run("until acknowledged", func(t *testing.T) {
+		p := SimPolicy{
+			Steps:                steps,
+			Repeat:               escalation.RepeatUntilAcknowledged,
+			RepeatBackoffMinutes: 15,
+			Fallback:             assignment.UserTarget("b"),
+		}
+		check(t, p, SimOptions{Start: start, End: start.Add(80 * time.Minute)}, []ev{
+			{0, 0, 0, "a-sms"},
+			{0, 0, 0, "b-sms"},
+			{5, 0, 0, "a-voice"},
+			{10, 1, 0, "chan"},
+			{10, 1, 0, "c-sms"},
+			{20, 1, 0, "b-sms"}, // fallback
+			{35, 0, 1, "a-sms"},
+			{35, 0, 1, "b-sms"},
+			{40, 0, 1, "a-voice"},
+			{45, 1, 1, "chan"},
+			{45, 1, 1, "c-sms"},
+		})
+	})
+	t.Run("end time", func(t *testing.T) {
+		check(t, SimPolicy{Steps: steps}, SimOptions{Start: start, End: start.Add(5 * time.Minute)}, []ev{
+			{0, 0, 0, "a-sms"},
+			{0, 0, 0, "b-sms"},
+		})
+	})
+	t.Run("sequential", func(t *testing.T) {
+		seq := []escalation.Step{steps[0], steps[1]}
+		seq[0].TargetMode = escalation.StepTargetModeSequential
+		seq[0].SequentialDelayMinutes = 3
+		check(t, SimPolicy{Steps: seq}, SimOptions{Start: start, End: start.Add(12 * time.Minute)}, []ev{
+			{0, 0, 0, "a-sms"},
+			{3, 0, 0, "b-sms"},
+			{5, 0, 0, "a-voice"},
+			{10, 1, 0, "chan"},
+			{10, 1, 0, "c-sms"},
+		})
+	})
+	t.Run("sequential target order", func(t *testing.T) {
+		// c is first by position, even though a and b are first by name
+		seq := []escalation.Step{{
+			StepNumber:             0,
+			DelayMinutes:           10,
+			TargetMode:             escalation.StepTargetModeSequential,
+			SequentialDelayMinutes: 3,
+			Targets:                []assignment.Target{assignment.UserTarget("c"), assignment.ScheduleTarget("sched")},
+		}}
+		check(t, SimPolicy{Steps: seq}, SimOptions{Start: start, End: start.Add(10 * time.Minute)}, []ev{
+			{0, 0, 0, "c-sms"},
+			{3, 0, 0, "a-sms"},
+			{6, 0, 0, "b-sms"},
+			{8, 0, 0, "a-voice"},
+		})
+	})
+	t.Run("condition", func(t *testing.T) {
+		cond := []escalation.Step{steps[0], steps[1]}
+		cond[0].Condition = &escalation.StepCondition{Source: alert.SourceGrafana}
+		check(t, SimPolicy{Steps: cond}, SimOptions{Start: start, End: start.Add(time.Hour), AlertSource: alert.SourceManual}, []ev{
+			{0, 1, 0, "chan"},
+			{0, 1, 0, "c-sms"},
+		})
+	})
+}
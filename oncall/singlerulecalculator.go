@@ -57,13 +57,17 @@ func (t *TimeIterator) NewSingleRuleCalculator(loc *time.Location, rule Resolved
 			// nothing, no on-call
 		case 1:
 			// always same user
-			calc.rot.SetSpan(t.Start(), t.End().Add(t.Step()), rule.Rotation.UserID(t.Start()))
+			for _, s := range rule.Rotation.availableSpans(t.Start(), t.End().Add(t.Step()), 0) {
+				calc.rot.SetSpan(s.Start, s.End, s.UserID)
+			}
 		default:
 			cur := t.Start().In(loc)
 			// loop through rotations
 			for cur.Before(t.End()) && limit() {
-				userID := rule.Rotation.UserID(cur)
-				calc.rot.SetSpan(rule.Rotation.CurrentStart, rule.Rotation.CurrentEnd, userID)
+				rule.Rotation.UserID(cur)
+				for _, s := range rule.Rotation.availableSpans(rule.Rotation.CurrentStart, rule.Rotation.CurrentEnd, rule.Rotation.CurrentIndex) {
+					calc.rot.SetSpan(s.Start, s.End, s.UserID)
+				}
 				cur = rule.Rotation.CurrentEnd
 			}
 		}
@@ -0,0 +1,291 @@
+package oncall
+
+import (
+	"context"
+	"database/sql"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/target/goalert/assignment"
+	"github.com/target/goalert/override"
+	"github.com/target/goalert/permission"
+	"github.com/target/goalert/util/sqlutil"
+	"github.com/target/goalert/validation"
+	"github.com/target/goalert/validation/validate"
+)
+
+// Snapshot describes who was on call for an escalation policy at a point in time.
+//
+// Steps, targets, and rotation participants reflect the current configuration, as
+// configuration history is not kept. On-call users are resolved from the stored on-call
+// history of each step and schedule for the given time, so they account for overrides,
+// time off, and manual rotation changes that were in effect.
+type Snapshot struct {
+	Time               time.Time
+	EscalationPolicyID string
+	Steps              []SnapshotStep
+}
+
+// SnapshotStep describes a single escalation policy step at a point in time.
+type SnapshotStep struct {
+	StepNumber int
+	Targets    []SnapshotTarget
+
+	// UserIDs contains the users recorded as on call for the step at the time.
+	UserIDs []string
+}
+
+// SnapshotTarget describes a single step target at a point in time.
+type SnapshotTarget struct {
+	Target assignment.RawTarget
+
+	// UserIDs contains the users on call for the target at the time.
+	//
+	// For rotations, these are the users recorded as on call for the step that are
+	// (currently) participants of the rotation.
+	UserIDs []string
+
+	// Overrides contains any schedule overrides that were active at the time.
+	Overrides []override.UserOverride
+}
+
+// SnapshotByService will return the on-call snapshot for the escalation policy of the given service at time t.
+func (db *DB) SnapshotByService(ctx context.Context, serviceID string, t time.Time) (*Snapshot, error) {
+	err := permission.LimitCheckAny(ctx, permission.User)
+	if err != nil {
+		return nil, err
+	}
+	err = validate.UUID("ServiceID", serviceID)
+	if err != nil {
+		return nil, err
+	}
+
+	var epID string
+	err = db.svcPolicy.QueryRowContext(ctx, serviceID).Scan(&epID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, validation.NewFieldError("ServiceID", "not found")
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "lookup escalation policy for service")
+	}
+
+	return db.SnapshotByPolicy(ctx, epID, t)
+}
+
+// SnapshotByPolicy will return the on-call snapshot for the given escalation policy at time t.
+func (db *DB) SnapshotByPolicy(ctx context.Context, policyID string, t time.Time) (*Snapshot, error) {
+	err := permission.LimitCheckAny(ctx, permission.User)
+	if err != nil {
+		return nil, err
+	}
+	err = validate.UUID("EscalationPolicyID", policyID)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := db.db.BeginTx(ctx, &sql.TxOptions{
+		ReadOnly:  true,
+		Isolation: sql.LevelRepeatableRead,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "begin transaction")
+	}
+	defer tx.Rollback()
+
+	var now time.Time
+	err = tx.StmtContext(ctx, db.now).QueryRowContext(ctx).Scan(&now)
+	if err != nil {
+		return nil, errors.Wrap(err, "get current time")
+	}
+	if t.After(now) {
+		return nil, validation.NewFieldError("Time", "must not be in the future")
+	}
+
+	rows, err := tx.StmtContext(ctx, db.epSteps).QueryContext(ctx, policyID)
+	if err != nil {
+		return nil, errors.Wrap(err, "lookup escalation policy steps")
+	}
+	defer rows.Close()
+
+	snap := &Snapshot{Time: t, EscalationPolicyID: policyID}
+	stepIndex := make(map[string]int)
+	var stepIDs []string
+	for rows.Next() {
+		var id string
+		var step SnapshotStep
+		err = rows.Scan(&id, &step.StepNumber)
+		if err != nil {
+			return nil, errors.Wrap(err, "scan escalation policy step")
+		}
+		stepIndex[id] = len(snap.Steps)
+		stepIDs = append(stepIDs, id)
+		snap.Steps = append(snap.Steps, step)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "lookup escalation policy steps")
+	}
+	if len(stepIDs) == 0 {
+		return snap, nil
+	}
+
+	rows, err = tx.StmtContext(ctx, db.stepUsersAt).QueryContext(ctx, sqlutil.UUIDArray(stepIDs), t)
+	if err != nil {
+		return nil, errors.Wrap(err, "lookup step on-call history")
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var stepID, userID string
+		err = rows.Scan(&stepID, &userID)
+		if err != nil {
+			return nil, errors.Wrap(err, "scan step on-call history")
+		}
+		step := &snap.Steps[stepIndex[stepID]]
+		step.UserIDs = appendUnique(step.UserIDs, userID)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "lookup step on-call history")
+	}
+
+	rows, err = tx.StmtContext(ctx, db.stepTargets).QueryContext(ctx, sqlutil.UUIDArray(stepIDs))
+	if err != nil {
+		return nil, errors.Wrap(err, "lookup step targets")
+	}
+	defer rows.Close()
+	var schedIDs, rotIDs []string
+	for rows.Next() {
+		var stepID string
+		var usr, sched, rot, ch sql.NullString
+		err = rows.Scan(&stepID, &usr, &sched, &rot, &ch)
+		if err != nil {
+			return nil, errors.Wrap(err, "scan step target")
+		}
+		var tgt SnapshotTarget
+		switch {
+		case usr.Valid:
+			tgt.Target = assignment.RawTarget{Type: assignment.TargetTypeUser, ID: usr.String}
+			tgt.UserIDs = []string{usr.String}
+		case sched.Valid:
+			tgt.Target = assignment.RawTarget{Type: assignment.TargetTypeSchedule, ID: sched.String}
+			schedIDs = append(schedIDs, sched.String)
+		case rot.Valid:
+			tgt.Target = assignment.RawTarget{Type: assignment.TargetTypeRotation, ID: rot.String}
+			rotIDs = append(rotIDs, rot.String)
+		case ch.Valid:
+			tgt.Target = assignment.RawTarget{Type: assignment.TargetTypeNotificationChannel, ID: ch.String}
+		default:
+			continue
+		}
+		step := &snap.Steps[stepIndex[stepID]]
+		step.Targets = append(step.Targets, tgt)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "lookup step targets")
+	}
+
+	schedUsers := make(map[string][]string)
+	schedOverrides := make(map[string][]override.UserOverride)
+	if len(schedIDs) > 0 {
+		rows, err = tx.StmtContext(ctx, db.schedUsersAt).QueryContext(ctx, sqlutil.UUIDArray(schedIDs), t)
+		if err != nil {
+			return nil, errors.Wrap(err, "lookup schedule on-call history")
+		}
+		defer rows.Close()
+		for rows.Next() {
+			var schedID, userID string
+			err = rows.Scan(&schedID, &userID)
+			if err != nil {
+				return nil, errors.Wrap(err, "scan schedule on-call history")
+			}
+			schedUsers[schedID] = appendUnique(schedUsers[schedID], userID)
+		}
+		if err = rows.Err(); err != nil {
+			return nil, errors.Wrap(err, "lookup schedule on-call history")
+		}
+
+		rows, err = tx.StmtContext(ctx, db.schedOverridesAt).QueryContext(ctx, sqlutil.UUIDArray(schedIDs), t)
+		if err != nil {
+			return nil, errors.Wrap(err, "lookup schedule overrides")
+		}
+		defer rows.Close()
+		for rows.Next() {
+			var o override.UserOverride
+			var schedID string
+			var add, rem sql.NullString
+			err = rows.Scan(&o.ID, &add, &rem, &o.Start, &o.End, &schedID)
+			if err != nil {
+				return nil, errors.Wrap(err, "scan schedule override")
+			}
+			o.AddUserID = add.String
+			o.RemoveUserID = rem.String
+			o.Target = assignment.ScheduleTarget(schedID)
+			schedOverrides[schedID] = append(schedOverrides[schedID], o)
+		}
+		if err = rows.Err(); err != nil {
+			return nil, errors.Wrap(err, "lookup schedule overrides")
+		}
+	}
+
+	rotUsers := make(map[string]map[string]bool)
+	if len(rotIDs) > 0 {
+		rows, err = tx.StmtContext(ctx, db.rotParts).QueryContext(ctx, sqlutil.UUIDArray(rotIDs))
+		if err != nil {
+			return nil, errors.Wrap(err, "lookup rotation participants")
+		}
+		defer rows.Close()
+		for rows.Next() {
+			var rotID, userID string
+			err = rows.Scan(&rotID, &userID)
+			if err != nil {
+				return nil, errors.Wrap(err, "scan rotation participant info")
+			}
+			if rotUsers[rotID] == nil {
+				rotUsers[rotID] = make(map[string]bool)
+			}
+			rotUsers[rotID][userID] = true
+		}
+		if err = rows.Err(); err != nil {
+			return nil, errors.Wrap(err, "lookup rotation participants")
+		}
+	}
+
+	for i := range snap.Steps {
+		for j := range snap.Steps[i].Targets {
+			tgt := &snap.Steps[i].Targets[j]
+			switch tgt.Target.Type {
+			case assignment.TargetTypeSchedule:
+				tgt.UserIDs = schedUsers[tgt.Target.ID]
+				tgt.Overrides = schedOverrides[tgt.Target.ID]
+			case assignment.TargetTypeRotation:
+				// rotation state is not recorded, so use the step history
+				for _, userID := range snap.Steps[i].UserIDs {
+					if rotUsers[tgt.Target.ID][userID] {
+						tgt.UserIDs = append(tgt.UserIDs, userID)
+					}
+				}
+			}
+		}
+		sortSnapshotTargets(snap.Steps[i].Targets)
+	}
+
+	return snap, nil
+}
+
+func appendUnique(ids []string, id string) []string {
+	for _, existing := range ids {
+		if existing == id {
+			return ids
+		}
+	}
+	return append(ids, id)
+}
+
+// sortSnapshotTargets will sort targets by type, then ID.
+func sortSnapshotTargets(tgts []SnapshotTarget) {
+	sort.Slice(tgts, func(i, j int) bool {
+		if tgts[i].Target.Type != tgts[j].Target.Type {
+			return tgts[i].Target.Type < tgts[j].Target.Type
+		}
+		return tgts[i].Target.ID < tgts[j].Target.ID
+	})
+}
@@ -0,0 +1,44 @@
+package oncall
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/target/goalert/assignment"
+	"github.com/target/goalert/schedule/rotation"
+)
+
+func TestResolvedRotation_UserID_Past(t *testing.T) {
+	start := time.Date(2021, 9, 1, 0, 0, 0, 0, time.UTC)
+	rot := &ResolvedRotation{
+		Rotation: rotation.Rotation{
+			Type:        rotation.TypeDaily,
+			Start:       start,
+			ShiftLength: 1,
+		},
+		CurrentIndex: 2,
+		CurrentStart: start.AddDate(0, 0, 5),
+		Users:        []string{"a", "b", "c"},
+	}
+
+	// past shifts are resolved backwards from the current state
+	assert.Equal(t, "b", rot.UserID(start.AddDate(0, 0, 4).Add(2*time.Hour)))
+	assert.Equal(t, "a", rot.UserID(start.AddDate(0, 0, 3)))
+	assert.Equal(t, "c", rot.UserID(start.AddDate(0, 0, 2).Add(23*time.Hour)))
+}
+
+func TestSortSnapshotTargets(t *testing.T) {
+	tgts := []SnapshotTarget{
+		{Target: assignment.RawTarget{Type: assignment.TargetTypeUser, ID: "b"}},
+		{Target: assignment.RawTarget{Type: assignment.TargetTypeSchedule, ID: "z"}},
+		{Target: assignment.RawTarget{Type: assignment.TargetTypeUser, ID: "a"}},
+	}
+	sortSnapshotTargets(tgts)
+
+	assert.Equal(t, []SnapshotTarget{
+		{Target: assignment.RawTarget{Type: assignment.TargetTypeSchedule, ID: "z"}},
+		{Target: assignment.RawTarget{Type: assignment.TargetTypeUser, ID: "a"}},
+		{Target: assignment.RawTarget{Type: assignment.TargetTypeUser, ID: "b"}},
+	}, tgts)
+}
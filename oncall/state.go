@@ -9,6 +9,7 @@ import (
 	"github.com/target/goalert/schedule"
 	"github.com/target/goalert/schedule/rotation"
 	"github.com/target/goalert/schedule/rule"
+	"github.com/target/goalert/user/timeoff"
 )
 
 type ResolvedRule struct {
@@ -21,6 +22,10 @@ type ResolvedRotation struct {
 	CurrentStart time.Time
 	CurrentEnd   time.Time
 	Users        []string
+
+	// TimeOff contains periods where participants are unavailable. The participant
+	// is skipped in favor of the next available one during these periods.
+	TimeOff []timeoff.TimeOff
 }
 
 type state struct {
@@ -78,7 +83,10 @@ func (r ResolvedRule) UserID(t time.Time) string {
 	case assignment.TargetTypeUser:
 		return r.Target.TargetID()
 	case assignment.TargetTypeRotation:
-		return r.Rotation.UserID(t)
+		if r.Rotation.UserID(t) == "" {
+			return ""
+		}
+		return r.Rotation.availableUser(r.Rotation.CurrentIndex, t)
 	}
 	panic("unknown target type " + r.Target.TargetType().String())
 }
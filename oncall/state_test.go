@@ -9,6 +9,7 @@ import (
 	"github.com/target/goalert/schedule"
 	"github.com/target/goalert/schedule/rotation"
 	"github.com/target/goalert/schedule/rule"
+	"github.com/target/goalert/user/timeoff"
 	"github.com/target/goalert/util/timeutil"
 )
 
@@ -750,4 +751,43 @@ func TestState_CalculateShifts(t *testing.T) {
 		},
 	)
 
+	check("RotationTimeOff",
+		time.Date(2018, 1, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2018, 1, 4, 0, 0, 0, 0, time.UTC),
+		&state{
+			loc: time.UTC,
+			now: time.Date(2017, 12, 31, 23, 0, 0, 0, time.UTC),
+			rules: []ResolvedRule{
+				{
+					Rule: rule.Rule{
+						WeekdayFilter: timeutil.WeekdayFilter{1, 1, 1, 1, 1, 1, 1},
+						Target:        assignment.RotationTarget("rot"),
+					},
+					Rotation: &ResolvedRotation{
+						Rotation: rotation.Rotation{
+							ID:          "rot",
+							Type:        rotation.TypeDaily,
+							ShiftLength: 1,
+							Start:       time.Date(2018, 1, 1, 0, 0, 0, 0, time.UTC),
+						},
+						CurrentStart: time.Date(2018, 1, 1, 0, 0, 0, 0, time.UTC),
+						Users:        []string{"a", "b"},
+						TimeOff: []timeoff.TimeOff{
+							// a is away for the second half of their first shift
+							{UserID: "a", Start: time.Date(2018, 1, 1, 12, 0, 0, 0, time.UTC), End: time.Date(2018, 1, 2, 12, 0, 0, 0, time.UTC)},
+							// nobody is available
+							{UserID: "a", Start: time.Date(2018, 1, 3, 6, 0, 0, 0, time.UTC), End: time.Date(2018, 1, 3, 8, 0, 0, 0, time.UTC)},
+							{UserID: "b", Start: time.Date(2018, 1, 3, 6, 0, 0, 0, time.UTC), End: time.Date(2018, 1, 3, 8, 0, 0, 0, time.UTC)},
+						},
+					},
+				},
+			},
+		},
+		[]Shift{
+			{UserID: "a", Start: time.Date(2018, 1, 1, 0, 0, 0, 0, time.UTC), End: time.Date(2018, 1, 1, 12, 0, 0, 0, time.UTC)},
+			{UserID: "b", Start: time.Date(2018, 1, 1, 12, 0, 0, 0, time.UTC), End: time.Date(2018, 1, 3, 0, 0, 0, 0, time.UTC)},
+			{UserID: "a", Start: time.Date(2018, 1, 3, 0, 0, 0, 0, time.UTC), End: time.Date(2018, 1, 3, 6, 0, 0, 0, time.UTC)},
+			{UserID: "a", Start: time.Date(2018, 1, 3, 8, 0, 0, 0, time.UTC), End: time.Date(2018, 1, 4, 0, 0, 0, 0, time.UTC)},
+		},
+	)
 }
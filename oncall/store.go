@@ -13,6 +13,7 @@ import (
 	"github.com/target/goalert/permission"
 	"github.com/target/goalert/schedule"
 	"github.com/target/goalert/schedule/rule"
+	"github.com/target/goalert/user/timeoff"
 	"github.com/target/goalert/util"
 	"github.com/target/goalert/util/sqlutil"
 	"github.com/target/goalert/validation/validate"
@@ -23,6 +24,11 @@ type Store interface {
 	OnCallUsersByService(ctx context.Context, serviceID string) ([]ServiceOnCallUser, error)
 	OnCallUsersBySchedule(ctx context.Context, scheduleID string) ([]ScheduleOnCallUser, error)
 	HistoryBySchedule(ctx context.Context, scheduleID string, start, end time.Time) ([]Shift, error)
+
+	SnapshotByService(ctx context.Context, serviceID string, t time.Time) (*Snapshot, error)
+	SnapshotByPolicy(ctx context.Context, policyID string, t time.Time) (*Snapshot, error)
+
+	SimulatePolicy(ctx context.Context, p SimPolicy, opts SimOptions) ([]SimEvent, error)
 }
 
 // ScheduleOnCallUser represents a currently on-call user for a schedule.
@@ -61,6 +67,19 @@ type DB struct {
 	schedTZ     *sql.Stmt
 	schedRot    *sql.Stmt
 	rotParts    *sql.Stmt
+	rotTimeOff  *sql.Stmt
+
+	now              *sql.Stmt
+	svcPolicy        *sql.Stmt
+	epSteps          *sql.Stmt
+	stepTargets      *sql.Stmt
+	stepUsersAt      *sql.Stmt
+	schedUsersAt     *sql.Stmt
+	schedOverridesAt *sql.Stmt
+	rotInfo          *sql.Stmt
+
+	userNames      *sql.Stmt
+	userNotifRules *sql.Stmt
 
 	ruleStore  rule.Store
 	schedStore *schedule.Store
@@ -139,6 +158,99 @@ func NewDB(ctx context.Context, db *sql.DB, ruleStore rule.Store, schedStore *sc
 				rotation_id,
 				position
 		`),
+		rotTimeOff: p.P(`
+			select
+				id,
+				user_id,
+				start_time,
+				end_time,
+				description
+			from user_time_off
+			where
+				user_id = any($1) and
+				end_time > $2 and
+				start_time < $3
+		`),
+
+		now:       p.P(`select now()`),
+		svcPolicy: p.P(`select escalation_policy_id from services where id = $1`),
+		epSteps: p.P(`
+			select id, step_number
+			from escalation_policy_steps
+			where escalation_policy_id = $1
+			order by step_number
+		`),
+		stepTargets: p.P(`
+			select
+				escalation_policy_step_id,
+				user_id,
+				schedule_id,
+				rotation_id,
+				channel_id
+			from escalation_policy_actions
+			where escalation_policy_step_id = any($1)
+		`),
+		stepUsersAt: p.P(`
+			select ep_step_id, user_id
+			from ep_step_on_call_users
+			where
+				ep_step_id = any($1) and
+				start_time <= $2 and
+				(end_time isnull or end_time > $2)
+			order by start_time
+		`),
+		schedUsersAt: p.P(`
+			select schedule_id, user_id
+			from schedule_on_call_users
+			where
+				schedule_id = any($1) and
+				start_time <= $2 and
+				(end_time isnull or end_time > $2)
+			order by start_time
+		`),
+		schedOverridesAt: p.P(`
+			select
+				id,
+				add_user_id,
+				remove_user_id,
+				start_time,
+				end_time,
+				tgt_schedule_id
+			from user_overrides
+			where
+				tgt_schedule_id = any($1) and
+				start_time <= $2 and
+				end_time > $2
+			order by start_time
+		`),
+		rotInfo: p.P(`
+			select
+				rot.id,
+				rot.type,
+				rot.start_time,
+				rot.shift_length,
+				rot.time_zone,
+				state.position,
+				state.shift_start
+			from rotations rot
+			join rotation_state state on state.rotation_id = rot.id
+			where rot.id = any($1)
+		`),
+
+		userNames: p.P(`select id, name from users where id = any($1)`),
+		userNotifRules: p.P(`
+			select
+				rule.id,
+				rule.user_id,
+				rule.delay_minutes,
+				rule.contact_method_id
+			from user_notification_rules rule
+			join user_contact_methods cm on cm.id = rule.contact_method_id
+			where
+				rule.user_id = any($1) and
+				not cm.disabled
+			order by rule.delay_minutes
+		`),
 	}, p.Err
 }
 
@@ -253,6 +365,7 @@ func (db *DB) HistoryBySchedule(ctx context.Context, scheduleID string, start, e
 		return nil, errors.Wrap(err, "lookup rotation participants")
 	}
 	defer rows.Close()
+	var partIDs []string
 	for rows.Next() {
 		var rotID, userID string
 		err = rows.Scan(&rotID, &userID)
@@ -260,6 +373,33 @@ func (db *DB) HistoryBySchedule(ctx context.Context, scheduleID string, start, e
 			return nil, errors.Wrap(err, "scan rotation participant info")
 		}
 		rots[rotID].Users = append(rots[rotID].Users, userID)
+		partIDs = append(partIDs, userID)
+	}
+
+	rows, err = tx.StmtContext(ctx, db.rotTimeOff).QueryContext(ctx, sqlutil.UUIDArray(partIDs), start, end)
+	if err != nil {
+		return nil, errors.Wrap(err, "lookup rotation participant time off")
+	}
+	defer rows.Close()
+	timeOff := make(map[string][]timeoff.TimeOff)
+	for rows.Next() {
+		var t timeoff.TimeOff
+		err = rows.Scan(&t.ID, &t.UserID, &t.Start, &t.End, &t.Description)
+		if err != nil {
+			return nil, errors.Wrap(err, "scan time off info")
+		}
+		timeOff[t.UserID] = append(timeOff[t.UserID], t)
+	}
+	for _, rot := range rots {
+		seen := make(map[string]bool, len(rot.Users))
+		for _, userID := range rot.Users {
+			if seen[userID] {
+				// users may be in a rotation more than once
+				continue
+			}
+			seen[userID] = true
+			rot.TimeOff = append(rot.TimeOff, timeOff[userID]...)
+		}
 	}
 
 	rawRules, err := db.ruleStore.FindAllTx(ctx, tx, scheduleID)
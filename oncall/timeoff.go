@@ -0,0 +1,90 @@
+package oncall
+
+import (
+	"sort"
+	"time"
+
+	"github.com/target/goalert/user/timeoff"
+)
+
+// TimeOffConflict indicates a user is scheduled to be on call during their time off.
+type TimeOffConflict struct {
+	Shift   Shift
+	TimeOff timeoff.TimeOff
+}
+
+// availableUser returns the first participant, starting with the one at index idx, that
+// does not have time off at t. An empty string is returned if no participant is available.
+func (r *ResolvedRotation) availableUser(idx int, t time.Time) string {
+	for i := 0; i < len(r.Users); i++ {
+		userID := r.Users[(idx+i)%len(r.Users)]
+		if !r.hasTimeOff(userID, t) {
+			return userID
+		}
+	}
+
+	return ""
+}
+
+func (r *ResolvedRotation) hasTimeOff(userID string, t time.Time) bool {
+	for _, to := range r.TimeOff {
+		if to.UserID == userID && to.Contains(t) {
+			return true
+		}
+	}
+	return false
+}
+
+// availableSpans will return the on-call spans between start and end for the participant at index idx,
+// substituting the next available participant for any period the scheduled one has time off.
+//
+// Consecutive spans for the same user are merged, and periods where no participant is available are omitted.
+func (r *ResolvedRotation) availableSpans(start, end time.Time, idx int) []Shift {
+	if len(r.TimeOff) == 0 {
+		return []Shift{{UserID: r.Users[idx], Start: start, End: end}}
+	}
+
+	bounds := []time.Time{start, end}
+	for _, to := range r.TimeOff {
+		if to.Start.After(start) && to.Start.Before(end) {
+			bounds = append(bounds, to.Start)
+		}
+		if to.End.After(start) && to.End.Before(end) {
+			bounds = append(bounds, to.End)
+		}
+	}
+	sort.Slice(bounds, func(i, j int) bool { return bounds[i].Before(bounds[j]) })
+
+	var spans []Shift
+	for i := 0; i < len(bounds)-1; i++ {
+		if !bounds[i+1].After(bounds[i]) {
+			continue
+		}
+		userID := r.availableUser(idx, bounds[i])
+		if userID == "" {
+			continue
+		}
+		if len(spans) > 0 && spans[len(spans)-1].UserID == userID && spans[len(spans)-1].End.Equal(bounds[i]) {
+			spans[len(spans)-1].End = bounds[i+1]
+			continue
+		}
+		spans = append(spans, Shift{UserID: userID, Start: bounds[i], End: bounds[i+1]})
+	}
+
+	return spans
+}
+
+// TimeOffConflicts will return all shifts that overlap with the assigned user's time off.
+func TimeOffConflicts(shifts []Shift, timeOff []timeoff.TimeOff) []TimeOffConflict {
+	var result []TimeOffConflict
+	for _, s := range shifts {
+		for _, to := range timeOff {
+			if to.UserID != s.UserID || !to.Overlaps(s.Start, s.End) {
+				continue
+			}
+			result = append(result, TimeOffConflict{Shift: s, TimeOff: to})
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,194 @@
+package workload
+
+import (
+	"sort"
+	"time"
+
+	"github.com/target/goalert/oncall"
+	"github.com/target/goalert/util/timeutil"
+)
+
+type interval struct {
+	Start, End time.Time
+}
+
+// userIntervals will return the merged on-call intervals for each user, clamped to start and end.
+func userIntervals(shifts []oncall.Shift, start, end time.Time) map[string][]interval {
+	m := make(map[string][]interval)
+	for _, s := range shifts {
+		iv := interval{Start: s.Start, End: s.End}
+		if iv.End.IsZero() || iv.End.After(end) {
+			iv.End = end
+		}
+		if iv.Start.Before(start) {
+			iv.Start = start
+		}
+		if !iv.End.After(iv.Start) {
+			continue
+		}
+		m[s.UserID] = append(m[s.UserID], iv)
+	}
+
+	for id, ivs := range m {
+		m[id] = mergeIntervals(ivs)
+	}
+
+	return m
+}
+
+// mergeIntervals will sort and merge overlapping intervals.
+func mergeIntervals(ivs []interval) []interval {
+	if len(ivs) < 2 {
+		return ivs
+	}
+	sort.Slice(ivs, func(i, j int) bool { return ivs[i].Start.Before(ivs[j].Start) })
+
+	result := ivs[:1]
+	for _, iv := range ivs[1:] {
+		last := &result[len(result)-1]
+		if iv.Start.After(last.End) {
+			result = append(result, iv)
+			continue
+		}
+		if iv.End.After(last.End) {
+			last.End = iv.End
+		}
+	}
+
+	return result
+}
+
+// addInterval will add the duration of iv to w, categorizing time as weekend or off-hours
+// using the provided location and weekday business hours.
+func (w *UserWorkload) addInterval(iv interval, loc *time.Location, bizStart, bizEnd timeutil.Clock) {
+	var total, offHours, weekend time.Duration
+	t := iv.Start.In(loc)
+	end := iv.End.In(loc)
+	for t.Before(end) {
+		y, m, d := t.Date()
+		nextDay := time.Date(y, m, d+1, 0, 0, 0, 0, loc)
+		segEnd := nextDay
+		if end.Before(segEnd) {
+			segEnd = end
+		}
+
+		dur := segEnd.Sub(t)
+		total += dur
+		switch t.Weekday() {
+		case time.Saturday, time.Sunday:
+			weekend += dur
+		default:
+			offHours += dur - overlap(t, segEnd, bizStart.FirstOfDay(t), bizEnd.FirstOfDay(t))
+		}
+
+		t = segEnd
+	}
+
+	w.OnCallHours += total.Hours()
+	w.OffHoursHours += offHours.Hours()
+	w.WeekendHours += weekend.Hours()
+}
+
+// overlap returns the duration that [aStart, aEnd) and [bStart, bEnd) have in common.
+func overlap(aStart, aEnd, bStart, bEnd time.Time) time.Duration {
+	if bStart.After(aStart) {
+		aStart = bStart
+	}
+	if bEnd.Before(aEnd) {
+		aEnd = bEnd
+	}
+	if !aEnd.After(aStart) {
+		return 0
+	}
+	return aEnd.Sub(aStart)
+}
+
+// nightKey returns the date (YYYY-MM-DD) of the evening that starts the night containing t,
+// or an empty string if t is not during the night.
+func nightKey(t time.Time, loc *time.Location) string {
+	t = t.In(loc)
+	h, m, _ := t.Clock()
+	c := timeutil.NewClock(h, m)
+	switch {
+	case c >= NightStart:
+		return t.Format("2006-01-02")
+	case c < NightEnd:
+		return t.AddDate(0, 0, -1).Format("2006-01-02")
+	}
+	return ""
+}
+
+type page struct {
+	UserID string
+	Time   time.Time
+}
+
+// calculate will build the per-user workload from the provided shifts and pages.
+func calculate(opts ReportOptions, loc *time.Location, shifts []oncall.Shift, pages []page) []UserWorkload {
+	byUser := make(map[string]*UserWorkload)
+	get := func(id string) *UserWorkload {
+		w := byUser[id]
+		if w == nil {
+			w = &UserWorkload{UserID: id}
+			byUser[id] = w
+		}
+		return w
+	}
+	for _, id := range opts.UserIDs {
+		get(id)
+	}
+	include := func(id string) bool {
+		if len(opts.UserIDs) == 0 {
+			return true
+		}
+		_, ok := byUser[id]
+		return ok
+	}
+
+	for id, ivs := range userIntervals(shifts, opts.Start, opts.End) {
+		if !include(id) {
+			continue
+		}
+		w := get(id)
+		for _, iv := range ivs {
+			w.addInterval(iv, loc, opts.BusinessStart, opts.BusinessEnd)
+		}
+	}
+
+	nights := make(map[string]map[string]struct{})
+	for _, p := range pages {
+		if !include(p.UserID) {
+			continue
+		}
+		w := get(p.UserID)
+		w.PagesReceived++
+
+		key := nightKey(p.Time, loc)
+		if key == "" {
+			continue
+		}
+		if nights[p.UserID] == nil {
+			nights[p.UserID] = make(map[string]struct{})
+		}
+		nights[p.UserID][key] = struct{}{}
+	}
+	for id, n := range nights {
+		byUser[id].NightsInterrupted = len(n)
+	}
+
+	result := make([]UserWorkload, 0, len(byUser))
+	for _, w := range byUser {
+		result = append(result, *w)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].OnCallHours != result[j].OnCallHours {
+			return result[i].OnCallHours > result[j].OnCallHours
+		}
+		if result[i].PagesReceived != result[j].PagesReceived {
+			return result[i].PagesReceived > result[j].PagesReceived
+		}
+		return result[i].UserID < result[j].UserID
+	})
+
+	return result
+}
@@ -0,0 +1,84 @@
+package workload
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/target/goalert/oncall"
+)
+
+func TestCalculate(t *testing.T) {
+	// 2021-08-02 is a Monday
+	day := func(d, h int) time.Time { return time.Date(2021, 8, d, h, 0, 0, 0, time.UTC) }
+	opts := ReportOptions{
+		Start:         day(2, 0),
+		End:           day(9, 0),
+		BusinessStart: DefaultBusinessStart,
+		BusinessEnd:   DefaultBusinessEnd,
+	}
+
+	shifts := []oncall.Shift{
+		// Monday 8am-6pm: 2 off-hours (8-9, 17-18)
+		{UserID: "a", Start: day(2, 8), End: day(2, 18)},
+		// overlapping shift from another schedule should not double count
+		{UserID: "a", Start: day(2, 12), End: day(2, 14)},
+		// Saturday all day, starting before the report window ends
+		{UserID: "b", Start: day(7, 0), End: day(8, 0)},
+		// truncated shift, clamped to the end of the window
+		{UserID: "b", Start: day(8, 12), Truncated: true},
+	}
+	pages := []page{
+		{UserID: "a", Time: day(2, 23)},
+		{UserID: "a", Time: day(3, 2)}, // same night as previous
+		{UserID: "a", Time: day(3, 12)},
+		{UserID: "c", Time: day(4, 3)},
+	}
+
+	res := calculate(opts, time.UTC, shifts, pages)
+	assert.Equal(t, []UserWorkload{
+		{UserID: "b", OnCallHours: 36, WeekendHours: 36},
+		{UserID: "a", OnCallHours: 10, OffHoursHours: 2, PagesReceived: 3, NightsInterrupted: 1},
+		{UserID: "c", PagesReceived: 1, NightsInterrupted: 1},
+	}, res)
+
+	opts.UserIDs = []string{"a", "d"}
+	res = calculate(opts, time.UTC, shifts, pages)
+	assert.Equal(t, []UserWorkload{
+		{UserID: "a", OnCallHours: 10, OffHoursHours: 2, PagesReceived: 3, NightsInterrupted: 1},
+		{UserID: "d"},
+	}, res)
+}
+
+func TestUserWorkload_AddInterval(t *testing.T) {
+	loc, err := time.LoadLocation("America/Chicago")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Friday 4pm through Monday 10am local time
+	iv := interval{
+		Start: time.Date(2021, 8, 6, 16, 0, 0, 0, loc),
+		End:   time.Date(2021, 8, 9, 10, 0, 0, 0, loc),
+	}
+
+	var w UserWorkload
+	w.addInterval(iv, loc, DefaultBusinessStart, DefaultBusinessEnd)
+	assert.Equal(t, 66.0, w.OnCallHours)
+	assert.Equal(t, 48.0, w.WeekendHours)
+	// Friday 5pm-midnight + Monday midnight-9am
+	assert.Equal(t, 16.0, w.OffHoursHours)
+}
+
+func TestNightKey(t *testing.T) {
+	check := func(h int, expected string) {
+		t.Helper()
+		assert.Equal(t, expected, nightKey(time.Date(2021, 8, 3, h, 30, 0, 0, time.UTC), time.UTC))
+	}
+
+	check(1, "2021-08-02")
+	check(6, "2021-08-02")
+	check(7, "")
+	check(21, "")
+	check(22, "2021-08-03")
+}
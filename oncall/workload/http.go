@@ -0,0 +1,122 @@
+package workload
+
+import (
+	"encoding/csv"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/target/goalert/util/errutil"
+	"github.com/target/goalert/util/log"
+	"github.com/target/goalert/util/sqlutil"
+	"github.com/target/goalert/util/timeutil"
+	"github.com/target/goalert/validation"
+)
+
+func parseTime(fname, val string) (time.Time, error) {
+	t, err := time.Parse(time.RFC3339, val)
+	if err != nil {
+		return t, validation.NewFieldError(fname, "must be an RFC3339 timestamp")
+	}
+	return t, nil
+}
+
+func parseOptions(req *http.Request) (opts ReportOptions, err error) {
+	q := req.URL.Query()
+	opts.Start, err = parseTime("start", q.Get("start"))
+	if err != nil {
+		return opts, err
+	}
+	opts.End, err = parseTime("end", q.Get("end"))
+	if err != nil {
+		return opts, err
+	}
+	opts.ScheduleIDs = q["scheduleID"]
+	opts.UserIDs = q["userID"]
+	opts.TimeZone = q.Get("timeZone")
+	if v := q.Get("businessStart"); v != "" {
+		opts.BusinessStart, err = timeutil.ParseClock(v)
+		if err != nil {
+			return opts, validation.NewFieldError("businessStart", err.Error())
+		}
+	}
+	if v := q.Get("businessEnd"); v != "" {
+		opts.BusinessEnd, err = timeutil.ParseClock(v)
+		if err != nil {
+			return opts, validation.NewFieldError("businessEnd", err.Error())
+		}
+	}
+
+	return opts, nil
+}
+
+func formatHours(h float64) string { return strconv.FormatFloat(h, 'f', 2, 64) }
+
+// ServeCSV will export a workload report as CSV using the options provided in the request query parameters.
+func (s *Store) ServeCSV(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+
+	opts, err := parseOptions(req)
+	if errutil.HTTPError(ctx, w, err) {
+		return
+	}
+
+	report, err := s.Report(ctx, opts)
+	if errutil.HTTPError(ctx, w, err) {
+		return
+	}
+
+	ids := make([]string, len(report))
+	for i, r := range report {
+		ids[i] = r.UserID
+	}
+	names := make(map[string]string, len(ids))
+	rows, err := s.userNames.QueryContext(ctx, sqlutil.UUIDArray(ids))
+	if errutil.HTTPError(ctx, w, err) {
+		return
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var id, name string
+		err = rows.Scan(&id, &name)
+		if errutil.HTTPError(ctx, w, err) {
+			return
+		}
+		names[id] = name
+	}
+	err = rows.Err()
+	if errutil.HTTPError(ctx, w, err) {
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/csv")
+	w.Header().Set("Content-Disposition", `attachment; filename="oncall-workload.csv"`)
+
+	cw := csv.NewWriter(w)
+	cw.Write([]string{
+		"User ID",
+		"User Name",
+		"On-Call Hours",
+		"Off-Hours Hours",
+		"Weekend Hours",
+		"Pages Received",
+		"Nights Interrupted",
+	})
+	for _, r := range report {
+		cw.Write([]string{
+			r.UserID,
+			names[r.UserID],
+			formatHours(r.OnCallHours),
+			formatHours(r.OffHoursHours),
+			formatHours(r.WeekendHours),
+			strconv.Itoa(r.PagesReceived),
+			strconv.Itoa(r.NightsInterrupted),
+		})
+	}
+	cw.Flush()
+	if err = cw.Error(); err != nil {
+		// headers are already sent, so just log it
+		log.Log(ctx, errors.Wrap(err, "write workload CSV"))
+	}
+}
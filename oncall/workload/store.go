@@ -0,0 +1,123 @@
+package workload
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/pkg/errors"
+	"github.com/target/goalert/oncall"
+	"github.com/target/goalert/permission"
+	"github.com/target/goalert/util"
+	"github.com/target/goalert/util/sqlutil"
+)
+
+// Store generates on-call workload reports.
+type Store struct {
+	db *sql.DB
+
+	shifts    *sql.Stmt
+	pages     *sql.Stmt
+	userNames *sql.Stmt
+}
+
+// NewStore will create a new Store, preparing required statements using the provided context.
+func NewStore(ctx context.Context, db *sql.DB) (*Store, error) {
+	p := &util.Prepare{DB: db, Ctx: ctx}
+
+	return &Store{
+		db: db,
+
+		userNames: p.P(`select id, name from users where id = any($1)`),
+		shifts: p.P(`
+			select user_id, start_time, coalesce(end_time, now())
+			from schedule_on_call_users
+			where
+				start_time < $2 and
+				(end_time isnull or end_time > $1) and
+				(coalesce(cardinality($3::uuid[]), 0) = 0 or schedule_id = any($3))
+		`),
+		pages: p.P(`
+			select log.sub_user_id, log.timestamp
+			from alert_logs log
+			where
+				log.event = 'notification_sent' and
+				log.sub_type = 'user' and
+				log.sub_user_id notnull and
+				log.timestamp >= $1 and
+				log.timestamp < $2 and
+				(coalesce(cardinality($3::uuid[]), 0) = 0 or log.sub_user_id = any($3)) and
+				(
+					coalesce(cardinality($4::uuid[]), 0) = 0 or
+					exists (
+						select 1
+						from schedule_on_call_users oc
+						where
+							oc.schedule_id = any($4) and
+							oc.user_id = log.sub_user_id and
+							oc.start_time <= log.timestamp and
+							(oc.end_time isnull or oc.end_time > log.timestamp)
+					)
+				)
+		`),
+	}, p.Err
+}
+
+// Report will calculate on-call hours and page counts for each user within the requested time range.
+//
+// On-call hours are read from the recorded schedule on-call history (the same history
+// oncall.DB.HistoryBySchedule uses for past shifts) with a single query for all schedules.
+// HistoryBySchedule is not used, as it also projects future shifts from the current
+// schedule configuration, which must not count toward a workload report.
+//
+// When limited to schedules, a page is counted if the user was recorded on call for one of
+// the schedules when it was sent, so later policy or schedule changes do not affect past reports.
+func (s *Store) Report(ctx context.Context, opts ReportOptions) ([]UserWorkload, error) {
+	err := permission.LimitCheckAny(ctx, permission.User)
+	if err != nil {
+		return nil, err
+	}
+	norm, loc, err := opts.Normalize()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := s.shifts.QueryContext(ctx, norm.Start, norm.End, sqlutil.UUIDArray(norm.ScheduleIDs))
+	if err != nil {
+		return nil, errors.Wrap(err, "lookup on-call history")
+	}
+	defer rows.Close()
+
+	var shifts []oncall.Shift
+	for rows.Next() {
+		var shift oncall.Shift
+		err = rows.Scan(&shift.UserID, &shift.Start, &shift.End)
+		if err != nil {
+			return nil, errors.Wrap(err, "scan on-call history")
+		}
+		shifts = append(shifts, shift)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "read on-call history")
+	}
+
+	rows, err = s.pages.QueryContext(ctx, norm.Start, norm.End, sqlutil.UUIDArray(norm.UserIDs), sqlutil.UUIDArray(norm.ScheduleIDs))
+	if err != nil {
+		return nil, errors.Wrap(err, "lookup sent notifications")
+	}
+	defer rows.Close()
+
+	var pages []page
+	for rows.Next() {
+		var p page
+		err = rows.Scan(&p.UserID, &p.Time)
+		if err != nil {
+			return nil, errors.Wrap(err, "scan sent notification")
+		}
+		pages = append(pages, p)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "read sent notifications")
+	}
+
+	return calculate(*norm, loc, shifts, pages), nil
+}
@@ -0,0 +1,104 @@
+package workload
+
+import (
+	"time"
+
+	"github.com/target/goalert/util"
+	"github.com/target/goalert/util/timeutil"
+	"github.com/target/goalert/validation"
+	"github.com/target/goalert/validation/validate"
+)
+
+// MaxReportDuration is the longest time range a single report may cover.
+const MaxReportDuration = 366 * 24 * time.Hour
+
+// Night boundaries (local time) used to determine if a page interrupted someone's sleep.
+const (
+	NightStart = timeutil.Clock(22 * time.Hour)
+	NightEnd   = timeutil.Clock(7 * time.Hour)
+)
+
+// Default business hours used when none are specified.
+const (
+	DefaultBusinessStart = timeutil.Clock(9 * time.Hour)
+	DefaultBusinessEnd   = timeutil.Clock(17 * time.Hour)
+)
+
+// ReportOptions configure the scope of a workload report.
+type ReportOptions struct {
+	Start time.Time
+	End   time.Time
+
+	// ScheduleIDs will limit the report to the provided schedules. If empty, all schedules are included.
+	//
+	// Pages are limited to those sent while the user was on call for one of the schedules.
+	ScheduleIDs []string
+
+	// UserIDs will limit the report to the provided users. If empty, all users with activity are included.
+	UserIDs []string
+
+	// TimeZone is used to determine weekends, business hours, and nights. Defaults to UTC.
+	TimeZone string
+
+	// BusinessStart and BusinessEnd define weekday business hours in TimeZone.
+	// Any weekday on-call time outside of this window is counted as off-hours.
+	BusinessStart timeutil.Clock
+	BusinessEnd   timeutil.Clock
+}
+
+// UserWorkload contains aggregated on-call metrics for a single user over the report period.
+type UserWorkload struct {
+	UserID string
+
+	// OnCallHours is the total number of hours the user was on call for any of the schedules.
+	// Overlapping shifts from multiple schedules are only counted once.
+	OnCallHours float64
+
+	// OffHoursHours is the number of on-call hours on weekdays outside of business hours.
+	OffHoursHours float64
+
+	// WeekendHours is the number of on-call hours on Saturday or Sunday.
+	WeekendHours float64
+
+	// PagesReceived is the number of notifications sent to the user.
+	PagesReceived int
+
+	// NightsInterrupted is the number of distinct nights the user received at least one page.
+	NightsInterrupted int
+}
+
+// Normalize will validate and return a copy of the options with defaults applied,
+// as well as the location to use for calculations.
+func (opts ReportOptions) Normalize() (*ReportOptions, *time.Location, error) {
+	if opts.TimeZone == "" {
+		opts.TimeZone = "UTC"
+	}
+	if opts.BusinessStart == 0 && opts.BusinessEnd == 0 {
+		opts.BusinessStart = DefaultBusinessStart
+		opts.BusinessEnd = DefaultBusinessEnd
+	}
+
+	err := validate.Many(
+		validate.ManyUUID("ScheduleIDs", opts.ScheduleIDs, 100),
+		validate.ManyUUID("UserIDs", opts.UserIDs, 500),
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !opts.End.After(opts.Start) {
+		return nil, nil, validation.NewFieldError("End", "must be after Start")
+	}
+	if opts.End.Sub(opts.Start) > MaxReportDuration {
+		return nil, nil, validation.NewFieldError("End", "cannot be more than 366 days past Start")
+	}
+	if opts.BusinessEnd <= opts.BusinessStart {
+		return nil, nil, validation.NewFieldError("BusinessEnd", "must be after BusinessStart")
+	}
+
+	loc, err := util.LoadLocation(opts.TimeZone)
+	if err != nil {
+		return nil, nil, validation.NewFieldError("TimeZone", err.Error())
+	}
+
+	return &opts, loc, nil
+}
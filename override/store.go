@@ -38,6 +38,8 @@ type DB struct {
 	updateUO  *sql.Stmt
 
 	findUOUpdate *sql.Stmt
+
+	findTeamIDs *sql.Stmt
 }
 
 // NewDB initializes a new DB using an existing sql connection.
@@ -101,6 +103,15 @@ func NewDB(ctx context.Context, db *sql.DB) (*DB, error) {
 				tgt_schedule_id = $1 and
 				(start_time, end_time) OVERLAPS ($2, $3)
 		`),
+		findTeamIDs: p.P(`
+			select array(
+				select distinct coalesce(team_id::text, '')
+				from schedules
+				where
+					id = any($1) or
+					id in (select tgt_schedule_id from user_overrides where id = any($1))
+			)
+		`),
 	}, p.Err
 }
 func wrap(stmt *sql.Stmt, tx *sql.Tx) *sql.Stmt {
@@ -163,6 +174,10 @@ func (db *DB) UpdateUserOverrideTx(ctx context.Context, tx *sql.Tx, o *UserOverr
 	if !n.End.After(time.Now()) {
 		return validation.NewFieldError("End", "must be in the future")
 	}
+	err = db.checkOwner(ctx, tx, n.ID, n.Target.TargetID())
+	if err != nil {
+		return err
+	}
 	var add, rem sql.NullString
 	if n.AddUserID != "" {
 		add.Valid = true
@@ -203,6 +218,10 @@ func (db *DB) CreateUserOverrideTx(ctx context.Context, tx *sql.Tx, o *UserOverr
 	if !n.End.After(time.Now()) {
 		return nil, validation.NewFieldError("End", "must be in the future")
 	}
+	err = db.checkOwner(ctx, tx, n.Target.TargetID())
+	if err != nil {
+		return nil, err
+	}
 	n.ID = uuid.New().String()
 	var add, rem sql.NullString
 	if n.AddUserID != "" {
@@ -239,6 +258,10 @@ func (db *DB) DeleteUserOverrideTx(ctx context.Context, tx *sql.Tx, ids ...strin
 	if err != nil {
 		return err
 	}
+	err = db.checkOwner(ctx, tx, ids...)
+	if err != nil {
+		return err
+	}
 
 	_, err = wrap(db.deleteUO, tx).ExecContext(ctx, sqlutil.UUIDArray(ids))
 	return err
@@ -287,3 +310,15 @@ func (db *DB) FindAllUserOverrides(ctx context.Context, start, end time.Time, t
 
 	return result, nil
 }
+
+// checkOwner will return an error if the context may not modify the given schedules or the
+// schedules of the given overrides.
+func (db *DB) checkOwner(ctx context.Context, tx *sql.Tx, ids ...string) error {
+	var teamIDs sqlutil.StringArray
+	err := wrap(db.findTeamIDs, tx).QueryRowContext(ctx, sqlutil.UUIDArray(ids)).Scan(&teamIDs)
+	if err != nil {
+		return err
+	}
+
+	return permission.LimitCheckOwner(ctx, teamIDs...)
+}
@@ -153,6 +153,47 @@ func MatchTeam(teamID string) Checker {
 	}
 }
 
+// TeamMember will return a Checker that ensures the context's user is a member of the given team.
+func TeamMember(teamID string) Checker {
+	teamID = strings.ToLower(teamID)
+	return func(ctx context.Context) bool {
+		if teamID == "" || !User(ctx) {
+			return false
+		}
+		for _, id := range UserTeamIDs(ctx) {
+			if id == teamID {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// Owner will return a Checker that ensures the context may modify a resource owned by
+// the given team.
+//
+// Resources without an owning team (empty teamID) are shared, and may be modified by any
+// user, but not by service or team contexts.
+func Owner(teamID string) Checker {
+	if teamID == "" {
+		// unowned resources are shared by all users
+		return User
+	}
+	return TeamMember(teamID)
+}
+
+// LimitCheckOwner will return an error if the context may not modify resources owned by
+// each of the given teams. System and Admin contexts may modify any resource.
+func LimitCheckOwner(ctx context.Context, teamIDs ...string) error {
+	for _, id := range teamIDs {
+		err := LimitCheckAny(ctx, System, Admin, Owner(id))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // MatchUser will return a Checker that ensures the context has the given UserID.
 func MatchUser(userID string) Checker {
 	return func(ctx context.Context) bool {
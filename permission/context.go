@@ -154,6 +154,7 @@ func WithoutAuth(ctx context.Context) context.Context {
 	if id, ok := ctx.Value(contextKeyUserID).(string); ok && id != "" {
 		ctx = context.WithValue(ctx, contextKeyUserID, nil)
 		ctx = context.WithValue(ctx, contextKeyUserRole, nil)
+		ctx = context.WithValue(ctx, contextKeyUserTeams, nil)
 	}
 	if Service(ctx) {
 		ctx = context.WithValue(ctx, contextKeyServiceID, nil)
@@ -196,6 +197,21 @@ func UserID(ctx context.Context) string {
 	return uid
 }
 
+// UserTeamsContext will return a context with the team memberships of the authenticated user.
+func UserTeamsContext(ctx context.Context, teamIDs []string) context.Context {
+	ids := make([]string, len(teamIDs))
+	for i, id := range teamIDs {
+		ids[i] = strings.ToLower(id)
+	}
+	return context.WithValue(ctx, contextKeyUserTeams, ids)
+}
+
+// UserTeamIDs will return the IDs of teams the authenticated user is a member of.
+func UserTeamIDs(ctx context.Context) []string {
+	ids, _ := ctx.Value(contextKeyUserTeams).([]string)
+	return ids
+}
+
 // SystemComponentName will return the component name used to initiate a context.
 func SystemComponentName(ctx context.Context) string {
 	name, _ := ctx.Value(contextKeySystem).(string)
@@ -2,6 +2,7 @@ package permission
 
 import (
 	"context"
+	"sync"
 	"testing"
 )
 
@@ -74,3 +75,45 @@ func TestWithoutAuth(t *testing.T) {
 		check(d.ctx, d.name)
 	}
 }
+
+func TestOwner(t *testing.T) {
+	const teamID = "ec9ea2d1-5ae6-4b50-aa43-7f2b0a52c2dc"
+	ctx := UserContext(context.Background(), "2e2a4b16-2eab-4d0c-a93b-2d9b8c33b2a5", RoleUser)
+	if !Owner("")(ctx) {
+		t.Error("Owner(\"\") = false; want true")
+	}
+	if err := LimitCheckOwner(ctx, ""); err != nil {
+		t.Errorf("LimitCheckOwner(\"\") = %v; want nil for user", err)
+	}
+	if Owner("")(ServiceContext(context.Background(), "test")) {
+		t.Error("Owner(\"\") = true; want false for service")
+	}
+	if err := LimitCheckOwner(ServiceContext(context.Background(), "test"), ""); err == nil {
+		t.Error("LimitCheckOwner(\"\") = nil; want error for service")
+	}
+	if Owner(teamID)(ctx) {
+		t.Error("Owner(teamID) = true; want false for non-member")
+	}
+
+	ctx = UserTeamsContext(ctx, []string{"EC9EA2D1-5AE6-4B50-AA43-7F2B0A52C2DC"})
+	if !Owner(teamID)(ctx) {
+		t.Error("Owner(teamID) = false; want true for member")
+	}
+	if Owner(teamID)(WithoutAuth(ctx)) {
+		t.Error("Owner(teamID) = true; want false without auth")
+	}
+
+	// checkers may be shared between goroutines
+	check := TeamMember("EC9EA2D1-5AE6-4B50-AA43-7F2B0A52C2DC")
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if !check(ctx) {
+				t.Error("TeamMember(teamID) = false; want true for member")
+			}
+		}()
+	}
+	wg.Wait()
+}
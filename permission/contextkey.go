@@ -12,4 +12,5 @@ const (
 	contextKeyTeamID
 	contextKeyCheckCountMax
 	contextKeySourceInfo
+	contextKeyUserTeams
 )
@@ -26,6 +26,9 @@ const (
 
 	// SourceTypeCalendarSubscription is set when a context is authorized for use of a calendar subscription.
 	SourceTypeCalendarSubscription
+
+	// SourceTypeAPIKey is set when a context is authorized for use of an API key.
+	SourceTypeAPIKey
 )
 
 // SourceInfo provides information about the source of a context's authorization.
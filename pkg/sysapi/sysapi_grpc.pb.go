@@ -26,6 +26,9 @@ type SysAPIClient interface {
 	DeleteUser(ctx context.Context, in *DeleteUserRequest, opts ...grpc.CallOption) (*DeleteUserResponse, error)
 	UsersWithoutAuthProvider(ctx context.Context, in *UsersWithoutAuthProviderRequest, opts ...grpc.CallOption) (SysAPI_UsersWithoutAuthProviderClient, error)
 	SetAuthSubject(ctx context.Context, in *SetAuthSubjectRequest, opts ...grpc.CallOption) (*SetAuthSubjectResponse, error)
+	CreateAPIKey(ctx context.Context, in *CreateAPIKeyRequest, opts ...grpc.CallOption) (*CreateAPIKeyResponse, error)
+	APIKeys(ctx context.Context, in *APIKeysRequest, opts ...grpc.CallOption) (SysAPI_APIKeysClient, error)
+	DeleteAPIKey(ctx context.Context, in *DeleteAPIKeyRequest, opts ...grpc.CallOption) (*DeleteAPIKeyResponse, error)
 }
 
 type sysAPIClient struct {
@@ -118,6 +121,56 @@ func (c *sysAPIClient) SetAuthSubject(ctx context.Context, in *SetAuthSubjectReq
 	return out, nil
 }
 
+func (c *sysAPIClient) CreateAPIKey(ctx context.Context, in *CreateAPIKeyRequest, opts ...grpc.CallOption) (*CreateAPIKeyResponse, error) {
+	out := new(CreateAPIKeyResponse)
+	err := c.cc.Invoke(ctx, "/goalert.v1.SysAPI/CreateAPIKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sysAPIClient) APIKeys(ctx context.Context, in *APIKeysRequest, opts ...grpc.CallOption) (SysAPI_APIKeysClient, error) {
+	stream, err := c.cc.NewStream(ctx, &SysAPI_ServiceDesc.Streams[2], "/goalert.v1.SysAPI/APIKeys", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &sysAPIAPIKeysClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type SysAPI_APIKeysClient interface {
+	Recv() (*APIKey, error)
+	grpc.ClientStream
+}
+
+type sysAPIAPIKeysClient struct {
+	grpc.ClientStream
+}
+
+func (x *sysAPIAPIKeysClient) Recv() (*APIKey, error) {
+	m := new(APIKey)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *sysAPIClient) DeleteAPIKey(ctx context.Context, in *DeleteAPIKeyRequest, opts ...grpc.CallOption) (*DeleteAPIKeyResponse, error) {
+	out := new(DeleteAPIKeyResponse)
+	err := c.cc.Invoke(ctx, "/goalert.v1.SysAPI/DeleteAPIKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SysAPIServer is the server API for SysAPI service.
 // All implementations must embed UnimplementedSysAPIServer
 // for forward compatibility
@@ -126,6 +179,9 @@ type SysAPIServer interface {
 	DeleteUser(context.Context, *DeleteUserRequest) (*DeleteUserResponse, error)
 	UsersWithoutAuthProvider(*UsersWithoutAuthProviderRequest, SysAPI_UsersWithoutAuthProviderServer) error
 	SetAuthSubject(context.Context, *SetAuthSubjectRequest) (*SetAuthSubjectResponse, error)
+	CreateAPIKey(context.Context, *CreateAPIKeyRequest) (*CreateAPIKeyResponse, error)
+	APIKeys(*APIKeysRequest, SysAPI_APIKeysServer) error
+	DeleteAPIKey(context.Context, *DeleteAPIKeyRequest) (*DeleteAPIKeyResponse, error)
 	mustEmbedUnimplementedSysAPIServer()
 }
 
@@ -145,6 +201,15 @@ func (UnimplementedSysAPIServer) UsersWithoutAuthProvider(*UsersWithoutAuthProvi
 func (UnimplementedSysAPIServer) SetAuthSubject(context.Context, *SetAuthSubjectRequest) (*SetAuthSubjectResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetAuthSubject not implemented")
 }
+func (UnimplementedSysAPIServer) CreateAPIKey(context.Context, *CreateAPIKeyRequest) (*CreateAPIKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAPIKey not implemented")
+}
+func (UnimplementedSysAPIServer) APIKeys(*APIKeysRequest, SysAPI_APIKeysServer) error {
+	return status.Errorf(codes.Unimplemented, "method APIKeys not implemented")
+}
+func (UnimplementedSysAPIServer) DeleteAPIKey(context.Context, *DeleteAPIKeyRequest) (*DeleteAPIKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAPIKey not implemented")
+}
 func (UnimplementedSysAPIServer) mustEmbedUnimplementedSysAPIServer() {}
 
 // UnsafeSysAPIServer may be embedded to opt out of forward compatibility for this service.
@@ -236,6 +301,63 @@ func _SysAPI_SetAuthSubject_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SysAPI_CreateAPIKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAPIKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysAPIServer).CreateAPIKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/goalert.v1.SysAPI/CreateAPIKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysAPIServer).CreateAPIKey(ctx, req.(*CreateAPIKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SysAPI_APIKeys_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(APIKeysRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SysAPIServer).APIKeys(m, &sysAPIAPIKeysServer{stream})
+}
+
+type SysAPI_APIKeysServer interface {
+	Send(*APIKey) error
+	grpc.ServerStream
+}
+
+type sysAPIAPIKeysServer struct {
+	grpc.ServerStream
+}
+
+func (x *sysAPIAPIKeysServer) Send(m *APIKey) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _SysAPI_DeleteAPIKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAPIKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysAPIServer).DeleteAPIKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/goalert.v1.SysAPI/DeleteAPIKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysAPIServer).DeleteAPIKey(ctx, req.(*DeleteAPIKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // SysAPI_ServiceDesc is the grpc.ServiceDesc for SysAPI service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -251,6 +373,14 @@ var SysAPI_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetAuthSubject",
 			Handler:    _SysAPI_SetAuthSubject_Handler,
 		},
+		{
+			MethodName: "CreateAPIKey",
+			Handler:    _SysAPI_CreateAPIKey_Handler,
+		},
+		{
+			MethodName: "DeleteAPIKey",
+			Handler:    _SysAPI_DeleteAPIKey_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -263,6 +393,11 @@ var SysAPI_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _SysAPI_UsersWithoutAuthProvider_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "APIKeys",
+			Handler:       _SysAPI_APIKeys_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "pkg/sysapi/sysapi.proto",
 }
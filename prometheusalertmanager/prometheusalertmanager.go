@@ -88,10 +88,8 @@ type postBody struct {
 
 	Alerts []postBodyAlert
 
-	CommonLabels struct {
-		Instance  string
-		AlertName string `json:"alertname"`
-	}
+	// CommonLabels are kept as-is (i.e., keys are case-sensitive) for routing rules.
+	CommonLabels map[string]string
 
 	CommonAnnotations struct {
 		Summary string
@@ -131,18 +129,34 @@ func (a postBodyAlert) Details() string {
 
 	return a.Summary() + a.gen()
 }
+
+// commonLabel will return the value of the named common label, ignoring case.
+func (b postBody) commonLabel(name string) string {
+	if v, ok := b.CommonLabels[name]; ok {
+		return v
+	}
+	for key, v := range b.CommonLabels {
+		if strings.EqualFold(key, name) {
+			return v
+		}
+	}
+
+	return ""
+}
+
 func (b postBody) Summary() string {
 	if b.CommonAnnotations.Summary != "" {
 		return b.CommonAnnotations.Summary
 	}
-	if b.CommonLabels.AlertName == "" {
+	alertName := b.commonLabel("alertname")
+	if alertName == "" {
 		// different alerts
 		return b.Alerts[0].Summary() + fmt.Sprintf(" and %d others", len(b.Alerts)-1)
 	}
 
 	// we have a common alert name
-	if b.CommonLabels.Instance != "" {
-		return b.CommonLabels.AlertName + " " + b.CommonLabels.Instance
+	if instance := b.commonLabel("instance"); instance != "" {
+		return alertName + " " + instance
 	}
 
 	var instances []string
@@ -150,7 +164,7 @@ func (b postBody) Summary() string {
 		instances = append(instances, a.Labels.Instance)
 	}
 
-	return b.CommonLabels.AlertName + " " + strings.Join(instances, ",")
+	return alertName + " " + strings.Join(instances, ",")
 }
 
 func (b postBody) Details(payload string) string {
@@ -230,6 +244,20 @@ func PrometheusAlertmanagerEventsAPI(aDB alert.Store, intDB integrationkey.Store
 			Dedup:     alert.NewUserDedup(summary),
 		}
 
+		ctx, route, err := intDB.RouteAlert(ctx, integrationkey.RouteInput{
+			Summary: msg.Summary,
+			Details: msg.Details,
+			Meta:    body.CommonLabels,
+		})
+		if errutil.HTTPError(ctx, w, errors.Wrap(err, "route alert")) {
+			return
+		}
+		if route.Drop() {
+			log.Logf(ctx, "alert dropped by routing rule")
+			return
+		}
+		msg.ServiceID = route.ServiceID
+
 		err = retry.DoTemporaryError(func(int) error {
 			_, err = aDB.CreateOrUpdate(ctx, msg)
 			return err
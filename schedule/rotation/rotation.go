@@ -1,6 +1,7 @@
 package rotation
 
 import (
+	"database/sql"
 	"time"
 
 	"github.com/target/goalert/util/timeutil"
@@ -16,6 +17,7 @@ type Rotation struct {
 	Type           Type      `json:"type"`
 	Start          time.Time `json:"start"`
 	ShiftLength    int       `json:"shift_length"`
+	TeamID         string    `json:"team_id,omitempty"`
 	isUserFavorite bool
 }
 
@@ -91,9 +93,16 @@ func (r Rotation) Normalize() (*Rotation, error) {
 		validate.OneOf("Type", r.Type, TypeWeekly, TypeDaily, TypeHourly),
 		validate.Text("Description", r.Description, 1, 255),
 	)
+	if r.TeamID != "" {
+		err = validate.Many(err, validate.UUID("TeamID", r.TeamID))
+	}
 	if err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
+
+func (r Rotation) teamID() sql.NullString {
+	return sql.NullString{String: r.TeamID, Valid: r.TeamID != ""}
+}
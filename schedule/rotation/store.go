@@ -104,6 +104,8 @@ type DB struct {
 	rmState   *sql.Stmt
 	partRotID *sql.Stmt
 
+	findTeamIDs *sql.Stmt
+
 	deleteParticipants      *sql.Stmt
 	updateParticipantUserID *sql.Stmt
 	setActiveIndex          *sql.Stmt
@@ -117,16 +119,16 @@ func NewDB(ctx context.Context, db *sql.DB) (*DB, error) {
 	return &DB{
 		db: db,
 
-		createRotation: p.P(`INSERT INTO rotations (id, name, description, type, start_time, shift_length, time_zone) VALUES ($1, $2, $3, $4, $5, $6, $7)`),
+		createRotation: p.P(`INSERT INTO rotations (id, name, description, type, start_time, shift_length, time_zone, team_id) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`),
 		updateRotation: p.P(`
 			WITH set_shift_start AS (
 				UPDATE rotation_state
 				SET shift_start = now()
 				WHERE rotation_id = $1
 			)
-			UPDATE rotations SET name = $2, description = $3, type = $4, start_time = $5, shift_length = $6, time_zone = $7 WHERE id = $1
+			UPDATE rotations SET name = $2, description = $3, type = $4, start_time = $5, shift_length = $6, time_zone = $7, team_id = $8 WHERE id = $1
 		`),
-		findAllRotations: p.P(`SELECT id, name, description, type, start_time, shift_length, time_zone FROM rotations`),
+		findAllRotations: p.P(`SELECT id, name, description, type, start_time, shift_length, time_zone, coalesce(team_id::text, '') FROM rotations`),
 		findRotation: p.P(`
 			SELECT 
 				r.id, 
@@ -136,13 +138,14 @@ func NewDB(ctx context.Context, db *sql.DB) (*DB, error) {
 				r.start_time, 
 				r.shift_length, 
 				r.time_zone, 
+				coalesce(r.team_id::text, ''),
 				fav IS DISTINCT FROM NULL 
 			FROM rotations r 
 			LEFT JOIN user_favorites fav ON fav.tgt_rotation_id = r.id 
 			AND fav.user_id = $2 
 			WHERE r.id = $1
 		`),
-		findRotationForUpdate: p.P(`SELECT id, name, description, type, start_time, shift_length, time_zone FROM rotations WHERE id = $1 FOR UPDATE`),
+		findRotationForUpdate: p.P(`SELECT id, name, description, type, start_time, shift_length, time_zone, coalesce(team_id::text, '') FROM rotations WHERE id = $1 FOR UPDATE`),
 		deleteRotation:        p.P(`DELETE FROM rotations WHERE id = ANY($1)`),
 
 		findMany: p.P(`
@@ -154,6 +157,7 @@ func NewDB(ctx context.Context, db *sql.DB) (*DB, error) {
 				r.start_time, 
 				r.shift_length, 
 				r.time_zone,
+				coalesce(r.team_id::text, ''),
 				fav IS DISTINCT FROM NULL 
 			FROM rotations r 
 			LEFT JOIN user_favorites fav ON fav.tgt_rotation_id = r.id 
@@ -162,9 +166,18 @@ func NewDB(ctx context.Context, db *sql.DB) (*DB, error) {
 		`),
 
 		partRotID: p.P(`SELECT rotation_id FROM rotation_participants WHERE id = $1`),
+		findTeamIDs: p.P(`
+			SELECT array(
+				SELECT DISTINCT coalesce(team_id::text, '')
+				FROM rotations
+				WHERE
+					id = any($1) OR
+					id IN (SELECT rotation_id FROM rotation_participants WHERE id = any($1))
+			)
+		`),
 
 		findAllBySched: p.P(`
-			SELECT id, name, description, type, start_time, shift_length, time_zone
+			SELECT id, name, description, type, start_time, shift_length, time_zone, coalesce(team_id::text, '')
 			FROM rotations
 			WHERE id IN (
 				SELECT DISTINCT tgt_rotation_id
@@ -294,7 +307,7 @@ func (db *DB) FindAllRotationsByScheduleID(ctx context.Context, schedID string)
 	var rot Rotation
 	var tz string
 	for rows.Next() {
-		err = rows.Scan(&rot.ID, &rot.Name, &rot.Description, &rot.Type, &rot.Start, &rot.ShiftLength, &tz)
+		err = rows.Scan(&rot.ID, &rot.Name, &rot.Description, &rot.Type, &rot.Start, &rot.ShiftLength, &tz, &rot.TeamID)
 		if err != nil {
 			return nil, err
 		}
@@ -409,6 +422,10 @@ func (db *DB) CreateRotationTx(ctx context.Context, tx *sql.Tx, r *Rotation) (*R
 	if err != nil {
 		return nil, err
 	}
+	err = permission.LimitCheckOwner(ctx, n.TeamID)
+	if err != nil {
+		return nil, err
+	}
 
 	stmt := db.createRotation
 	if tx != nil {
@@ -417,7 +434,7 @@ func (db *DB) CreateRotationTx(ctx context.Context, tx *sql.Tx, r *Rotation) (*R
 
 	n.ID = uuid.New().String()
 
-	_, err = stmt.ExecContext(ctx, n.ID, n.Name, n.Description, n.Type, n.Start, n.ShiftLength, n.Start.Location().String())
+	_, err = stmt.ExecContext(ctx, n.ID, n.Name, n.Description, n.Type, n.Start, n.ShiftLength, n.Start.Location().String(), n.teamID())
 	if err != nil {
 		return nil, err
 	}
@@ -442,13 +459,17 @@ func (db *DB) UpdateRotationTx(ctx context.Context, tx *sql.Tx, r *Rotation) err
 	if err != nil {
 		return err
 	}
+	err = db.checkOwner(ctx, tx, []string{n.ID}, n.TeamID)
+	if err != nil {
+		return err
+	}
 
 	s := db.updateRotation
 	if tx != nil {
 		s = tx.StmtContext(ctx, s)
 	}
 
-	_, err = s.ExecContext(ctx, n.ID, n.Name, n.Description, n.Type, n.Start, n.ShiftLength, n.Start.Location().String())
+	_, err = s.ExecContext(ctx, n.ID, n.Name, n.Description, n.Type, n.Start, n.ShiftLength, n.Start.Location().String(), n.teamID())
 	return err
 }
 func (db *DB) FindAllRotations(ctx context.Context) ([]Rotation, error) {
@@ -467,7 +488,7 @@ func (db *DB) FindAllRotations(ctx context.Context) ([]Rotation, error) {
 	var res []Rotation
 	var tz string
 	for rows.Next() {
-		err = rows.Scan(&r.ID, &r.Name, &r.Description, &r.Type, &r.Start, &r.ShiftLength, &tz)
+		err = rows.Scan(&r.ID, &r.Name, &r.Description, &r.Type, &r.Start, &r.ShiftLength, &tz, &r.TeamID)
 		if err != nil {
 			return nil, err
 		}
@@ -505,7 +526,7 @@ func (db *DB) FindMany(ctx context.Context, ids []string) ([]Rotation, error) {
 	var tz string
 	result := make([]Rotation, 0, len(ids))
 	for rows.Next() {
-		err = rows.Scan(&r.ID, &r.Name, &r.Description, &r.Type, &r.Start, &r.ShiftLength, &tz, &r.isUserFavorite)
+		err = rows.Scan(&r.ID, &r.Name, &r.Description, &r.Type, &r.Start, &r.ShiftLength, &tz, &r.TeamID, &r.isUserFavorite)
 		if err != nil {
 			return nil, err
 		}
@@ -534,7 +555,7 @@ func (db *DB) FindRotation(ctx context.Context, id string) (*Rotation, error) {
 	row := db.findRotation.QueryRowContext(ctx, id, userID)
 	var r Rotation
 	var tz string
-	err = row.Scan(&r.ID, &r.Name, &r.Description, &r.Type, &r.Start, &r.ShiftLength, &tz, &r.isUserFavorite)
+	err = row.Scan(&r.ID, &r.Name, &r.Description, &r.Type, &r.Start, &r.ShiftLength, &tz, &r.TeamID, &r.isUserFavorite)
 	if err != nil {
 		return nil, err
 	}
@@ -586,7 +607,7 @@ func (db *DB) FindRotationForUpdateTx(ctx context.Context, tx *sql.Tx, rotationI
 	row := s.QueryRowContext(ctx, rotationID)
 	var r Rotation
 	var tz string
-	err = row.Scan(&r.ID, &r.Name, &r.Description, &r.Type, &r.Start, &r.ShiftLength, &tz)
+	err = row.Scan(&r.ID, &r.Name, &r.Description, &r.Type, &r.Start, &r.ShiftLength, &tz, &r.TeamID)
 	if err != nil {
 		return nil, err
 	}
@@ -614,6 +635,10 @@ func (db *DB) DeleteManyTx(ctx context.Context, tx *sql.Tx, ids []string) error
 	if err != nil {
 		return err
 	}
+	err = db.checkOwner(ctx, tx, ids)
+	if err != nil {
+		return err
+	}
 	s := db.deleteRotation
 	if tx != nil {
 		s = tx.StmtContext(ctx, s)
@@ -716,6 +741,10 @@ func (db *DB) AddParticipantTx(ctx context.Context, tx *sql.Tx, p *Participant)
 	if err != nil {
 		return nil, err
 	}
+	err = db.checkOwner(ctx, tx, []string{n.RotationID})
+	if err != nil {
+		return nil, err
+	}
 
 	stmt := db.addParticipant
 	if tx != nil {
@@ -745,6 +774,10 @@ func (db *DB) RemoveParticipantTx(ctx context.Context, tx *sql.Tx, id string) (s
 	if err != nil {
 		return "", err
 	}
+	err = db.checkOwner(ctx, tx, []string{id})
+	if err != nil {
+		return "", err
+	}
 
 	s := db.deleteParticipant
 	if tx != nil {
@@ -776,6 +809,10 @@ func (db *DB) MoveParticipant(ctx context.Context, id string, newPos int) error
 	if err != nil {
 		return err
 	}
+	err = db.checkOwner(ctx, nil, []string{id})
+	if err != nil {
+		return err
+	}
 
 	var rotID string
 	err = db.moveParticipant.QueryRowContext(ctx, id, newPos).Scan(&rotID)
@@ -795,6 +832,10 @@ func (db *DB) SetActiveParticipant(ctx context.Context, rotID string, partID str
 	if err != nil {
 		return err
 	}
+	err = db.checkOwner(ctx, nil, []string{rotID})
+	if err != nil {
+		return err
+	}
 
 	_, err = db.setActiveParticipant.ExecContext(ctx, rotID, partID)
 	return err
@@ -812,6 +853,10 @@ func (db *DB) SetActiveIndexTx(ctx context.Context, tx *sql.Tx, rotID string, po
 	if err != nil {
 		return err
 	}
+	err = db.checkOwner(ctx, tx, []string{rotID})
+	if err != nil {
+		return err
+	}
 
 	stmt := db.setActiveIndex
 	if tx != nil {
@@ -857,7 +902,14 @@ func (db *DB) AddRotationUsersTx(ctx context.Context, tx *sql.Tx, rotationID str
 		return err
 	}
 
-	err = validate.ManyUUID("UserIDs", userIDs, 50)
+	err = validate.Many(
+		validate.UUID("RotationID", rotationID),
+		validate.ManyUUID("UserIDs", userIDs, 50),
+	)
+	if err != nil {
+		return err
+	}
+	err = db.checkOwner(ctx, tx, []string{rotationID})
 	if err != nil {
 		return err
 	}
@@ -886,6 +938,10 @@ func (db *DB) DeleteRotationParticipantsTx(ctx context.Context, tx *sql.Tx, part
 	if err != nil {
 		return err
 	}
+	err = db.checkOwner(ctx, tx, partIDs)
+	if err != nil {
+		return err
+	}
 
 	stmt := db.deleteParticipants
 	if tx != nil {
@@ -909,6 +965,10 @@ func (db *DB) UpdateParticipantUserIDTx(ctx context.Context, tx *sql.Tx, partID,
 	if err != nil {
 		return err
 	}
+	err = db.checkOwner(ctx, tx, []string{partID})
+	if err != nil {
+		return err
+	}
 
 	stmt := db.updateParticipantUserID
 	if tx != nil {
@@ -928,6 +988,10 @@ func (db *DB) DeleteStateTx(ctx context.Context, tx *sql.Tx, rotationID string)
 	if err != nil {
 		return err
 	}
+	err = db.checkOwner(ctx, tx, []string{rotationID})
+	if err != nil {
+		return err
+	}
 
 	stmt := db.rmState
 	if tx != nil {
@@ -937,3 +1001,20 @@ func (db *DB) DeleteStateTx(ctx context.Context, tx *sql.Tx, rotationID string)
 	_, err = stmt.ExecContext(ctx, rotationID)
 	return err
 }
+
+// checkOwner will return an error if the context may not modify the given rotations or
+// participants, or rotations owned by any of the extra team IDs.
+func (db *DB) checkOwner(ctx context.Context, tx *sql.Tx, ids []string, extraTeamIDs ...string) error {
+	stmt := db.findTeamIDs
+	if tx != nil {
+		stmt = tx.StmtContext(ctx, stmt)
+	}
+
+	var teamIDs sqlutil.StringArray
+	err := stmt.QueryRowContext(ctx, sqlutil.UUIDArray(ids)).Scan(&teamIDs)
+	if err != nil {
+		return err
+	}
+
+	return permission.LimitCheckOwner(ctx, append(teamIDs, extraTeamIDs...)...)
+}
@@ -53,6 +53,7 @@ type DB struct {
 	findAllUsers *sql.Stmt
 
 	findScheduleID *sql.Stmt
+	findTeamIDs    *sql.Stmt
 }
 
 func NewDB(ctx context.Context, db *sql.DB) (*DB, error) {
@@ -65,6 +66,15 @@ func NewDB(ctx context.Context, db *sql.DB) (*DB, error) {
 			from schedule_rules
 			where id = $1
 		`),
+		findTeamIDs: p.P(`
+			select array(
+				select distinct coalesce(team_id::text, '')
+				from schedules
+				where
+					id = any($1) or
+					id in (select schedule_id from schedule_rules where id = any($1))
+			)
+		`),
 		add: p.P(`
 			insert into schedule_rules (
 				id,
@@ -224,7 +234,24 @@ func (db *DB) FindScheduleID(ctx context.Context, ruleID string) (string, error)
 	return schedID, nil
 }
 
-func (db *DB) _Add(ctx context.Context, s *sql.Stmt, r *Rule) (*Rule, error) {
+// checkOwner will return an error if the context may not modify the given schedules or the
+// schedules of the given rules.
+func (db *DB) checkOwner(ctx context.Context, tx *sql.Tx, ids ...string) error {
+	stmt := db.findTeamIDs
+	if tx != nil {
+		stmt = tx.StmtContext(ctx, stmt)
+	}
+
+	var teamIDs sqlutil.StringArray
+	err := stmt.QueryRowContext(ctx, sqlutil.UUIDArray(ids)).Scan(&teamIDs)
+	if err != nil {
+		return err
+	}
+
+	return permission.LimitCheckOwner(ctx, teamIDs...)
+}
+
+func (db *DB) _Add(ctx context.Context, tx *sql.Tx, r *Rule) (*Rule, error) {
 	n, err := r.Normalize()
 	if err != nil {
 		return nil, err
@@ -234,6 +261,15 @@ func (db *DB) _Add(ctx context.Context, s *sql.Stmt, r *Rule) (*Rule, error) {
 	if err != nil {
 		return nil, err
 	}
+	err = db.checkOwner(ctx, tx, n.ScheduleID)
+	if err != nil {
+		return nil, err
+	}
+
+	s := db.add
+	if tx != nil {
+		s = tx.Stmt(s)
+	}
 
 	n.ID = uuid.New().String()
 	_, err = s.ExecContext(ctx, n.readFields()...)
@@ -245,7 +281,7 @@ func (db *DB) _Add(ctx context.Context, s *sql.Stmt, r *Rule) (*Rule, error) {
 }
 
 func (db *DB) Add(ctx context.Context, r *Rule) (*Rule, error) {
-	r, err := db._Add(ctx, db.add, r)
+	r, err := db._Add(ctx, nil, r)
 	if err != nil {
 		return nil, err
 	}
@@ -253,10 +289,7 @@ func (db *DB) Add(ctx context.Context, r *Rule) (*Rule, error) {
 }
 
 func (db *DB) CreateRuleTx(ctx context.Context, tx *sql.Tx, r *Rule) (*Rule, error) {
-	if tx == nil {
-		return db._Add(ctx, db.add, r)
-	}
-	return db._Add(ctx, tx.Stmt(db.add), r)
+	return db._Add(ctx, tx, r)
 }
 
 func (db *DB) FindByTargetTx(ctx context.Context, tx *sql.Tx, scheduleID string, target assignment.Target) ([]Rule, error) {
@@ -325,6 +358,10 @@ func (db *DB) DeleteByTarget(ctx context.Context, scheduleID string, target assi
 	if err != nil {
 		return err
 	}
+	err = db.checkOwner(ctx, nil, scheduleID)
+	if err != nil {
+		return err
+	}
 
 	var tgtUser, tgtRot sql.NullString
 
@@ -361,6 +398,10 @@ func (db *DB) DeleteManyTx(ctx context.Context, tx *sql.Tx, ruleIDs []string) er
 	if err != nil {
 		return err
 	}
+	err = db.checkOwner(ctx, tx, ruleIDs...)
+	if err != nil {
+		return err
+	}
 	s := db.delete
 	if tx != nil {
 		s = tx.StmtContext(ctx, s)
@@ -380,6 +421,10 @@ func (db *DB) UpdateTx(ctx context.Context, tx *sql.Tx, r *Rule) error {
 	if err != nil {
 		return err
 	}
+	err = db.checkOwner(ctx, tx, n.ID, n.ScheduleID)
+	if err != nil {
+		return err
+	}
 
 	f := n.readFields()
 
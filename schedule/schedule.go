@@ -1,6 +1,7 @@
 package schedule
 
 import (
+	"database/sql"
 	"github.com/target/goalert/validation"
 	"github.com/target/goalert/validation/validate"
 	"time"
@@ -11,6 +12,7 @@ type Schedule struct {
 	Name           string         `json:"name"`
 	Description    string         `json:"description"`
 	TimeZone       *time.Location `json:"time_zone"`
+	TeamID         string         `json:"team_id,omitempty"`
 	isUserFavorite bool
 }
 
@@ -19,6 +21,9 @@ func (s Schedule) Normalize() (*Schedule, error) {
 		validate.IDName("Name", s.Name),
 		validate.Text("Description", s.Description, 1, 255),
 	)
+	if s.TeamID != "" {
+		err = validate.Many(err, validate.UUID("TeamID", s.TeamID))
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -34,3 +39,7 @@ func (s Schedule) Normalize() (*Schedule, error) {
 func (s Schedule) IsUserFavorite() bool {
 	return s.isUserFavorite
 }
+
+func (s Schedule) teamID() sql.NullString {
+	return sql.NullString{String: s.TeamID, Valid: s.TeamID != ""}
+}
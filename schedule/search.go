@@ -46,6 +46,7 @@ var searchTemplate = template.Must(template.New("search").Funcs(search.Helpers()
 		sched.name,
 		sched.description,
 		sched.time_zone,
+		coalesce(sched.team_id::text, ''),
 		fav IS DISTINCT FROM NULL
 	FROM schedules sched
 	{{if not .FavoritesOnly }}
@@ -146,7 +147,7 @@ func (store *Store) Search(ctx context.Context, opts *SearchOptions) ([]Schedule
 	var s Schedule
 	var tz string
 	for rows.Next() {
-		err = rows.Scan(&s.ID, &s.Name, &s.Description, &tz, &s.isUserFavorite)
+		err = rows.Scan(&s.ID, &s.Name, &s.Description, &tz, &s.TeamID, &s.isUserFavorite)
 		if err != nil {
 			return nil, err
 		}
@@ -30,6 +30,8 @@ type Store struct {
 
 	findMany *sql.Stmt
 
+	findTeamIDs *sql.Stmt
+
 	usr *user.Store
 }
 
@@ -45,22 +47,23 @@ func NewStore(ctx context.Context, db *sql.DB, usr *user.Store) (*Store, error)
 		insertData:  p.P(`INSERT INTO schedule_data (schedule_id, data) VALUES ($1, '{}')`),
 		updateData:  p.P(`UPDATE schedule_data SET data = $2 WHERE schedule_id = $1`),
 
-		create:  p.P(`INSERT INTO schedules (id, name, description, time_zone) VALUES (DEFAULT, $1, $2, $3) RETURNING id`),
-		update:  p.P(`UPDATE schedules SET name = $2, description = $3, time_zone = $4 WHERE id = $1`),
-		findAll: p.P(`SELECT id, name, description, time_zone FROM schedules`),
+		create:  p.P(`INSERT INTO schedules (id, name, description, time_zone, team_id) VALUES (DEFAULT, $1, $2, $3, $4) RETURNING id`),
+		update:  p.P(`UPDATE schedules SET name = $2, description = $3, time_zone = $4, team_id = $5 WHERE id = $1`),
+		findAll: p.P(`SELECT id, name, description, time_zone, coalesce(team_id::text, '') FROM schedules`),
 		findOne: p.P(`
 			SELECT
 				s.id,
 				s.name,
 				s.description,
 				s.time_zone,
+				coalesce(s.team_id::text, ''),
 				fav IS DISTINCT FROM NULL
 			FROM schedules s
 			LEFT JOIN user_favorites fav ON
 				fav.tgt_schedule_id = s.id AND fav.user_id = $2
 			WHERE s.id = $1
 		`),
-		findOneUp: p.P(`SELECT id, name, description, time_zone FROM schedules WHERE id = $1 FOR UPDATE`),
+		findOneUp: p.P(`SELECT id, name, description, time_zone, coalesce(team_id::text, '') FROM schedules WHERE id = $1 FOR UPDATE`),
 
 		findMany: p.P(`
 			SELECT
@@ -68,6 +71,7 @@ func NewStore(ctx context.Context, db *sql.DB, usr *user.Store) (*Store, error)
 				s.name,
 				s.description,
 				s.time_zone,
+				coalesce(s.team_id::text, ''),
 				fav is distinct from null
 			FROM schedules s
 			LEFT JOIN user_favorites fav ON
@@ -76,6 +80,8 @@ func NewStore(ctx context.Context, db *sql.DB, usr *user.Store) (*Store, error)
 		`),
 
 		delete: p.P(`DELETE FROM schedules WHERE id = any($1)`),
+
+		findTeamIDs: p.P(`SELECT array(SELECT DISTINCT coalesce(team_id::text, '') FROM schedules WHERE id = any($1))`),
 	}, p.Err
 }
 func (store *Store) FindMany(ctx context.Context, ids []string) ([]Schedule, error) {
@@ -101,7 +107,7 @@ func (store *Store) FindMany(ctx context.Context, ids []string) ([]Schedule, err
 	var s Schedule
 	var tz string
 	for rows.Next() {
-		err = rows.Scan(&s.ID, &s.Name, &s.Description, &tz, &s.isUserFavorite)
+		err = rows.Scan(&s.ID, &s.Name, &s.Description, &tz, &s.TeamID, &s.isUserFavorite)
 		if err != nil {
 			return nil, err
 		}
@@ -129,11 +135,15 @@ func (store *Store) CreateScheduleTx(ctx context.Context, tx *sql.Tx, s *Schedul
 	if err != nil {
 		return nil, err
 	}
+	err = permission.LimitCheckOwner(ctx, n.TeamID)
+	if err != nil {
+		return nil, err
+	}
 	stmt := store.create
 	if tx != nil {
 		stmt = tx.Stmt(stmt)
 	}
-	row := stmt.QueryRowContext(ctx, n.Name, n.Description, n.TimeZone.String())
+	row := stmt.QueryRowContext(ctx, n.Name, n.Description, n.TimeZone.String(), n.teamID())
 	err = row.Scan(&n.ID)
 	return n, err
 }
@@ -154,7 +164,12 @@ func (store *Store) Update(ctx context.Context, s *Schedule) error {
 		return err
 	}
 
-	_, err = store.update.ExecContext(ctx, n.ID, n.Name, n.Description, n.TimeZone.String())
+	err = store.checkOwner(ctx, nil, []string{n.ID}, n.TeamID)
+	if err != nil {
+		return err
+	}
+
+	_, err = store.update.ExecContext(ctx, n.ID, n.Name, n.Description, n.TimeZone.String(), n.teamID())
 	return err
 }
 func (store *Store) UpdateTx(ctx context.Context, tx *sql.Tx, s *Schedule) error {
@@ -172,7 +187,12 @@ func (store *Store) UpdateTx(ctx context.Context, tx *sql.Tx, s *Schedule) error
 		return err
 	}
 
-	_, err = tx.StmtContext(ctx, store.update).ExecContext(ctx, n.ID, n.Name, n.Description, n.TimeZone.String())
+	err = store.checkOwner(ctx, tx, []string{n.ID}, n.TeamID)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.StmtContext(ctx, store.update).ExecContext(ctx, n.ID, n.Name, n.Description, n.TimeZone.String(), n.teamID())
 	return err
 }
 
@@ -192,7 +212,7 @@ func (store *Store) FindAll(ctx context.Context) ([]Schedule, error) {
 	var tz string
 	var res []Schedule
 	for rows.Next() {
-		err = rows.Scan(&s.ID, &s.Name, &s.Description, &tz)
+		err = rows.Scan(&s.ID, &s.Name, &s.Description, &tz, &s.TeamID)
 		if err != nil {
 			return nil, err
 		}
@@ -219,7 +239,7 @@ func (store *Store) FindOneForUpdate(ctx context.Context, tx *sql.Tx, id string)
 	row := tx.StmtContext(ctx, store.findOneUp).QueryRowContext(ctx, id)
 	var s Schedule
 	var tz string
-	err = row.Scan(&s.ID, &s.Name, &s.Description, &tz)
+	err = row.Scan(&s.ID, &s.Name, &s.Description, &tz, &s.TeamID)
 	if err != nil {
 		return nil, err
 	}
@@ -247,7 +267,7 @@ func (store *Store) FindOne(ctx context.Context, id string) (*Schedule, error) {
 	row := store.findOne.QueryRowContext(ctx, id, userID)
 	var s Schedule
 	var tz string
-	err = row.Scan(&s.ID, &s.Name, &s.Description, &tz, &s.isUserFavorite)
+	err = row.Scan(&s.ID, &s.Name, &s.Description, &tz, &s.TeamID, &s.isUserFavorite)
 	if err != nil {
 		return nil, err
 	}
@@ -277,6 +297,10 @@ func (store *Store) DeleteManyTx(ctx context.Context, tx *sql.Tx, ids []string)
 	if err != nil {
 		return err
 	}
+	err = store.checkOwner(ctx, tx, ids)
+	if err != nil {
+		return err
+	}
 	s := store.delete
 	if tx != nil {
 		s = tx.StmtContext(ctx, s)
@@ -284,3 +308,20 @@ func (store *Store) DeleteManyTx(ctx context.Context, tx *sql.Tx, ids []string)
 	_, err = s.ExecContext(ctx, sqlutil.UUIDArray(ids))
 	return err
 }
+
+// checkOwner will return an error if the context may not modify the given schedules, or
+// schedules owned by any of the extra team IDs.
+func (store *Store) checkOwner(ctx context.Context, tx *sql.Tx, ids []string, extraTeamIDs ...string) error {
+	stmt := store.findTeamIDs
+	if tx != nil {
+		stmt = tx.StmtContext(ctx, stmt)
+	}
+
+	var teamIDs sqlutil.StringArray
+	err := stmt.QueryRowContext(ctx, sqlutil.UUIDArray(ids)).Scan(&teamIDs)
+	if err != nil {
+		return err
+	}
+
+	return permission.LimitCheckOwner(ctx, append(teamIDs, extraTeamIDs...)...)
+}
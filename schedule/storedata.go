@@ -45,6 +45,11 @@ func (store *Store) updateScheduleData(ctx context.Context, tx *sql.Tx, schedule
 		defer tx.Rollback()
 	}
 
+	err = store.checkOwner(ctx, tx, []string{scheduleID.String()})
+	if err != nil {
+		return err
+	}
+
 	var rawData json.RawMessage
 	// Select for update, if it does not exist try inserting, if that fails due to a race, re-try select for update
 	err = tx.StmtContext(ctx, store.findUpdData).QueryRowContext(ctx, scheduleID).Scan(&rawData)
@@ -0,0 +1,629 @@
+package scim
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/target/goalert/audit"
+	"github.com/target/goalert/config"
+	"github.com/target/goalert/permission"
+	"github.com/target/goalert/user"
+	"github.com/target/goalert/util"
+	"github.com/target/goalert/util/log"
+	"github.com/target/goalert/util/sqlutil"
+	"github.com/target/goalert/validation"
+	"github.com/target/goalert/validation/validate"
+)
+
+// BasePath is the URL path prefix the Handler serves.
+const BasePath = "/api/v2/scim/v2"
+
+const maxResults = 200
+
+// Handler serves the SCIM 2.0 provisioning API for users.
+//
+// Users created by the API are tracked so that only provisioned users are listed. Deleting
+// a user removes them from GoAlert entirely, while deactivating them (`active` set to false)
+// keeps the user but blocks login and removes them from rotations.
+type Handler struct {
+	db    *sql.DB
+	users *user.Store
+
+	insert      *sql.Stmt
+	update      *sql.Stmt
+	findOneStmt *sql.Stmt
+	search      *sql.Stmt
+	searchCount *sql.Stmt
+	subjectUsr  *sql.Stmt
+}
+
+// NewHandler will create a new Handler with the given parameters.
+func NewHandler(ctx context.Context, db *sql.DB, users *user.Store) (*Handler, error) {
+	p := &util.Prepare{DB: db, Ctx: ctx}
+
+	return &Handler{
+		db:    db,
+		users: users,
+
+		insert: p.P(`
+			INSERT INTO scim_users (user_id, user_name, external_id)
+			VALUES ($1, $2, $3)
+		`),
+		update: p.P(`
+			UPDATE scim_users
+			SET user_name = $2, external_id = $3, updated_at = now()
+			WHERE user_id = $1
+		`),
+		findOneStmt: p.P(`
+			SELECT s.user_id, s.user_name, s.external_id, u.name, u.email, not u.disabled, s.created_at, s.updated_at
+			FROM scim_users s
+			JOIN users u ON u.id = s.user_id
+			WHERE s.user_id = $1
+		`),
+		search: p.P(`
+			SELECT s.user_id, s.user_name, s.external_id, u.name, u.email, not u.disabled, s.created_at, s.updated_at
+			FROM scim_users s
+			JOIN users u ON u.id = s.user_id
+			WHERE
+				($1::uuid ISNULL OR s.user_id = $1) AND
+				($2::text ISNULL OR lower(s.user_name) = lower($2)) AND
+				($3::text ISNULL OR s.external_id = $3)
+			ORDER BY lower(s.user_name)
+			OFFSET $4
+			LIMIT $5
+		`),
+		// counted separately, as no rows are returned when paging past the end (or with count=0)
+		searchCount: p.P(`
+			SELECT count(*)
+			FROM scim_users s
+			WHERE
+				($1::uuid ISNULL OR s.user_id = $1) AND
+				($2::text ISNULL OR lower(s.user_name) = lower($2)) AND
+				($3::text ISNULL OR s.external_id = $3)
+		`),
+		subjectUsr: p.P(`
+			SELECT a.user_id, s.user_id NOTNULL
+			FROM auth_subjects a
+			LEFT JOIN scim_users s ON s.user_id = a.user_id
+			WHERE a.provider_id = $1 AND a.subject_id = $2
+		`),
+	}, p.Err
+}
+
+// httpError is an error with an HTTP status and SCIM error type.
+type httpError struct {
+	status   int
+	scimType string
+	detail   string
+}
+
+func (e httpError) Error() string { return e.detail }
+
+var errNotFound = httpError{status: http.StatusNotFound, detail: "Resource not found."}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/scim+json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+func writeError(ctx context.Context, w http.ResponseWriter, err error) {
+	e := httpError{status: http.StatusInternalServerError, detail: "Internal server error."}
+	var hErr httpError
+	switch {
+	case errors.As(err, &hErr):
+		e = hErr
+	case permission.IsUnauthorized(err):
+		e = httpError{status: http.StatusUnauthorized, detail: err.Error()}
+	case permission.IsPermissionError(err):
+		e = httpError{status: http.StatusForbidden, detail: err.Error()}
+	case validation.IsValidationError(err):
+		e = httpError{status: http.StatusBadRequest, scimType: "invalidValue", detail: err.Error()}
+	default:
+		log.Log(ctx, err)
+	}
+
+	writeJSON(w, e.status, struct {
+		Schemas  []string `json:"schemas"`
+		Status   string   `json:"status"`
+		ScimType string   `json:"scimType,omitempty"`
+		Detail   string   `json:"detail"`
+	}{
+		Schemas:  []string{SchemaError},
+		Status:   strconv.Itoa(e.status),
+		ScimType: e.scimType,
+		Detail:   e.detail,
+	})
+}
+
+// ServeHTTP implements the http.Handler interface.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+	if !config.FromContext(ctx).SCIM.Enable {
+		writeError(ctx, w, httpError{status: http.StatusNotFound, detail: "SCIM is disabled."})
+		return
+	}
+
+	err := permission.LimitCheckAny(ctx, permission.System, permission.Admin)
+	if err != nil {
+		writeError(ctx, w, err)
+		return
+	}
+
+	path := strings.TrimSuffix(strings.TrimPrefix(req.URL.Path, BasePath), "/")
+	switch {
+	case path == "/ServiceProviderConfig" && req.Method == "GET":
+		h.serveServiceProviderConfig(w, req)
+	case path == "/ResourceTypes" && req.Method == "GET":
+		h.serveResourceTypes(w, req)
+	case path == "/Users" && req.Method == "GET":
+		h.serveListUsers(w, req)
+	case path == "/Users" && req.Method == "POST":
+		h.serveCreateUser(w, req)
+	case strings.HasPrefix(path, "/Users/"):
+		id := strings.TrimPrefix(path, "/Users/")
+		switch req.Method {
+		case "GET":
+			h.serveGetUser(w, req, id)
+		case "PUT":
+			h.serveReplaceUser(w, req, id)
+		case "PATCH":
+			h.servePatchUser(w, req, id)
+		case "DELETE":
+			h.serveDeleteUser(w, req, id)
+		default:
+			writeError(ctx, w, httpError{status: http.StatusMethodNotAllowed, detail: "Method not allowed."})
+		}
+	default:
+		writeError(ctx, w, errNotFound)
+	}
+}
+
+func (h *Handler) serveServiceProviderConfig(w http.ResponseWriter, req *http.Request) {
+	type supported struct {
+		Supported bool `json:"supported"`
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"schemas":        []string{"urn:ietf:params:scim:schemas:core:2.0:ServiceProviderConfig"},
+		"patch":          supported{Supported: true},
+		"bulk":           map[string]interface{}{"supported": false, "maxOperations": 0, "maxPayloadSize": 0},
+		"filter":         map[string]interface{}{"supported": true, "maxResults": maxResults},
+		"changePassword": supported{},
+		"sort":           supported{},
+		"etag":           supported{},
+		"authenticationSchemes": []map[string]interface{}{{
+			"type":        "oauthbearertoken",
+			"name":        "API Key",
+			"description": "Authentication using an admin-scoped GoAlert API key as a bearer token.",
+		}},
+	})
+}
+
+func (h *Handler) serveResourceTypes(w http.ResponseWriter, req *http.Request) {
+	writeJSON(w, http.StatusOK, listResponse([]interface{}{
+		map[string]interface{}{
+			"schemas":  []string{"urn:ietf:params:scim:schemas:core:2.0:ResourceType"},
+			"id":       "User",
+			"name":     "User",
+			"endpoint": "/Users",
+			"schema":   SchemaUser,
+		},
+	}, 1, 1))
+}
+
+type listResp struct {
+	Schemas      []string    `json:"schemas"`
+	TotalResults int         `json:"totalResults"`
+	StartIndex   int         `json:"startIndex"`
+	ItemsPerPage int         `json:"itemsPerPage"`
+	Resources    interface{} `json:"Resources"`
+}
+
+func listResponse(items []interface{}, total, start int) listResp {
+	return listResp{
+		Schemas:      []string{SchemaListResponse},
+		TotalResults: total,
+		StartIndex:   start,
+		ItemsPerPage: len(items),
+		Resources:    items,
+	}
+}
+
+func (h *Handler) location(ctx context.Context, id string) string {
+	return config.FromContext(ctx).CallbackURL(BasePath + "/Users/" + id)
+}
+
+func (h *Handler) scanUser(ctx context.Context, scan func(...interface{}) error) (*User, error) {
+	var u User
+	var name, email string
+	var active bool
+	u.Meta = &Meta{ResourceType: "User"}
+	err := scan(&u.ID, &u.UserName, &u.ExternalID, &name, &email, &active, &u.Meta.Created, &u.Meta.LastModified)
+	if err != nil {
+		return nil, err
+	}
+
+	u.Schemas = []string{SchemaUser}
+	u.DisplayName = name
+	u.Name = &Name{Formatted: name}
+	if email != "" {
+		u.Emails = []Email{{Value: email, Type: "work", Primary: true}}
+	}
+	u.Active = &active
+	u.Meta.Location = h.location(ctx, u.ID)
+
+	return &u, nil
+}
+
+func (h *Handler) findOne(ctx context.Context, tx *sql.Tx, id string) (*User, error) {
+	if validate.UUID("id", id) != nil {
+		return nil, errNotFound
+	}
+
+	stmt := h.findOneStmt
+	if tx != nil {
+		stmt = tx.StmtContext(ctx, stmt)
+	}
+	u, err := h.scanUser(ctx, stmt.QueryRowContext(ctx, id).Scan)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, errNotFound
+	}
+
+	return u, err
+}
+
+func (h *Handler) serveListUsers(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+	q := req.URL.Query()
+
+	filter, err := ParseFilter(q.Get("filter"))
+	if err != nil {
+		writeError(ctx, w, httpError{status: http.StatusBadRequest, scimType: "invalidFilter", detail: err.Error()})
+		return
+	}
+
+	start, count := 1, 100
+	if v := q.Get("startIndex"); v != "" {
+		start, err = strconv.Atoi(v)
+		if err != nil || start < 1 {
+			start = 1
+		}
+	}
+	if v := q.Get("count"); v != "" {
+		count, err = strconv.Atoi(v)
+		if err != nil || count < 0 {
+			count = 0
+		}
+		if count > maxResults {
+			count = maxResults
+		}
+	}
+
+	var id, userName, externalID sql.NullString
+	if filter != nil {
+		switch filter.Attr {
+		case "id":
+			if validate.UUID("id", filter.Value) != nil {
+				writeJSON(w, http.StatusOK, listResponse([]interface{}{}, 0, start))
+				return
+			}
+			id = sql.NullString{String: filter.Value, Valid: true}
+		case "username":
+			userName = sql.NullString{String: filter.Value, Valid: true}
+		case "externalid":
+			externalID = sql.NullString{String: filter.Value, Valid: true}
+		}
+	}
+
+	var total int
+	err = h.searchCount.QueryRowContext(ctx, id, userName, externalID).Scan(&total)
+	if err != nil {
+		writeError(ctx, w, err)
+		return
+	}
+
+	rows, err := h.search.QueryContext(ctx, id, userName, externalID, start-1, count)
+	if err != nil {
+		writeError(ctx, w, err)
+		return
+	}
+	defer rows.Close()
+
+	items := []interface{}{}
+	for rows.Next() {
+		u, err := h.scanUser(ctx, rows.Scan)
+		if err != nil {
+			writeError(ctx, w, err)
+			return
+		}
+		items = append(items, u)
+	}
+	if err = rows.Err(); err != nil {
+		writeError(ctx, w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, listResponse(items, total, start))
+}
+
+func (h *Handler) serveGetUser(w http.ResponseWriter, req *http.Request, id string) {
+	ctx := req.Context()
+	u, err := h.findOne(ctx, nil, id)
+	if err != nil {
+		writeError(ctx, w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, u)
+}
+
+func decodeBody(req *http.Request, v interface{}) error {
+	err := json.NewDecoder(req.Body).Decode(v)
+	if err != nil {
+		return httpError{status: http.StatusBadRequest, scimType: "invalidSyntax", detail: "Invalid request body: " + err.Error()}
+	}
+
+	return nil
+}
+
+// mapUniqueErr maps a unique violation on the user name to a SCIM uniqueness error.
+func mapUniqueErr(err error) error {
+	if sqlErr := sqlutil.MapError(err); sqlErr != nil && sqlErr.Code == "23505" && sqlErr.ConstraintName == "idx_scim_user_name" {
+		return httpError{status: http.StatusConflict, scimType: "uniqueness", detail: "userName is already in use."}
+	}
+
+	return err
+}
+
+func (h *Handler) withTx(ctx context.Context, fn func(tx *sql.Tx) error) error {
+	tx, err := h.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	err = audit.SetActorTx(ctx, tx)
+	if err != nil {
+		return err
+	}
+
+	err = fn(tx)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (h *Handler) serveCreateUser(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+	cfg := config.FromContext(ctx)
+
+	var res User
+	err := decodeBody(req, &res)
+	if err != nil {
+		writeError(ctx, w, err)
+		return
+	}
+	n, err := res.Normalize()
+	if err != nil {
+		writeError(ctx, w, err)
+		return
+	}
+
+	var userID string
+	err = h.withTx(ctx, func(tx *sql.Tx) error {
+		subject := n.Subject(cfg.SCIM.SubjectAttribute)
+		if cfg.SCIM.AuthProviderID != "" && subject != "" {
+			// adopt users that have already logged in with the provider
+			var provisioned bool
+			err := tx.StmtContext(ctx, h.subjectUsr).QueryRowContext(ctx, cfg.SCIM.AuthProviderID, subject).Scan(&userID, &provisioned)
+			if err != nil && !errors.Is(err, sql.ErrNoRows) {
+				return err
+			}
+			if provisioned {
+				return httpError{status: http.StatusConflict, scimType: "uniqueness", detail: "A user with the same auth subject already exists."}
+			}
+		}
+
+		if userID == "" {
+			u, err := h.users.InsertTx(ctx, tx, &user.User{
+				Role:  permission.RoleUser,
+				Name:  validate.SanitizeName(n.FullName()),
+				Email: validate.SanitizeEmail(n.PrimaryEmail()),
+			})
+			if err != nil {
+				return err
+			}
+			userID = u.ID
+		} else {
+			err = h.updateUser(ctx, tx, userID, n)
+			if err != nil {
+				return err
+			}
+		}
+
+		_, err := tx.StmtContext(ctx, h.insert).ExecContext(ctx, userID, n.UserName, n.ExternalID)
+		if err != nil {
+			return mapUniqueErr(err)
+		}
+
+		if cfg.SCIM.AuthProviderID != "" && subject != "" {
+			err = h.users.AddAuthSubjectTx(ctx, tx, &user.AuthSubject{ProviderID: cfg.SCIM.AuthProviderID, SubjectID: subject, UserID: userID})
+			if err != nil {
+				return err
+			}
+		}
+
+		if !n.IsActive() {
+			return h.users.SetActiveTx(ctx, tx, userID, false)
+		}
+
+		return nil
+	})
+	if err != nil {
+		writeError(ctx, w, err)
+		return
+	}
+
+	u, err := h.findOne(ctx, nil, userID)
+	if err != nil {
+		writeError(ctx, w, err)
+		return
+	}
+
+	w.Header().Set("Location", u.Meta.Location)
+	writeJSON(w, http.StatusCreated, u)
+}
+
+// updateUser will update the GoAlert user's name and email.
+func (h *Handler) updateUser(ctx context.Context, tx *sql.Tx, userID string, res *User) error {
+	u, err := h.users.FindOneTx(ctx, tx, userID, true)
+	if err != nil {
+		return err
+	}
+	if name := validate.SanitizeName(res.FullName()); name != "" {
+		u.Name = name
+	}
+	if email := validate.SanitizeEmail(res.PrimaryEmail()); email != "" {
+		u.Email = email
+	}
+
+	return h.users.UpdateTx(ctx, tx, u)
+}
+
+// replace will replace the stored user with the given resource.
+func (h *Handler) replace(ctx context.Context, tx *sql.Tx, old, res *User) error {
+	cfg := config.FromContext(ctx)
+
+	n, err := res.Normalize()
+	if err != nil {
+		return err
+	}
+
+	err = h.updateUser(ctx, tx, old.ID, n)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.StmtContext(ctx, h.update).ExecContext(ctx, old.ID, n.UserName, n.ExternalID)
+	if err != nil {
+		return mapUniqueErr(err)
+	}
+
+	oldSubject, newSubject := old.Subject(cfg.SCIM.SubjectAttribute), n.Subject(cfg.SCIM.SubjectAttribute)
+	if cfg.SCIM.AuthProviderID != "" && oldSubject != newSubject {
+		if oldSubject != "" {
+			err = h.users.DeleteAuthSubjectTx(ctx, tx, &user.AuthSubject{ProviderID: cfg.SCIM.AuthProviderID, SubjectID: oldSubject, UserID: old.ID})
+			if err != nil {
+				return err
+			}
+		}
+		if newSubject != "" {
+			err = h.users.AddAuthSubjectTx(ctx, tx, &user.AuthSubject{ProviderID: cfg.SCIM.AuthProviderID, SubjectID: newSubject, UserID: old.ID})
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	if old.IsActive() != n.IsActive() {
+		return h.users.SetActiveTx(ctx, tx, old.ID, n.IsActive())
+	}
+
+	return nil
+}
+
+func (h *Handler) serveReplaceUser(w http.ResponseWriter, req *http.Request, id string) {
+	ctx := req.Context()
+
+	var res User
+	err := decodeBody(req, &res)
+	if err != nil {
+		writeError(ctx, w, err)
+		return
+	}
+
+	err = h.withTx(ctx, func(tx *sql.Tx) error {
+		old, err := h.findOne(ctx, tx, id)
+		if err != nil {
+			return err
+		}
+		if res.Active == nil {
+			// omitted attributes are cleared on replace, but users should not be deactivated implicitly
+			res.Active = old.Active
+		}
+
+		return h.replace(ctx, tx, old, &res)
+	})
+	if err != nil {
+		writeError(ctx, w, err)
+		return
+	}
+
+	h.serveGetUser(w, req, id)
+}
+
+func (h *Handler) servePatchUser(w http.ResponseWriter, req *http.Request, id string) {
+	ctx := req.Context()
+
+	var patch PatchRequest
+	err := decodeBody(req, &patch)
+	if err != nil {
+		writeError(ctx, w, err)
+		return
+	}
+
+	err = h.withTx(ctx, func(tx *sql.Tx) error {
+		old, err := h.findOne(ctx, tx, id)
+		if err != nil {
+			return err
+		}
+
+		res := *old
+		if old.Name != nil {
+			n := *old.Name
+			res.Name = &n
+		}
+		res.Emails = append([]Email(nil), old.Emails...)
+		for i, op := range patch.Operations {
+			err = res.ApplyPatch(op)
+			if err != nil {
+				return validation.AddPrefix(fmt.Sprintf("Operations[%d].", i), err)
+			}
+		}
+
+		return h.replace(ctx, tx, old, &res)
+	})
+	if err != nil {
+		writeError(ctx, w, err)
+		return
+	}
+
+	h.serveGetUser(w, req, id)
+}
+
+func (h *Handler) serveDeleteUser(w http.ResponseWriter, req *http.Request, id string) {
+	ctx := req.Context()
+
+	err := h.withTx(ctx, func(tx *sql.Tx) error {
+		_, err := h.findOne(ctx, tx, id)
+		if err != nil {
+			return err
+		}
+
+		return h.users.DeleteManyTx(ctx, tx, []string{id})
+	})
+	if err != nil {
+		writeError(ctx, w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,284 @@
+package scim
+
+import (
+	"encoding/json"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/target/goalert/validation"
+	"github.com/target/goalert/validation/validate"
+)
+
+// Schema URNs used by the API.
+const (
+	SchemaUser         = "urn:ietf:params:scim:schemas:core:2.0:User"
+	SchemaListResponse = "urn:ietf:params:scim:api:messages:2.0:ListResponse"
+	SchemaPatchOp      = "urn:ietf:params:scim:api:messages:2.0:PatchOp"
+	SchemaError        = "urn:ietf:params:scim:api:messages:2.0:Error"
+)
+
+// User is a SCIM User resource.
+type User struct {
+	Schemas     []string `json:"schemas"`
+	ID          string   `json:"id,omitempty"`
+	ExternalID  string   `json:"externalId,omitempty"`
+	UserName    string   `json:"userName"`
+	Name        *Name    `json:"name,omitempty"`
+	DisplayName string   `json:"displayName,omitempty"`
+	Emails      []Email  `json:"emails,omitempty"`
+	Active      *bool    `json:"active,omitempty"`
+	Meta        *Meta    `json:"meta,omitempty"`
+}
+
+// Name is the components of a User's name.
+type Name struct {
+	Formatted  string `json:"formatted,omitempty"`
+	GivenName  string `json:"givenName,omitempty"`
+	FamilyName string `json:"familyName,omitempty"`
+}
+
+// Email is an email address of a User.
+type Email struct {
+	Value   string `json:"value"`
+	Type    string `json:"type,omitempty"`
+	Primary bool   `json:"primary,omitempty"`
+}
+
+// Meta is resource metadata.
+type Meta struct {
+	ResourceType string    `json:"resourceType"`
+	Created      time.Time `json:"created"`
+	LastModified time.Time `json:"lastModified"`
+	Location     string    `json:"location,omitempty"`
+}
+
+// PatchOp is a single operation of a PATCH request.
+type PatchOp struct {
+	Op    string          `json:"op"`
+	Path  string          `json:"path"`
+	Value json.RawMessage `json:"value"`
+}
+
+// PatchRequest is the body of a PATCH request.
+type PatchRequest struct {
+	Schemas    []string  `json:"schemas"`
+	Operations []PatchOp `json:"Operations"`
+}
+
+// IsActive returns false only if the user has been explicitly deactivated.
+func (u User) IsActive() bool { return u.Active == nil || *u.Active }
+
+// FullName returns the user's name for GoAlert, falling back to the userName.
+func (u User) FullName() string {
+	switch {
+	case u.DisplayName != "":
+		return u.DisplayName
+	case u.Name != nil && u.Name.Formatted != "":
+		return u.Name.Formatted
+	case u.Name != nil && (u.Name.GivenName != "" || u.Name.FamilyName != ""):
+		return strings.TrimSpace(u.Name.GivenName + " " + u.Name.FamilyName)
+	}
+
+	return u.UserName
+}
+
+// PrimaryEmail returns the primary (or first) email, falling back to the
+// userName if it is an email address.
+func (u User) PrimaryEmail() string {
+	var first string
+	for _, e := range u.Emails {
+		if e.Value == "" {
+			continue
+		}
+		if e.Primary {
+			return e.Value
+		}
+		if first == "" {
+			first = e.Value
+		}
+	}
+	if first != "" {
+		return first
+	}
+	if validate.Email("userName", u.UserName) == nil {
+		return u.UserName
+	}
+
+	return ""
+}
+
+// Subject returns the auth subject ID for the user, based on the attribute name.
+func (u User) Subject(attr string) string {
+	if attr == "userName" {
+		return u.UserName
+	}
+
+	return u.ExternalID
+}
+
+// Normalize will validate and normalize the resource for storage.
+func (u User) Normalize() (*User, error) {
+	u.UserName = strings.TrimSpace(u.UserName)
+	u.ExternalID = strings.TrimSpace(u.ExternalID)
+
+	err := validate.Many(
+		validate.Text("userName", u.UserName, 1, 255),
+		validate.Text("externalId", u.ExternalID, 0, 255),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &u, nil
+}
+
+var emailFilterPath = regexp.MustCompile(`(?i)^emails\[type eq "([^"]*)"\]\.value$`)
+
+func unmarshalString(path string, raw json.RawMessage) (string, error) {
+	var s string
+	err := json.Unmarshal(raw, &s)
+	if err != nil {
+		return "", validation.NewFieldError(path, "must be a string")
+	}
+
+	return s, nil
+}
+
+// setAttr sets the value of a single attribute by path. Unsupported attributes are ignored.
+func (u *User) setAttr(path string, raw json.RawMessage) error {
+	if m := emailFilterPath.FindStringSubmatch(path); m != nil {
+		val, err := unmarshalString(path, raw)
+		if err != nil {
+			return err
+		}
+		for i, e := range u.Emails {
+			if strings.EqualFold(e.Type, m[1]) {
+				u.Emails[i].Value = val
+				return nil
+			}
+		}
+		u.Emails = append(u.Emails, Email{Value: val, Type: m[1], Primary: len(u.Emails) == 0})
+		return nil
+	}
+
+	lPath := strings.ToLower(path)
+	if strings.HasPrefix(lPath, "name.") && u.Name == nil {
+		u.Name = &Name{}
+	}
+
+	var err error
+	switch lPath {
+	case "active":
+		var b bool
+		// some providers send booleans as strings (e.g., "False")
+		if s, strErr := unmarshalString(path, raw); strErr == nil {
+			b, err = strconv.ParseBool(s)
+		} else {
+			err = json.Unmarshal(raw, &b)
+		}
+		if err != nil {
+			return validation.NewFieldError(path, "must be a boolean")
+		}
+		u.Active = &b
+	case "username":
+		u.UserName, err = unmarshalString(path, raw)
+	case "externalid":
+		u.ExternalID, err = unmarshalString(path, raw)
+	case "displayname":
+		u.DisplayName, err = unmarshalString(path, raw)
+	case "name.formatted":
+		u.Name.Formatted, err = unmarshalString(path, raw)
+	case "name.givenname":
+		u.Name.GivenName, err = unmarshalString(path, raw)
+	case "name.familyname":
+		u.Name.FamilyName, err = unmarshalString(path, raw)
+	case "name":
+		var n Name
+		err = json.Unmarshal(raw, &n)
+		if err != nil {
+			return validation.NewFieldError(path, "must be an object")
+		}
+		u.Name = &n
+	case "emails":
+		var e []Email
+		err = json.Unmarshal(raw, &e)
+		if err != nil {
+			return validation.NewFieldError(path, "must be a list")
+		}
+		u.Emails = e
+	}
+
+	return err
+}
+
+// ApplyPatch will apply the PATCH operation to the resource.
+func (u *User) ApplyPatch(op PatchOp) error {
+	switch strings.ToLower(op.Op) {
+	case "add", "replace":
+	case "remove":
+		if op.Path == "" {
+			return validation.NewFieldError("path", "required for remove")
+		}
+		switch strings.ToLower(op.Path) {
+		case "active":
+			return nil
+		case "name":
+			u.Name = nil
+			return nil
+		case "emails":
+			u.Emails = nil
+			return nil
+		}
+		return u.setAttr(op.Path, json.RawMessage(`""`))
+	default:
+		return validation.NewFieldError("op", "unsupported operation")
+	}
+
+	if op.Path != "" {
+		return u.setAttr(op.Path, op.Value)
+	}
+
+	var attrs map[string]json.RawMessage
+	err := json.Unmarshal(op.Value, &attrs)
+	if err != nil {
+		return validation.NewFieldError("value", "must be an object")
+	}
+	for path, raw := range attrs {
+		err = u.setAttr(path, raw)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Filter is a parsed (simple) SCIM filter.
+type Filter struct {
+	Attr  string
+	Value string
+}
+
+var filterRx = regexp.MustCompile(`(?i)^\s*(id|userName|externalId)\s+eq\s+("(?:[^"\\]|\\.)*")\s*$`)
+
+// ParseFilter parses a filter of the form `attr eq "value"`, where attr is one of
+// id, userName, or externalId. These are the filters used by identity providers
+// to look up existing users.
+func ParseFilter(s string) (*Filter, error) {
+	if s == "" {
+		return nil, nil
+	}
+
+	m := filterRx.FindStringSubmatch(s)
+	if m == nil {
+		return nil, validation.NewFieldError("filter", "only `id`, `userName`, or `externalId` with `eq` are supported")
+	}
+	val, err := strconv.Unquote(m[2])
+	if err != nil {
+		return nil, validation.NewFieldError("filter", "invalid value")
+	}
+
+	return &Filter{Attr: strings.ToLower(m[1]), Value: val}, nil
+}
@@ -0,0 +1,69 @@
+package scim
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUser_ApplyPatch(t *testing.T) {
+	active := true
+	u := User{
+		UserName: "jsmith@example.com",
+		Name:     &Name{GivenName: "Joe", FamilyName: "Smith"},
+		Emails:   []Email{{Value: "jsmith@example.com", Type: "work", Primary: true}},
+		Active:   &active,
+	}
+	op := func(op, path, value string) PatchOp {
+		return PatchOp{Op: op, Path: path, Value: json.RawMessage(value)}
+	}
+
+	require.NoError(t, u.ApplyPatch(op("Replace", "active", `"False"`)), "string boolean")
+	assert.False(t, u.IsActive())
+
+	require.NoError(t, u.ApplyPatch(op("replace", "", `{"active":true,"name.givenName":"Joseph","externalId":"abc"}`)), "no path")
+	assert.True(t, u.IsActive())
+	assert.Equal(t, "Joseph Smith", u.FullName())
+	assert.Equal(t, "abc", u.ExternalID)
+
+	require.NoError(t, u.ApplyPatch(op("replace", `emails[type eq "work"].value`, `"joe@example.com"`)))
+	assert.Equal(t, "joe@example.com", u.PrimaryEmail())
+
+	require.NoError(t, u.ApplyPatch(op("add", "displayName", `"Joe S."`)))
+	assert.Equal(t, "Joe S.", u.FullName())
+
+	require.NoError(t, u.ApplyPatch(op("remove", "displayName", "")))
+	require.NoError(t, u.ApplyPatch(op("remove", "name", "")))
+	require.NoError(t, u.ApplyPatch(op("remove", "emails", "")))
+	assert.Equal(t, "jsmith@example.com", u.FullName(), "fallback to userName")
+	assert.Equal(t, "jsmith@example.com", u.PrimaryEmail(), "fallback to userName")
+
+	require.NoError(t, u.ApplyPatch(op("replace", "urn:example:unknown", `"x"`)), "ignore unknown")
+
+	assert.Error(t, u.ApplyPatch(op("move", "active", `true`)), "invalid op")
+	assert.Error(t, u.ApplyPatch(op("replace", "active", `"maybe"`)), "invalid boolean")
+	assert.Error(t, u.ApplyPatch(op("remove", "", "")), "remove without path")
+}
+
+func TestParseFilter(t *testing.T) {
+	f, err := ParseFilter(`userName eq "jsmith@example.com"`)
+	require.NoError(t, err)
+	assert.Equal(t, &Filter{Attr: "username", Value: "jsmith@example.com"}, f)
+
+	f, err = ParseFilter(`externalId EQ "a\"b"`)
+	require.NoError(t, err)
+	assert.Equal(t, &Filter{Attr: "externalid", Value: `a"b`}, f)
+
+	f, err = ParseFilter("")
+	assert.NoError(t, err)
+	assert.Nil(t, f)
+
+	_, err = ParseFilter(`displayName eq "Joe"`)
+	assert.Error(t, err, "unsupported attribute")
+	_, err = ParseFilter(`userName sw "j"`)
+	assert.Error(t, err, "unsupported operator")
+	_, err = ParseFilter(`userName eq "a" and externalId eq "b"`)
+	assert.Error(t, err, "compound")
+}
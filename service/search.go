@@ -48,6 +48,11 @@ var searchTemplate = template.Must(template.New("search").Funcs(search.Helpers()
 		svc.name,
 		svc.description,
 		svc.escalation_policy_id,
+		svc.ack_timeout_minutes,
+		svc.ack_timeout_limit,
+		svc.alert_storm_threshold,
+		svc.alert_storm_window_minutes,
+		coalesce(svc.team_id::text, ''),
 		fav IS DISTINCT FROM NULL
 	FROM services svc
 	{{if not .FavoritesOnly }}LEFT {{end}}JOIN user_favorites fav ON svc.id = fav.tgt_service_id AND {{if .FavoritesUserID}}fav.user_id = :favUserID{{else}}false{{end}}
@@ -206,7 +211,7 @@ func (db *DB) Search(ctx context.Context, opts *SearchOptions) ([]Service, error
 	var result []Service
 	for rows.Next() {
 		var s Service
-		err = rows.Scan(&s.ID, &s.Name, &s.Description, &s.EscalationPolicyID, &s.isUserFavorite)
+		err = rows.Scan(&s.ID, &s.Name, &s.Description, &s.EscalationPolicyID, &s.AckTimeoutMinutes, &s.AckTimeoutLimit, &s.AlertStormThreshold, &s.AlertStormWindowMinutes, &s.TeamID, &s.isUserFavorite)
 		if err != nil {
 			return nil, err
 		}
@@ -1,6 +1,16 @@
 package service
 
-import "github.com/target/goalert/validation/validate"
+import (
+	"database/sql"
+
+	"github.com/target/goalert/validation/validate"
+)
+
+// DefaultAckTimeoutLimit is the default maximum number of times an alert is re-triggered after an acknowledgement timeout.
+const DefaultAckTimeoutLimit = 3
+
+// DefaultAlertStormWindowMinutes is the default window used to measure the rate of new alerts.
+const DefaultAlertStormWindowMinutes = 5
 
 type Service struct {
 	ID                 string `json:"id"`
@@ -8,6 +18,24 @@ type Service struct {
 	Description        string `json:"description"`
 	EscalationPolicyID string `json:"escalation_policy_id"`
 
+	// AckTimeoutMinutes is the time after which acknowledged alerts are re-triggered, or zero if disabled.
+	AckTimeoutMinutes int `json:"ack_timeout_minutes"`
+
+	// AckTimeoutLimit is the maximum number of times a single alert will be re-triggered.
+	AckTimeoutLimit int `json:"ack_timeout_limit"`
+
+	// AlertStormThreshold is the number of new alerts within AlertStormWindowMinutes that
+	// will start an alert storm, or zero if disabled. During a storm, new alerts are
+	// aggregated into a single storm alert.
+	AlertStormThreshold int `json:"alert_storm_threshold"`
+
+	// AlertStormWindowMinutes is the window used to measure the rate of new alerts.
+	AlertStormWindowMinutes int `json:"alert_storm_window_minutes"`
+
+	// TeamID is the team that owns the service, or empty if it is not owned by a team.
+	// Only members of the owning team may modify an owned service.
+	TeamID string `json:"team_id,omitempty"`
+
 	epName         string
 	isUserFavorite bool
 }
@@ -24,14 +52,31 @@ func (s Service) IsUserFavorite() bool {
 // Normalize will validate and 'normalize' the ContactMethod -- such as making email lower-case
 // and setting carrier to "" (for non-phone types).
 func (s Service) Normalize() (*Service, error) {
+	if s.AckTimeoutLimit == 0 {
+		s.AckTimeoutLimit = DefaultAckTimeoutLimit
+	}
+	if s.AlertStormWindowMinutes == 0 {
+		s.AlertStormWindowMinutes = DefaultAlertStormWindowMinutes
+	}
 	err := validate.Many(
 		validate.IDName("Name", s.Name),
 		validate.Text("Description", s.Description, 1, 255),
 		validate.UUID("EscalationPolicyID", s.EscalationPolicyID),
+		validate.Range("AckTimeoutMinutes", s.AckTimeoutMinutes, 0, 10080),
+		validate.Range("AckTimeoutLimit", s.AckTimeoutLimit, 1, 100),
+		validate.Range("AlertStormThreshold", s.AlertStormThreshold, 0, 10000),
+		validate.Range("AlertStormWindowMinutes", s.AlertStormWindowMinutes, 1, 1440),
 	)
+	if s.TeamID != "" {
+		err = validate.Many(err, validate.UUID("TeamID", s.TeamID))
+	}
 	if err != nil {
 		return nil, err
 	}
 
 	return &s, nil
 }
+
+func (s Service) teamID() sql.NullString {
+	return sql.NullString{String: s.TeamID, Valid: s.TeamID != ""}
+}
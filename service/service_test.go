@@ -23,9 +23,15 @@ func TestService_Normalize(t *testing.T) {
 
 	valid := []Service{
 		{Name: "Sample Service", Description: "Sample Service", EscalationPolicyID: "A035FD3C-73C8-4F72-BECD-36B027AE1374"},
+		{Name: "Sample Service", Description: "Sample Service", EscalationPolicyID: "A035FD3C-73C8-4F72-BECD-36B027AE1374", AckTimeoutMinutes: 60, AckTimeoutLimit: 5},
+		{Name: "Sample Service", Description: "Sample Service", EscalationPolicyID: "A035FD3C-73C8-4F72-BECD-36B027AE1374", AlertStormThreshold: 50, AlertStormWindowMinutes: 10},
 	}
 	invalid := []Service{
 		{},
+		{Name: "Sample Service", Description: "Sample Service", EscalationPolicyID: "A035FD3C-73C8-4F72-BECD-36B027AE1374", AckTimeoutMinutes: -1},
+		{Name: "Sample Service", Description: "Sample Service", EscalationPolicyID: "A035FD3C-73C8-4F72-BECD-36B027AE1374", AckTimeoutLimit: 101},
+		{Name: "Sample Service", Description: "Sample Service", EscalationPolicyID: "A035FD3C-73C8-4F72-BECD-36B027AE1374", AlertStormThreshold: -1},
+		{Name: "Sample Service", Description: "Sample Service", EscalationPolicyID: "A035FD3C-73C8-4F72-BECD-36B027AE1374", AlertStormWindowMinutes: 1441},
 	}
 	for _, s := range valid {
 		test(true, s)
@@ -45,6 +45,7 @@ type DB struct {
 	insert      *sql.Stmt
 	update      *sql.Stmt
 	delete      *sql.Stmt
+	teamIDs     *sql.Stmt
 }
 
 func NewDB(ctx context.Context, db *sql.DB) (*DB, error) {
@@ -58,6 +59,11 @@ func NewDB(ctx context.Context, db *sql.DB) (*DB, error) {
 			s.name,
 			s.description,
 			s.escalation_policy_id,
+			s.ack_timeout_minutes,
+			s.ack_timeout_limit,
+			s.alert_storm_threshold,
+			s.alert_storm_window_minutes,
+			coalesce(s.team_id::text, ''),
 			e.name,
 			fav	is distinct from null
 		FROM
@@ -72,7 +78,12 @@ func NewDB(ctx context.Context, db *sql.DB) (*DB, error) {
 			s.id,
 			s.name,
 			s.description,
-			s.escalation_policy_id
+			s.escalation_policy_id,
+			s.ack_timeout_minutes,
+			s.ack_timeout_limit,
+			s.alert_storm_threshold,
+			s.alert_storm_window_minutes,
+			coalesce(s.team_id::text, '')
 		FROM services s
 		WHERE s.id = $1
 		FOR UPDATE
@@ -83,6 +94,11 @@ func NewDB(ctx context.Context, db *sql.DB) (*DB, error) {
 			s.name,
 			s.description,
 			s.escalation_policy_id,
+			s.ack_timeout_minutes,
+			s.ack_timeout_limit,
+			s.alert_storm_threshold,
+			s.alert_storm_window_minutes,
+			coalesce(s.team_id::text, ''),
 			e.name,
 			fav	is distinct from null
 		FROM
@@ -99,6 +115,11 @@ func NewDB(ctx context.Context, db *sql.DB) (*DB, error) {
 			s.name,
 			s.description,
 			s.escalation_policy_id,
+			s.ack_timeout_minutes,
+			s.ack_timeout_limit,
+			s.alert_storm_threshold,
+			s.alert_storm_window_minutes,
+			coalesce(s.team_id::text, ''),
 			e.name,
 			false
 		FROM
@@ -113,6 +134,11 @@ func NewDB(ctx context.Context, db *sql.DB) (*DB, error) {
 			s.name,
 			s.description,
 			s.escalation_policy_id,
+			s.ack_timeout_minutes,
+			s.ack_timeout_limit,
+			s.alert_storm_threshold,
+			s.alert_storm_window_minutes,
+			coalesce(s.team_id::text, ''),
 			e.name,
 			false
 		FROM
@@ -122,9 +148,23 @@ func NewDB(ctx context.Context, db *sql.DB) (*DB, error) {
 			e.id = $1 AND
 			e.id = s.escalation_policy_id
 	`)
-	s.insert = p(`INSERT INTO services (id,name,description,escalation_policy_id) VALUES ($1,$2,$3,$4)`)
-	s.update = p(`UPDATE services SET name = $2, description = $3, escalation_policy_id = $4 WHERE id = $1`)
+	s.insert = p(`
+		INSERT INTO services (
+			id, name, description, escalation_policy_id,
+			ack_timeout_minutes, ack_timeout_limit,
+			alert_storm_threshold, alert_storm_window_minutes,
+			team_id
+		)
+		VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9)
+	`)
+	s.update = p(`
+		UPDATE services
+		SET name = $2, description = $3, escalation_policy_id = $4, ack_timeout_minutes = $5, ack_timeout_limit = $6,
+			alert_storm_threshold = $7, alert_storm_window_minutes = $8, team_id = $9
+		WHERE id = $1
+	`)
 	s.delete = p(`DELETE FROM services WHERE id = any($1)`)
+	s.teamIDs = p(`SELECT array(SELECT DISTINCT coalesce(team_id::text, '') FROM services WHERE id = any($1))`)
 
 	return s, prep.Err
 }
@@ -139,7 +179,7 @@ func (db *DB) FindOneForUpdate(ctx context.Context, tx *sql.Tx, id string) (*Ser
 		return nil, err
 	}
 	var s Service
-	err = tx.StmtContext(ctx, db.findOneUp).QueryRowContext(ctx, id).Scan(&s.ID, &s.Name, &s.Description, &s.EscalationPolicyID)
+	err = tx.StmtContext(ctx, db.findOneUp).QueryRowContext(ctx, id).Scan(&s.ID, &s.Name, &s.Description, &s.EscalationPolicyID, &s.AckTimeoutMinutes, &s.AckTimeoutLimit, &s.AlertStormThreshold, &s.AlertStormWindowMinutes, &s.TeamID)
 	if err != nil {
 		return nil, err
 	}
@@ -182,12 +222,17 @@ func (db *DB) CreateServiceTx(ctx context.Context, tx *sql.Tx, s *Service) (*Ser
 		return nil, err
 	}
 
+	err = permission.LimitCheckOwner(ctx, n.TeamID)
+	if err != nil {
+		return nil, err
+	}
+
 	n.ID = uuid.New().String()
 	stmt := db.insert
 	if tx != nil {
 		stmt = tx.Stmt(stmt)
 	}
-	_, err = stmt.ExecContext(ctx, n.ID, n.Name, n.Description, n.EscalationPolicyID)
+	_, err = stmt.ExecContext(ctx, n.ID, n.Name, n.Description, n.EscalationPolicyID, n.AckTimeoutMinutes, n.AckTimeoutLimit, n.AlertStormThreshold, n.AlertStormWindowMinutes, n.teamID())
 	if err != nil {
 		return nil, err
 	}
@@ -211,6 +256,10 @@ func (db *DB) DeleteManyTx(ctx context.Context, tx *sql.Tx, ids []string) error
 	if err != nil {
 		return err
 	}
+	err = db.checkOwner(ctx, tx, ids)
+	if err != nil {
+		return err
+	}
 	s := db.delete
 	if tx != nil {
 		s = tx.StmtContext(ctx, s)
@@ -226,6 +275,18 @@ func wrap(tx *sql.Tx, s *sql.Stmt) *sql.Stmt {
 	return tx.Stmt(s)
 }
 
+// checkOwner will return an error if the context may not modify the given services, or
+// services owned by any of the extra team IDs.
+func (db *DB) checkOwner(ctx context.Context, tx *sql.Tx, ids []string, extraTeamIDs ...string) error {
+	var teamIDs sqlutil.StringArray
+	err := wrap(tx, db.teamIDs).QueryRowContext(ctx, sqlutil.UUIDArray(ids)).Scan(&teamIDs)
+	if err != nil {
+		return err
+	}
+
+	return permission.LimitCheckOwner(ctx, append(teamIDs, extraTeamIDs...)...)
+}
+
 // Update implements the ServiceStore interface.
 func (db *DB) Update(ctx context.Context, s *Service) error {
 	return db.UpdateTx(ctx, nil, s)
@@ -246,7 +307,12 @@ func (db *DB) UpdateTx(ctx context.Context, tx *sql.Tx, s *Service) error {
 		return err
 	}
 
-	_, err = wrap(tx, db.update).ExecContext(ctx, n.ID, n.Name, n.Description, n.EscalationPolicyID)
+	err = db.checkOwner(ctx, tx, []string{n.ID}, n.TeamID)
+	if err != nil {
+		return err
+	}
+
+	_, err = wrap(tx, db.update).ExecContext(ctx, n.ID, n.Name, n.Description, n.EscalationPolicyID, n.AckTimeoutMinutes, n.AckTimeoutLimit, n.AlertStormThreshold, n.AlertStormWindowMinutes, n.teamID())
 	return err
 }
 
@@ -290,7 +356,7 @@ func (db *DB) FindOne(ctx context.Context, id string) (*Service, error) {
 }
 
 func scanFrom(s *Service, f func(args ...interface{}) error) error {
-	return f(&s.ID, &s.Name, &s.Description, &s.EscalationPolicyID, &s.epName, &s.isUserFavorite)
+	return f(&s.ID, &s.Name, &s.Description, &s.EscalationPolicyID, &s.AckTimeoutMinutes, &s.AckTimeoutLimit, &s.AlertStormThreshold, &s.AlertStormWindowMinutes, &s.TeamID, &s.epName, &s.isUserFavorite)
 }
 
 func scanAllFrom(rows *sql.Rows) (services []Service, err error) {
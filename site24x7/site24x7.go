@@ -83,6 +83,20 @@ func Site24x7ToEventsAPI(aDB alert.Store, intDB integrationkey.Store) http.Handl
 			Dedup:     alert.NewUserDedup(r.FormValue("dedup")),
 		}
 
+		ctx, route, err := intDB.RouteAlert(ctx, integrationkey.RouteInput{
+			Summary: msg.Summary,
+			Details: msg.Details,
+			Meta:    map[string]string{"status": g.Status},
+		})
+		if errutil.HTTPError(ctx, w, errors.Wrap(err, "route alert")) {
+			return
+		}
+		if route.Drop() {
+			log.Logf(ctx, "alert dropped by routing rule")
+			return
+		}
+		msg.ServiceID = route.ServiceID
+
 		err = retry.DoTemporaryError(func(int) error {
 			_, err = aDB.CreateOrUpdate(ctx, msg)
 			return err
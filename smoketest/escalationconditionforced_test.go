@@ -0,0 +1,59 @@
+package smoketest
+
+import (
+	"testing"
+
+	"github.com/target/goalert/smoketest/harness"
+)
+
+// TestEscalationConditionForced ensures that manually escalating an alert moves it off of the current
+// step, even if the current step is the only one with a matching condition.
+func TestEscalationConditionForced(t *testing.T) {
+	t.Parallel()
+
+	sql := `
+	insert into users (id, name, email)
+	values
+		({{uuid "uid1"}}, 'bob', 'joe'),
+		({{uuid "uid2"}}, 'jane', 'xyz');
+	insert into user_contact_methods (id, user_id, name, type, value)
+	values
+		({{uuid "c1"}}, {{uuid "uid1"}}, 'personal', 'SMS', {{phone "1"}}),
+		({{uuid "c2"}}, {{uuid "uid2"}}, 'personal', 'SMS', {{phone "2"}});
+	insert into user_notification_rules (user_id, contact_method_id, delay_minutes)
+	values
+		({{uuid "uid1"}}, {{uuid "c1"}}, 0),
+		({{uuid "uid2"}}, {{uuid "c2"}}, 0);
+
+	insert into escalation_policies (id, name, repeat)
+	values
+		({{uuid "eid"}}, 'esc policy', -1);
+	insert into escalation_policy_steps (id, escalation_policy_id, delay, condition_summary_regex)
+	values
+		({{uuid "esid1"}}, {{uuid "eid"}}, 60, '^db-'),
+		({{uuid "esid2"}}, {{uuid "eid"}}, 60, '^web-');
+	insert into escalation_policy_actions (escalation_policy_step_id, user_id)
+	values
+		({{uuid "esid1"}}, {{uuid "uid1"}}),
+		({{uuid "esid2"}}, {{uuid "uid2"}});
+
+	insert into services (id, escalation_policy_id, name)
+	values
+		({{uuid "sid"}}, {{uuid "eid"}}, 'service');
+
+	insert into alerts (service_id, summary)
+	values
+		({{uuid "sid"}}, 'db-01 down');
+`
+	h := harness.NewHarness(t, sql, "escalation-step-conditions")
+	defer h.Close()
+
+	h.Twilio(t).Device(h.Phone("1")).ExpectSMS("db-01")
+	h.Twilio(t).WaitAndAssert()
+
+	h.Escalate(1, 0)
+
+	// the second step doesn't match, but forced escalations fall back to the next step by position
+	h.Twilio(t).Device(h.Phone("2")).ExpectSMS("db-01")
+	h.Twilio(t).WaitAndAssert()
+}
@@ -0,0 +1,113 @@
+package smoketest
+
+import (
+	"testing"
+
+	"github.com/target/goalert/smoketest/harness"
+)
+
+// TestEscalationSafetyNetFailed checks that, with the safety net enabled, a step is escalated
+// immediately once all of its notifications have failed.
+func TestEscalationSafetyNetFailed(t *testing.T) {
+	t.Parallel()
+
+	sql := `
+	insert into users (id, name, email)
+	values
+		({{uuid "user1"}}, 'bob', 'joe'),
+		({{uuid "user2"}}, 'ben', 'josh');
+	insert into user_contact_methods (id, user_id, name, type, value)
+	values
+		({{uuid "cm1"}}, {{uuid "user1"}}, 'personal', 'SMS', {{phone "1"}}),
+		({{uuid "cm2"}}, {{uuid "user2"}}, 'personal', 'SMS', {{phone "2"}});
+
+	insert into user_notification_rules (user_id, contact_method_id, delay_minutes)
+	values
+		({{uuid "user1"}}, {{uuid "cm1"}}, 0),
+		({{uuid "user2"}}, {{uuid "cm2"}}, 0);
+
+	insert into escalation_policies (id, name)
+	values
+		({{uuid "eid"}}, 'esc policy');
+	insert into escalation_policy_steps (id, escalation_policy_id, delay)
+	values
+		({{uuid "esid1"}}, {{uuid "eid"}}, 60),
+		({{uuid "esid2"}}, {{uuid "eid"}}, 60);
+	insert into escalation_policy_actions (escalation_policy_step_id, user_id)
+	values
+		({{uuid "esid1"}}, {{uuid "user1"}}),
+		({{uuid "esid2"}}, {{uuid "user2"}});
+
+	insert into services (id, escalation_policy_id, name)
+	values
+		({{uuid "sid"}}, {{uuid "eid"}}, 'service');
+`
+	h := harness.NewHarness(t, sql, "escalation-safety-net-failed")
+	defer h.Close()
+
+	h.SetConfigValue("SafetyNet.Enable", "true")
+	h.CreateAlert(h.UUID("sid"), "testing")
+
+	tw := h.Twilio(t)
+	tw.Device(h.Phone("1")).RejectSMS("testing")
+
+	// step 1 failed, so step 2 should be notified without waiting for the step delay
+	tw.Device(h.Phone("2")).ExpectSMS("testing")
+}
+
+// TestEscalationSafetyNetPolicyFailed checks that the safety net is notified once the final step
+// is reached without any successful notification, even though every step has users configured.
+func TestEscalationSafetyNetPolicyFailed(t *testing.T) {
+	t.Parallel()
+
+	sql := `
+	insert into users (id, name, email, role)
+	values
+		({{uuid "user1"}}, 'bob', 'joe', 'user'),
+		({{uuid "user2"}}, 'ben', 'josh', 'user'),
+		({{uuid "admin"}}, 'alice', 'jane', 'admin');
+	insert into user_contact_methods (id, user_id, name, type, value)
+	values
+		({{uuid "cm1"}}, {{uuid "user1"}}, 'personal', 'SMS', {{phone "1"}}),
+		({{uuid "cm2"}}, {{uuid "user2"}}, 'personal', 'SMS', {{phone "2"}}),
+		({{uuid "cm3"}}, {{uuid "admin"}}, 'personal', 'SMS', {{phone "3"}});
+
+	insert into user_notification_rules (user_id, contact_method_id, delay_minutes)
+	values
+		({{uuid "user1"}}, {{uuid "cm1"}}, 0),
+		({{uuid "user2"}}, {{uuid "cm2"}}, 0),
+		({{uuid "admin"}}, {{uuid "cm3"}}, 0);
+
+	insert into escalation_policies (id, name)
+	values
+		({{uuid "eid"}}, 'esc policy');
+	insert into escalation_policy_steps (id, escalation_policy_id, delay)
+	values
+		({{uuid "esid1"}}, {{uuid "eid"}}, 60),
+		({{uuid "esid2"}}, {{uuid "eid"}}, 60);
+	insert into escalation_policy_actions (escalation_policy_step_id, user_id)
+	values
+		({{uuid "esid1"}}, {{uuid "user1"}}),
+		({{uuid "esid2"}}, {{uuid "user2"}});
+
+	insert into services (id, escalation_policy_id, name)
+	values
+		({{uuid "sid"}}, {{uuid "eid"}}, 'service');
+`
+	h := harness.NewHarness(t, sql, "escalation-safety-net-outcome")
+	defer h.Close()
+
+	h.SetConfigValue("SafetyNet.Enable", "true")
+	h.SetConfigValue("SafetyNet.NotifyAdmins", "true")
+	h.CreateAlert(h.UUID("sid"), "testing")
+
+	tw := h.Twilio(t)
+	tw.Device(h.Phone("1")).RejectSMS("testing")
+	tw.WaitAndAssert()
+
+	// the final step must have failed too before the safety net is notified
+	tw.Device(h.Phone("2")).RejectSMS("testing")
+	tw.WaitAndAssert()
+
+	tw.Device(h.Phone("3")).ExpectSMS("testing")
+}
@@ -0,0 +1,130 @@
+package smoketest
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/target/goalert/smoketest/harness"
+)
+
+// TestEscalationSequential checks that users of a sequential step are notified one at a time,
+// in the configured target order.
+func TestEscalationSequential(t *testing.T) {
+	t.Parallel()
+
+	sql := `
+	insert into users (id, name, email)
+	values
+		({{uuid "user1"}}, 'bob', 'joe'),
+		({{uuid "user2"}}, 'alice', 'jane');
+	insert into user_contact_methods (id, user_id, name, type, value)
+	values
+		({{uuid "cm1"}}, {{uuid "user1"}}, 'personal', 'SMS', {{phone "1"}}),
+		({{uuid "cm2"}}, {{uuid "user2"}}, 'personal', 'SMS', {{phone "2"}});
+
+	insert into user_notification_rules (user_id, contact_method_id, delay_minutes)
+	values
+		({{uuid "user1"}}, {{uuid "cm1"}}, 0),
+		({{uuid "user2"}}, {{uuid "cm2"}}, 0);
+
+	insert into escalation_policies (id, name)
+	values
+		({{uuid "eid"}}, 'esc policy');
+	insert into escalation_policy_steps (id, escalation_policy_id, delay, target_mode, sequential_delay_minutes)
+	values
+		({{uuid "esid"}}, {{uuid "eid"}}, 30, 'sequential', 5);
+	insert into escalation_policy_actions (escalation_policy_step_id, user_id, position)
+	values
+		({{uuid "esid"}}, {{uuid "user1"}}, 0),
+		({{uuid "esid"}}, {{uuid "user2"}}, 1);
+
+	insert into services (id, escalation_policy_id, name)
+	values
+		({{uuid "sid"}}, {{uuid "eid"}}, 'service');
+`
+	h := harness.NewHarness(t, sql, "escalation-step-target-position")
+	defer h.Close()
+
+	// the simulator must agree with the engine on the notification order
+	resp := h.GraphQLQueryT(t, fmt.Sprintf(`query{simulateEscalationPolicy(input: {escalationPolicyID: "%s", start: "%s"}){user{id}}}`,
+		h.UUID("eid"), time.Now().Format(time.RFC3339)))
+	for _, err := range resp.Errors {
+		t.Error("GraphQL Error:", err.Message)
+	}
+	require.Empty(t, resp.Errors, "errors returned from GraphQL")
+	var data struct {
+		SimulateEscalationPolicy []struct {
+			User struct{ ID string }
+		}
+	}
+	require.NoError(t, json.Unmarshal(resp.Data, &data))
+	require.NotEmpty(t, data.SimulateEscalationPolicy, "simulated events")
+	assert.Equal(t, h.UUID("user1"), data.SimulateEscalationPolicy[0].User.ID, "first simulated user")
+
+	h.CreateAlert(h.UUID("sid"), "testing")
+
+	tw := h.Twilio(t)
+	d1 := tw.Device(h.Phone("1"))
+	d2 := tw.Device(h.Phone("2"))
+
+	// bob is first by position, even though alice is first by name
+	d1.ExpectSMS("testing")
+	tw.WaitAndAssert()
+
+	h.FastForward(5 * time.Minute)
+	d2.ExpectSMS("testing")
+}
+
+// TestEscalationRoundRobin checks that a round-robin step notifies a single user per alert,
+// rotating between alerts in the configured target order.
+func TestEscalationRoundRobin(t *testing.T) {
+	t.Parallel()
+
+	sql := `
+	insert into users (id, name, email)
+	values
+		({{uuid "user1"}}, 'bob', 'joe'),
+		({{uuid "user2"}}, 'alice', 'jane');
+	insert into user_contact_methods (id, user_id, name, type, value)
+	values
+		({{uuid "cm1"}}, {{uuid "user1"}}, 'personal', 'SMS', {{phone "1"}}),
+		({{uuid "cm2"}}, {{uuid "user2"}}, 'personal', 'SMS', {{phone "2"}});
+
+	insert into user_notification_rules (user_id, contact_method_id, delay_minutes)
+	values
+		({{uuid "user1"}}, {{uuid "cm1"}}, 0),
+		({{uuid "user2"}}, {{uuid "cm2"}}, 0);
+
+	insert into escalation_policies (id, name)
+	values
+		({{uuid "eid"}}, 'esc policy');
+	insert into escalation_policy_steps (id, escalation_policy_id, delay, target_mode)
+	values
+		({{uuid "esid"}}, {{uuid "eid"}}, 30, 'round_robin');
+	insert into escalation_policy_actions (escalation_policy_step_id, user_id, position)
+	values
+		({{uuid "esid"}}, {{uuid "user1"}}, 0),
+		({{uuid "esid"}}, {{uuid "user2"}}, 1);
+
+	insert into services (id, escalation_policy_id, name)
+	values
+		({{uuid "sid"}}, {{uuid "eid"}}, 'service');
+`
+	h := harness.NewHarness(t, sql, "escalation-step-target-position")
+	defer h.Close()
+
+	tw := h.Twilio(t)
+	d1 := tw.Device(h.Phone("1"))
+	d2 := tw.Device(h.Phone("2"))
+
+	h.CreateAlert(h.UUID("sid"), "first")
+	d1.ExpectSMS("first")
+	tw.WaitAndAssert()
+
+	h.CreateAlert(h.UUID("sid"), "second")
+	d2.ExpectSMS("second")
+}
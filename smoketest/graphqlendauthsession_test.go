@@ -0,0 +1,67 @@
+package smoketest
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/target/goalert/smoketest/harness"
+)
+
+// TestGraphQLEndAuthSession tests that a non-admin user can end their own sessions by ID,
+// but not the sessions of other users.
+func TestGraphQLEndAuthSession(t *testing.T) {
+	t.Parallel()
+
+	const sql = `
+	insert into users (id, name, email, role)
+	values
+		({{uuid "user"}}, 'bob', 'joe', 'user'),
+		({{uuid "other"}}, 'alice', 'jane', 'user');
+
+	insert into auth_user_sessions (id, user_agent, user_id)
+	values
+		({{uuid "sess"}}, 'old browser', {{uuid "user"}}),
+		({{uuid "otherSess"}}, 'old browser', {{uuid "other"}});
+	`
+
+	h := harness.NewHarness(t, sql, "integration-key-usage")
+	defer h.Close()
+
+	doQL := func(userID, query string) {
+		t.Helper()
+		resp := h.GraphQLQueryUserT(t, userID, query)
+		for _, err := range resp.Errors {
+			t.Error("GraphQL Error:", err.Message)
+		}
+		require.Empty(t, resp.Errors, "errors returned from GraphQL")
+	}
+	sessions := func(userID string) []string {
+		t.Helper()
+		resp := h.GraphQLQueryT(t, fmt.Sprintf(`query{user(id: "%s"){sessions{id}}}`, userID))
+		for _, err := range resp.Errors {
+			t.Error("GraphQL Error:", err.Message)
+		}
+		require.Empty(t, resp.Errors, "errors returned from GraphQL")
+
+		var data struct {
+			User struct {
+				Sessions []struct{ ID string }
+			}
+		}
+		require.NoError(t, json.Unmarshal(resp.Data, &data))
+		var ids []string
+		for _, s := range data.User.Sessions {
+			ids = append(ids, s.ID)
+		}
+		return ids
+	}
+
+	doQL(h.UUID("user"), fmt.Sprintf(`mutation{endAuthSession(id: "%s")}`, h.UUID("otherSess")))
+	assert.Contains(t, sessions(h.UUID("other")), h.UUID("otherSess"), "other user's session should remain")
+
+	doQL(h.UUID("user"), fmt.Sprintf(`mutation{endAuthSession(id: "%s")}`, h.UUID("sess")))
+	assert.NotContains(t, sessions(h.UUID("user")), h.UUID("sess"), "own session should be ended")
+}
@@ -0,0 +1,79 @@
+package smoketest
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/target/goalert/smoketest/harness"
+)
+
+// TestGraphQLEscalationTemplateDelete tests that escalation policy templates may only be deleted
+// by the user that created them, members of the owning team, or an admin.
+func TestGraphQLEscalationTemplateDelete(t *testing.T) {
+	t.Parallel()
+
+	const sql = `
+	insert into users (id, name, email, role)
+	values
+		({{uuid "creator"}}, 'bob', 'joe', 'user'),
+		({{uuid "member"}}, 'alice', 'jane', 'user'),
+		({{uuid "other"}}, 'carl', 'carl', 'user');
+
+	insert into teams (id, name)
+	values ({{uuid "team"}}, 'team');
+
+	insert into team_members (team_id, user_id)
+	values ({{uuid "team"}}, {{uuid "member"}});
+
+	insert into escalation_policy_templates (id, name, created_by_user_id)
+	values ({{uuid "userTmpl"}}, 'user template', {{uuid "creator"}});
+
+	insert into escalation_policy_templates (id, name, team_id, created_by_user_id)
+	values ({{uuid "teamTmpl"}}, 'team template', {{uuid "team"}}, {{uuid "creator"}});
+
+	insert into escalation_policy_templates (id, name)
+	values ({{uuid "legacyTmpl"}}, 'legacy template');
+	`
+
+	h := harness.NewHarness(t, sql, "escalation-policy-template-owner")
+	defer h.Close()
+
+	deleteTmpl := func(userID, id string) error {
+		t.Helper()
+		resp := h.GraphQLQueryUserT(t, userID, fmt.Sprintf(`mutation{deleteEscalationPolicyTemplates(ids: ["%s"])}`, id))
+		if len(resp.Errors) > 0 {
+			return fmt.Errorf("%s", resp.Errors[0].Message)
+		}
+		return nil
+	}
+	templateIDs := func() []string {
+		t.Helper()
+		resp := h.GraphQLQueryT(t, `query{escalationPolicyTemplates{id}}`)
+		for _, err := range resp.Errors {
+			t.Error("GraphQL Error:", err.Message)
+		}
+		require.Empty(t, resp.Errors, "errors returned from GraphQL")
+
+		var data struct {
+			EscalationPolicyTemplates []struct{ ID string }
+		}
+		require.NoError(t, json.Unmarshal(resp.Data, &data))
+		var ids []string
+		for _, tmpl := range data.EscalationPolicyTemplates {
+			ids = append(ids, tmpl.ID)
+		}
+		return ids
+	}
+
+	assert.Error(t, deleteTmpl(h.UUID("other"), h.UUID("userTmpl")), "non-owner delete should be rejected")
+	assert.Error(t, deleteTmpl(h.UUID("other"), h.UUID("teamTmpl")), "non-member delete should be rejected")
+	assert.Error(t, deleteTmpl(h.UUID("other"), h.UUID("legacyTmpl")), "non-admin delete of an unowned template should be rejected")
+	assert.ElementsMatch(t, []string{h.UUID("userTmpl"), h.UUID("teamTmpl"), h.UUID("legacyTmpl")}, templateIDs())
+
+	assert.NoError(t, deleteTmpl(h.UUID("creator"), h.UUID("userTmpl")), "creator delete")
+	assert.NoError(t, deleteTmpl(h.UUID("member"), h.UUID("teamTmpl")), "team member delete")
+	assert.ElementsMatch(t, []string{h.UUID("legacyTmpl")}, templateIDs())
+}
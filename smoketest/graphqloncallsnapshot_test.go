@@ -0,0 +1,76 @@
+package smoketest
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/target/goalert/smoketest/harness"
+)
+
+// TestGraphQLOnCallSnapshot tests that rotation users in an on-call snapshot are resolved
+// from the recorded step history, rather than the current rotation state.
+func TestGraphQLOnCallSnapshot(t *testing.T) {
+	t.Parallel()
+
+	const sql = `
+	insert into users (id, name, email)
+	values
+		({{uuid "u1"}}, 'bob', 'joe'),
+		({{uuid "u2"}}, 'alice', 'jane');
+
+	insert into rotations (id, name, description, type, start_time, time_zone)
+	values
+		({{uuid "rot"}}, 'rot', 'test', 'weekly', now() - '1 day'::interval, 'UTC');
+
+	insert into rotation_participants (id, rotation_id, user_id, position)
+	values
+		({{uuid ""}}, {{uuid "rot"}}, {{uuid "u1"}}, 0),
+		({{uuid ""}}, {{uuid "rot"}}, {{uuid "u2"}}, 1);
+
+	insert into escalation_policies (id, name)
+	values
+		({{uuid "eid"}}, 'esc policy');
+	insert into escalation_policy_steps (id, escalation_policy_id)
+	values
+		({{uuid "esid"}}, {{uuid "eid"}});
+	insert into escalation_policy_actions (escalation_policy_step_id, rotation_id)
+	values
+		({{uuid "esid"}}, {{uuid "rot"}});
+
+	-- alice was on call earlier, e.g., before the rotation was manually advanced
+	insert into ep_step_on_call_users (ep_step_id, user_id, start_time, end_time)
+	values
+		({{uuid "esid"}}, {{uuid "u2"}}, now() - '3 hours'::interval, now() - '1 hour'::interval);
+	`
+
+	h := harness.NewHarness(t, sql, "escalation-step-target-position")
+	defer h.Close()
+
+	resp := h.GraphQLQueryT(t, fmt.Sprintf(`query{onCallSnapshot(input: {escalationPolicyID: "%s", time: "%s"}){
+		steps{ users{id} targets{ users{id} } }
+	}}`, h.UUID("eid"), time.Now().Add(-2*time.Hour).Format(time.RFC3339)))
+	for _, err := range resp.Errors {
+		t.Error("GraphQL Error:", err.Message)
+	}
+	require.Empty(t, resp.Errors, "errors returned from GraphQL")
+
+	type users []struct{ ID string }
+	var data struct {
+		OnCallSnapshot struct {
+			Steps []struct {
+				Users   users
+				Targets []struct{ Users users }
+			}
+		}
+	}
+	require.NoError(t, json.Unmarshal(resp.Data, &data))
+	require.Len(t, data.OnCallSnapshot.Steps, 1)
+	step := data.OnCallSnapshot.Steps[0]
+	assert.Equal(t, users{{ID: h.UUID("u2")}}, step.Users)
+	require.Len(t, step.Targets, 1)
+	assert.Equal(t, users{{ID: h.UUID("u2")}}, step.Targets[0].Users)
+}
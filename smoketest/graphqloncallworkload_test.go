@@ -0,0 +1,116 @@
+package smoketest
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/target/goalert/smoketest/harness"
+)
+
+// TestGraphQLOnCallWorkload tests that the workload report is based on the recorded on-call
+// history (not projected shifts), and that pages are attributed to schedules from that
+// history rather than the current escalation policy configuration.
+func TestGraphQLOnCallWorkload(t *testing.T) {
+	t.Parallel()
+
+	const sql = `
+	insert into users (id, name, email)
+	values
+		({{uuid "u1"}}, 'bob', 'joe'),
+		({{uuid "u2"}}, 'alice', 'jane');
+
+	insert into schedules (id, name, time_zone)
+	values
+		({{uuid "s1"}}, 'sched 1', 'UTC'),
+		({{uuid "s2"}}, 'sched 2', 'UTC');
+
+	insert into schedule_on_call_users (schedule_id, user_id, start_time, end_time)
+	values
+		({{uuid "s1"}}, {{uuid "u1"}}, now() - '5 hours'::interval, now() - '3 hours'::interval),
+		({{uuid "s2"}}, {{uuid "u1"}}, now() - '3 hours'::interval, now() - '2 hours'::interval),
+		({{uuid "s2"}}, {{uuid "u2"}}, now() - '1 hour'::interval, null);
+
+	insert into escalation_policies (id, name)
+	values
+		({{uuid "e1"}}, 'esc policy 1'),
+		({{uuid "e2"}}, 'esc policy 2');
+	insert into escalation_policy_steps (id, escalation_policy_id)
+	values
+		({{uuid "es1"}}, {{uuid "e1"}}),
+		({{uuid "es2"}}, {{uuid "e2"}});
+	insert into escalation_policy_actions (escalation_policy_step_id, schedule_id)
+	values
+		({{uuid "es1"}}, {{uuid "s1"}}),
+		({{uuid "es2"}}, {{uuid "s2"}});
+
+	insert into services (id, escalation_policy_id, name)
+	values
+		({{uuid "svc1"}}, {{uuid "e1"}}, 'service 1'),
+		({{uuid "svc2"}}, {{uuid "e2"}}, 'service 2');
+
+	insert into alerts (id, service_id, status, summary)
+	values
+		(1, {{uuid "svc1"}}, 'closed', 'one'),
+		(2, {{uuid "svc2"}}, 'closed', 'two'),
+		(3, {{uuid "svc1"}}, 'closed', 'three');
+
+	insert into alert_logs (alert_id, event, sub_user_id, sub_type, message, timestamp)
+	values
+		(1, 'notification_sent', {{uuid "u1"}}, 'user', '', now() - '4 hours'::interval),
+		(2, 'notification_sent', {{uuid "u1"}}, 'user', '', now() - '150 minutes'::interval),
+		(3, 'notification_sent', {{uuid "u1"}}, 'user', '', now() - '90 minutes'::interval);
+	`
+
+	h := harness.NewHarness(t, sql, "escalation-step-target-position")
+	defer h.Close()
+
+	type workload struct {
+		UserID        string
+		OnCallHours   float64
+		PagesReceived int
+	}
+	report := func(schedIDs string) []workload {
+		t.Helper()
+		resp := h.GraphQLQueryT(t, fmt.Sprintf(`query{onCallWorkloadReport(input: {start: "%s", end: "%s", scheduleIDs: [%s]}){
+			userID onCallHours pagesReceived
+		}}`, time.Now().Add(-24*time.Hour).Format(time.RFC3339), time.Now().Add(24*time.Hour).Format(time.RFC3339), schedIDs))
+		for _, err := range resp.Errors {
+			t.Error("GraphQL Error:", err.Message)
+		}
+		require.Empty(t, resp.Errors, "errors returned from GraphQL")
+
+		var data struct{ OnCallWorkloadReport []workload }
+		require.NoError(t, json.Unmarshal(resp.Data, &data))
+		return data.OnCallWorkloadReport
+	}
+
+	byUser := func(w []workload) map[string]workload {
+		m := make(map[string]workload, len(w))
+		for _, u := range w {
+			m[u.UserID] = u
+		}
+		return m
+	}
+
+	all := byUser(report(""))
+	require.Len(t, all, 2)
+	assert.InDelta(t, 3, all[h.UUID("u1")].OnCallHours, 0.1)
+	assert.Equal(t, 3, all[h.UUID("u1")].PagesReceived)
+	assert.InDelta(t, 1, all[h.UUID("u2")].OnCallHours, 0.1, "current shift counted until now, not the end of the report")
+
+	// pages are attributed by on-call history, so editing the policy must not change past reports
+	resp := h.GraphQLQueryT(t, fmt.Sprintf(`mutation{updateEscalationPolicyStep(input: {id: "%s", targets: []})}`, h.UUID("es1")))
+	for _, err := range resp.Errors {
+		t.Error("GraphQL Error:", err.Message)
+	}
+	require.Empty(t, resp.Errors, "errors returned from GraphQL")
+
+	sched1 := byUser(report(fmt.Sprintf(`"%s"`, h.UUID("s1"))))
+	require.Contains(t, sched1, h.UUID("u1"))
+	assert.InDelta(t, 2, sched1[h.UUID("u1")].OnCallHours, 0.1)
+	assert.Equal(t, 1, sched1[h.UUID("u1")].PagesReceived, "only pages sent while on call for the schedule")
+}
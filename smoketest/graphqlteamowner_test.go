@@ -0,0 +1,82 @@
+package smoketest
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/target/goalert/smoketest/harness"
+)
+
+// TestGraphQLTeamOwner ensures that only members of the owning team may modify integration keys
+// (including rotating secrets), heartbeat monitors, labels, and overrides of team-owned services
+// and schedules, or redirect alerts to team-owned services.
+func TestGraphQLTeamOwner(t *testing.T) {
+	t.Parallel()
+
+	const sql = `
+	insert into users (id, name, email, role)
+	values
+		({{uuid "member"}}, 'bob', 'joe', 'user'),
+		({{uuid "outsider"}}, 'jane', 'xyz', 'user');
+
+	insert into teams (id, name)
+	values ({{uuid "team"}}, 'team');
+	insert into team_members (team_id, user_id)
+	values ({{uuid "team"}}, {{uuid "member"}});
+
+	insert into escalation_policies (id, name)
+	values ({{uuid "eid"}}, 'esc policy');
+	insert into services (id, escalation_policy_id, name, team_id)
+	values ({{uuid "sid"}}, {{uuid "eid"}}, 'service', {{uuid "team"}});
+	insert into schedules (id, name, time_zone, team_id)
+	values ({{uuid "schedID"}}, 'schedule', 'UTC', {{uuid "team"}});
+
+	insert into services (id, escalation_policy_id, name)
+	values ({{uuid "otherSvc"}}, {{uuid "eid"}}, 'other service');
+
+	insert into integration_keys (id, type, name, service_id)
+	values
+		({{uuid "intKey"}}, 'generic', 'key', {{uuid "sid"}}),
+		({{uuid "rotateKey"}}, 'generic', 'key', {{uuid "sid"}}),
+		({{uuid "otherKey"}}, 'generic', 'key', {{uuid "otherSvc"}});
+`
+	h := harness.NewHarness(t, sql, "teams")
+	defer h.Close()
+
+	start := time.Now().Add(time.Hour).UTC().Format(time.RFC3339)
+	end := time.Now().Add(2 * time.Hour).UTC().Format(time.RFC3339)
+	mutations := map[string]string{
+		"create integration key": fmt.Sprintf(`mutation{createIntegrationKey(input:{serviceID: "%s", type: generic, name: "%%s"}){id}}`, h.UUID("sid")),
+		"delete integration key": fmt.Sprintf(`mutation{deleteAll(input:[{type: integrationKey, id: "%s"}])} # %%s`, h.UUID("intKey")),
+		"rotate integration key": fmt.Sprintf(`mutation{rotateIntegrationKey(input:{id: "%s"}){id}} # %%s`, h.UUID("rotateKey")),
+		"set key expiration":     fmt.Sprintf(`mutation{setIntegrationKeyExpiration(input:{id: "%s", expiresAt: "%s"})} # %%s`, h.UUID("rotateKey"), end),
+		"create heartbeat":       fmt.Sprintf(`mutation{createHeartbeatMonitor(input:{serviceID: "%s", name: "%%s", timeoutMinutes: 5}){id}}`, h.UUID("sid")),
+		"set label":              fmt.Sprintf(`mutation{setLabel(input:{target:{type: service, id: "%s"}, key: "foo/%%s", value: "bar"})}`, h.UUID("sid")),
+		"create override":        fmt.Sprintf(`mutation{createUserOverride(input:{scheduleID: "%s", addUserID: "%s", start: "%s", end: "%s"}){id}} # %%s`, h.UUID("schedID"), h.UUID("member"), start, end),
+	}
+
+	redirect := func(userID, svcID string) []string {
+		t.Helper()
+		resp := h.GraphQLQueryUserT(t, userID, fmt.Sprintf(`mutation{setIntegrationKeyRoutingRules(input:{integrationKeyID: "%s", rules: [{name: "redirect", action: redirect, serviceID: "%s"}]})}`, h.UUID("otherKey"), svcID))
+		var errs []string
+		for _, err := range resp.Errors {
+			errs = append(errs, err.Message)
+		}
+		return errs
+	}
+	assert.NotEmpty(t, redirect(h.UUID("outsider"), h.UUID("sid")), "outsider should not be allowed to redirect to a team-owned service")
+	assert.NotEmpty(t, redirect(h.UUID("outsider"), "00000000-0000-0000-0000-000000000000"), "redirect to missing service")
+	assert.Empty(t, redirect(h.UUID("member"), h.UUID("sid")), "team member should be allowed to redirect to a team-owned service")
+
+	for name, m := range mutations {
+		resp := h.GraphQLQueryUserT(t, h.UUID("outsider"), fmt.Sprintf(m, "outsider"))
+		assert.NotEmptyf(t, resp.Errors, "%s: outsider should not be allowed", name)
+	}
+
+	for name, m := range mutations {
+		resp := h.GraphQLQueryUserT(t, h.UUID("member"), fmt.Sprintf(m, "member"))
+		assert.Emptyf(t, resp.Errors, "%s: team member should be allowed", name)
+	}
+}
@@ -38,7 +38,7 @@ func (h *Harness) insertGraphQLUser(userID string) string {
 		}
 	}
 
-	tok, err := h.backend.AuthHandler.CreateSession(context.Background(), "goalert-smoketest", userID)
+	tok, err := h.backend.AuthHandler.CreateSession(context.Background(), "goalert-smoketest", userID, "")
 	if err != nil {
 		h.t.Fatal(errors.Wrap(err, "create auth session"))
 	}
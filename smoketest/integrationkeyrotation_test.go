@@ -0,0 +1,37 @@
+package smoketest
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/target/goalert/smoketest/harness"
+)
+
+// TestIntegrationKeyRotation ensures a key can't be rotated with a grace period while the
+// previous secret is still accepted, but may be rotated without one.
+func TestIntegrationKeyRotation(t *testing.T) {
+	t.Parallel()
+
+	const sql = `
+	insert into escalation_policies (id, name)
+	values ({{uuid "eid"}}, 'esc policy');
+	insert into services (id, escalation_policy_id, name)
+	values ({{uuid "sid"}}, {{uuid "eid"}}, 'service');
+
+	insert into integration_keys (id, type, name, service_id)
+	values ({{uuid "intKey"}}, 'generic', 'key', {{uuid "sid"}});
+`
+	h := harness.NewHarness(t, sql, "integration-key-usage")
+	defer h.Close()
+
+	rotate := func(grace int) *harness.QLResponse {
+		t.Helper()
+		return h.GraphQLQuery2(fmt.Sprintf(`mutation{rotateIntegrationKey(input:{id: "%s", gracePeriodHours: %d}){id}}`, h.UUID("intKey"), grace))
+	}
+
+	assert.Empty(t, rotate(24).Errors, "first rotation")
+	assert.NotEmpty(t, rotate(24).Errors, "rotation during grace period")
+	assert.Empty(t, rotate(0).Errors, "rotation without grace period")
+	assert.Empty(t, rotate(24).Errors, "rotation after revoking previous secret")
+}
@@ -0,0 +1,141 @@
+package smoketest
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/target/goalert/smoketest/harness"
+)
+
+// TestSCIMUsers tests SCIM user listing and deactivation.
+func TestSCIMUsers(t *testing.T) {
+	t.Parallel()
+
+	const sql = `
+	insert into users (id, name, email)
+	values
+		({{uuid "u1"}}, 'bob', 'joe');
+	insert into scim_users (user_id, user_name, external_id)
+	values
+		({{uuid "u1"}}, 'bob', 'ext-bob');
+
+	insert into user_contact_methods (id, user_id, name, type, value, disabled)
+	values
+		({{uuid "cm1"}}, {{uuid "u1"}}, 'personal', 'SMS', {{phone "1"}}, false),
+		({{uuid "cm2"}}, {{uuid "u1"}}, 'stopped', 'SMS', {{phone "2"}}, true);
+
+	insert into escalation_policies (id, name, fallback_user_id)
+	values
+		({{uuid "eid"}}, 'esc policy', {{uuid "u1"}});
+	insert into escalation_policy_steps (id, escalation_policy_id)
+	values
+		({{uuid "esid"}}, {{uuid "eid"}});
+	insert into escalation_policy_actions (escalation_policy_step_id, user_id)
+	values
+		({{uuid "esid"}}, {{uuid "u1"}});
+
+	insert into schedules (id, name, time_zone)
+	values
+		({{uuid "sid"}}, 'sched', 'UTC');
+	insert into schedule_rules (id, schedule_id, sunday, monday, tuesday, wednesday, thursday, friday, saturday, start_time, end_time, tgt_user_id)
+	values
+		({{uuid "rule"}}, {{uuid "sid"}}, true, true, true, true, true, true, true, '00:00:00', '00:00:00', {{uuid "u1"}});
+	`
+
+	h := harness.NewHarness(t, sql, "user-deactivation-contact-methods")
+	defer h.Close()
+
+	h.SetConfigValue("SCIM.Enable", "true")
+
+	resp := h.GraphQLQueryT(t, `mutation{createAPIKey(input: {name: "scim", serviceAccount: true, scope: ADMIN}){token}}`)
+	for _, err := range resp.Errors {
+		t.Error("GraphQL Error:", err.Message)
+	}
+	require.Empty(t, resp.Errors, "errors returned from GraphQL")
+	var keyData struct{ CreateAPIKey struct{ Token string } }
+	require.NoError(t, json.Unmarshal(resp.Data, &keyData))
+
+	do := func(method, path string, body interface{}, res interface{}) {
+		t.Helper()
+		var buf bytes.Buffer
+		if body != nil {
+			require.NoError(t, json.NewEncoder(&buf).Encode(body))
+		}
+		req, err := http.NewRequest(method, h.URL()+"/api/v2/scim/v2"+path, &buf)
+		require.NoError(t, err)
+		req.Header.Set("Authorization", "Bearer "+keyData.CreateAPIKey.Token)
+		req.Header.Set("Content-Type", "application/scim+json")
+		r, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer r.Body.Close()
+		require.Equal(t, http.StatusOK, r.StatusCode, "%s %s", method, path)
+		if res != nil {
+			require.NoError(t, json.NewDecoder(r.Body).Decode(res))
+		}
+	}
+
+	var list struct {
+		TotalResults int
+		Resources    []interface{}
+	}
+	do("GET", "/Users?count=0", nil, &list)
+	assert.Equal(t, 1, list.TotalResults, "total should not depend on count")
+	assert.Empty(t, list.Resources)
+
+	do("GET", "/Users?startIndex=5", nil, &list)
+	assert.Equal(t, 1, list.TotalResults, "total should not depend on startIndex")
+
+	setActive := func(active bool) {
+		t.Helper()
+		do("PATCH", "/Users/"+h.UUID("u1"), map[string]interface{}{
+			"schemas":    []string{"urn:ietf:params:scim:api:messages:2.0:PatchOp"},
+			"Operations": []map[string]interface{}{{"op": "replace", "path": "active", "value": active}},
+		}, nil)
+	}
+
+	setActive(false)
+
+	resp = h.GraphQLQueryT(t, `query{
+		escalationPolicy(id: "`+h.UUID("eid")+`"){ steps{ targets{id} } }
+		schedule(id: "`+h.UUID("sid")+`"){ targets{ rules{id} } }
+	}`)
+	for _, err := range resp.Errors {
+		t.Error("GraphQL Error:", err.Message)
+	}
+	require.Empty(t, resp.Errors, "errors returned from GraphQL")
+	var data struct {
+		EscalationPolicy struct {
+			Steps []struct{ Targets []struct{ ID string } }
+		}
+		Schedule struct {
+			Targets []struct{ Rules []struct{ ID string } }
+		}
+	}
+	require.NoError(t, json.Unmarshal(resp.Data, &data))
+	require.Len(t, data.EscalationPolicy.Steps, 1)
+	assert.Empty(t, data.EscalationPolicy.Steps[0].Targets, "user should be removed from escalation policy steps")
+	for _, tgt := range data.Schedule.Targets {
+		assert.Empty(t, tgt.Rules, "user should be removed from schedule rules")
+	}
+
+	setActive(true)
+
+	resp = h.GraphQLQueryT(t, `query{
+		cm1: userContactMethod(id: "`+h.UUID("cm1")+`"){ disabled }
+		cm2: userContactMethod(id: "`+h.UUID("cm2")+`"){ disabled }
+	}`)
+	for _, err := range resp.Errors {
+		t.Error("GraphQL Error:", err.Message)
+	}
+	require.Empty(t, resp.Errors, "errors returned from GraphQL")
+	var cms struct {
+		CM1, CM2 struct{ Disabled bool }
+	}
+	require.NoError(t, json.Unmarshal(resp.Data, &cms))
+	assert.False(t, cms.CM1.Disabled, "contact method disabled by deactivation should be re-enabled")
+	assert.True(t, cms.CM2.Disabled, "contact method disabled before deactivation should stay disabled")
+}
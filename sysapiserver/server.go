@@ -3,13 +3,16 @@ package sysapiserver
 import (
 	"context"
 
+	"github.com/target/goalert/apikey"
 	"github.com/target/goalert/permission"
 	"github.com/target/goalert/pkg/sysapi"
 	"github.com/target/goalert/user"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type Server struct {
-	UserStore *user.Store
+	UserStore   *user.Store
+	APIKeyStore *apikey.Store
 	sysapi.UnimplementedSysAPIServer
 }
 
@@ -55,3 +58,75 @@ func (srv *Server) DeleteUser(ctx context.Context, req *sysapi.DeleteUserRequest
 	}
 	return &sysapi.DeleteUserResponse{}, nil
 }
+
+func apiKeyProto(k apikey.APIKey) *sysapi.APIKey {
+	pk := &sysapi.APIKey{
+		Id:          k.ID,
+		Name:        k.Name,
+		Description: k.Description,
+		UserId:      k.UserID,
+		Scope:       string(k.Scope),
+		CreatedAt:   timestamppb.New(k.CreatedAt),
+	}
+	if !k.ExpiresAt.IsZero() {
+		pk.ExpiresAt = timestamppb.New(k.ExpiresAt)
+	}
+	if !k.LastUsedAt.IsZero() {
+		pk.LastUsedAt = timestamppb.New(k.LastUsedAt)
+	}
+	return pk
+}
+
+func (srv *Server) CreateAPIKey(ctx context.Context, req *sysapi.CreateAPIKeyRequest) (*sysapi.CreateAPIKeyResponse, error) {
+	ctx = permission.SystemContext(ctx, "SystemAPI")
+
+	k := &apikey.APIKey{
+		Name:        req.Name,
+		Description: req.Description,
+		UserID:      req.UserId,
+		Scope:       apikey.Scope(req.Scope),
+	}
+	if req.ExpiresAt != nil {
+		k.ExpiresAt = req.ExpiresAt.AsTime()
+	}
+
+	k, err := srv.APIKeyStore.CreateTx(ctx, nil, k)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sysapi.CreateAPIKeyResponse{Key: apiKeyProto(*k), Token: k.Token()}, nil
+}
+
+func (srv *Server) APIKeys(req *sysapi.APIKeysRequest, rSrv sysapi.SysAPI_APIKeysServer) error {
+	ctx := permission.SystemContext(rSrv.Context(), "SystemAPI")
+
+	var keys []apikey.APIKey
+	var err error
+	if req.UserId != "" {
+		keys, err = srv.APIKeyStore.FindAllByUser(ctx, req.UserId)
+	} else {
+		keys, err = srv.APIKeyStore.FindAll(ctx)
+	}
+	if err != nil {
+		return err
+	}
+
+	for _, k := range keys {
+		err = rSrv.Send(apiKeyProto(k))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (srv *Server) DeleteAPIKey(ctx context.Context, req *sysapi.DeleteAPIKeyRequest) (*sysapi.DeleteAPIKeyResponse, error) {
+	ctx = permission.SystemContext(ctx, "SystemAPI")
+	err := srv.APIKeyStore.DeleteTx(ctx, nil, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &sysapi.DeleteAPIKeyResponse{}, nil
+}
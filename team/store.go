@@ -0,0 +1,276 @@
+package team
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/target/goalert/permission"
+	"github.com/target/goalert/util"
+	"github.com/target/goalert/util/sqlutil"
+	"github.com/target/goalert/validation"
+	"github.com/target/goalert/validation/validate"
+)
+
+// Store allows the lookup and management of teams and team membership.
+type Store struct {
+	db *sql.DB
+
+	create    *sql.Stmt
+	update    *sql.Stmt
+	delete    *sql.Stmt
+	findOne   *sql.Stmt
+	findAll   *sql.Stmt
+	findByUsr *sql.Stmt
+	members   *sql.Stmt
+	addMember *sql.Stmt
+	rmMember  *sql.Stmt
+}
+
+// NewStore will create a new Store with the given parameters.
+func NewStore(ctx context.Context, db *sql.DB) (*Store, error) {
+	p := &util.Prepare{DB: db, Ctx: ctx}
+
+	return &Store{
+		db: db,
+
+		create: p.P(`INSERT INTO teams (id, name, description) VALUES ($1, $2, $3)`),
+		update: p.P(`UPDATE teams SET name = $2, description = $3 WHERE id = $1`),
+		delete: p.P(`DELETE FROM teams WHERE id = any($1)`),
+		findOne: p.P(`
+			SELECT id, name, description
+			FROM teams
+			WHERE id = $1
+		`),
+		findAll: p.P(`
+			SELECT id, name, description
+			FROM teams
+			ORDER BY lower(name)
+		`),
+		findByUsr: p.P(`
+			SELECT t.id, t.name, t.description
+			FROM teams t
+			JOIN team_members m ON m.team_id = t.id AND m.user_id = $1
+			ORDER BY lower(t.name)
+		`),
+		members: p.P(`
+			SELECT m.user_id
+			FROM team_members m
+			JOIN users u ON u.id = m.user_id
+			WHERE m.team_id = $1
+			ORDER BY lower(u.name)
+		`),
+		addMember: p.P(`
+			INSERT INTO team_members (team_id, user_id)
+			SELECT $1, unnest($2::uuid[])
+			ON CONFLICT DO NOTHING
+		`),
+		rmMember: p.P(`
+			DELETE FROM team_members
+			WHERE team_id = $1 AND user_id = any($2)
+		`),
+	}, p.Err
+}
+
+func wrapTx(ctx context.Context, tx *sql.Tx, stmt *sql.Stmt) *sql.Stmt {
+	if tx == nil {
+		return stmt
+	}
+	return tx.StmtContext(ctx, stmt)
+}
+
+// CreateTx will create a new team. Only admins may create teams.
+func (s *Store) CreateTx(ctx context.Context, tx *sql.Tx, t *Team) (*Team, error) {
+	err := permission.LimitCheckAny(ctx, permission.System, permission.Admin)
+	if err != nil {
+		return nil, err
+	}
+
+	n, err := t.Normalize()
+	if err != nil {
+		return nil, err
+	}
+	n.ID = uuid.New().String()
+
+	_, err = wrapTx(ctx, tx, s.create).ExecContext(ctx, n.ID, n.Name, n.Description)
+	if err != nil {
+		return nil, err
+	}
+
+	return n, nil
+}
+
+// UpdateTx will update the name and description of a team. Admins and team members may update a team.
+func (s *Store) UpdateTx(ctx context.Context, tx *sql.Tx, t *Team) error {
+	err := permission.LimitCheckAny(ctx, permission.System, permission.Admin, permission.TeamMember(t.ID))
+	if err != nil {
+		return err
+	}
+
+	n, err := t.Normalize()
+	if err != nil {
+		return err
+	}
+	err = validate.UUID("ID", n.ID)
+	if err != nil {
+		return err
+	}
+
+	_, err = wrapTx(ctx, tx, s.update).ExecContext(ctx, n.ID, n.Name, n.Description)
+	return err
+}
+
+// DeleteManyTx will delete the given teams. Resources owned by deleted teams become unowned.
+// Only admins may delete teams.
+func (s *Store) DeleteManyTx(ctx context.Context, tx *sql.Tx, ids []string) error {
+	err := permission.LimitCheckAny(ctx, permission.System, permission.Admin)
+	if err != nil {
+		return err
+	}
+
+	err = validate.ManyUUID("TeamID", ids, 50)
+	if err != nil {
+		return err
+	}
+
+	_, err = wrapTx(ctx, tx, s.delete).ExecContext(ctx, sqlutil.UUIDArray(ids))
+	return err
+}
+
+// FindOne will return the team with the given ID.
+func (s *Store) FindOne(ctx context.Context, id string) (*Team, error) {
+	err := permission.LimitCheckAny(ctx, permission.System, permission.User)
+	if err != nil {
+		return nil, err
+	}
+
+	err = validate.UUID("TeamID", id)
+	if err != nil {
+		return nil, err
+	}
+
+	var t Team
+	err = s.findOne.QueryRowContext(ctx, id).Scan(&t.ID, &t.Name, &t.Description)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, validation.NewFieldError("TeamID", "not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &t, nil
+}
+
+// FindAll will return all teams.
+func (s *Store) FindAll(ctx context.Context) ([]Team, error) {
+	err := permission.LimitCheckAny(ctx, permission.System, permission.User)
+	if err != nil {
+		return nil, err
+	}
+
+	return scanAll(s.findAll.QueryContext(ctx))
+}
+
+// FindAllByUser will return all teams the given user is a member of.
+func (s *Store) FindAllByUser(ctx context.Context, userID string) ([]Team, error) {
+	err := permission.LimitCheckAny(ctx, permission.System, permission.User)
+	if err != nil {
+		return nil, err
+	}
+
+	err = validate.UUID("UserID", userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return scanAll(s.findByUsr.QueryContext(ctx, userID))
+}
+
+func scanAll(rows *sql.Rows, err error) ([]Team, error) {
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var teams []Team
+	for rows.Next() {
+		var t Team
+		err = rows.Scan(&t.ID, &t.Name, &t.Description)
+		if err != nil {
+			return nil, err
+		}
+		teams = append(teams, t)
+	}
+
+	return teams, rows.Err()
+}
+
+// MemberIDs will return the user IDs of all members of the given team.
+func (s *Store) MemberIDs(ctx context.Context, teamID string) ([]string, error) {
+	err := permission.LimitCheckAny(ctx, permission.System, permission.User)
+	if err != nil {
+		return nil, err
+	}
+
+	err = validate.UUID("TeamID", teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := s.members.QueryContext(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ids []string
+	for rows.Next() {
+		var id string
+		err = rows.Scan(&id)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+
+	return ids, rows.Err()
+}
+
+// AddMembersTx will add the given users to a team. Admins and team members may add members.
+func (s *Store) AddMembersTx(ctx context.Context, tx *sql.Tx, teamID string, userIDs []string) error {
+	err := permission.LimitCheckAny(ctx, permission.System, permission.Admin, permission.TeamMember(teamID))
+	if err != nil {
+		return err
+	}
+
+	err = validate.Many(
+		validate.UUID("TeamID", teamID),
+		validate.ManyUUID("UserIDs", userIDs, 100),
+	)
+	if err != nil {
+		return err
+	}
+
+	_, err = wrapTx(ctx, tx, s.addMember).ExecContext(ctx, teamID, sqlutil.UUIDArray(userIDs))
+	return err
+}
+
+// RemoveMembersTx will remove the given users from a team. Admins and team members may remove members.
+func (s *Store) RemoveMembersTx(ctx context.Context, tx *sql.Tx, teamID string, userIDs []string) error {
+	err := permission.LimitCheckAny(ctx, permission.System, permission.Admin, permission.TeamMember(teamID))
+	if err != nil {
+		return err
+	}
+
+	err = validate.Many(
+		validate.UUID("TeamID", teamID),
+		validate.ManyUUID("UserIDs", userIDs, 100),
+	)
+	if err != nil {
+		return err
+	}
+
+	_, err = wrapTx(ctx, tx, s.rmMember).ExecContext(ctx, teamID, sqlutil.UUIDArray(userIDs))
+	return err
+}
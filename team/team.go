@@ -0,0 +1,31 @@
+package team
+
+import (
+	"github.com/target/goalert/validation/validate"
+)
+
+// A Team is a group of users that owns services, schedules, rotations and escalation policies.
+//
+// Members of a team may modify resources the team owns; all other users (except admins) have
+// read-only access to them.
+type Team struct {
+	ID          string
+	Name        string
+	Description string
+}
+
+// Normalize will validate and 'normalize' the Team.
+func (t Team) Normalize() (*Team, error) {
+	err := validate.Many(
+		validate.IDName("Name", t.Name),
+		validate.Text("Description", t.Description, 1, 255),
+	)
+	if t.ID != "" {
+		err = validate.Many(err, validate.UUID("ID", t.ID))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &t, nil
+}
@@ -0,0 +1,37 @@
+package team
+
+import (
+	"testing"
+)
+
+func TestTeam_Normalize(t *testing.T) {
+	test := func(valid bool, tm Team) {
+		name := "valid"
+		if !valid {
+			name = "invalid"
+		}
+		t.Run(name, func(t *testing.T) {
+			_, err := tm.Normalize()
+			if valid && err != nil {
+				t.Errorf("got %v; want nil", err)
+			} else if !valid && err == nil {
+				t.Errorf("got nil err; want non-nil")
+			}
+		})
+	}
+
+	valid := []Team{
+		{Name: "Platform"},
+		{Name: "Platform", Description: "Platform engineering", ID: "ec9ea2d1-5ae6-4b50-aa43-7f2b0a52c2dc"},
+	}
+	invalid := []Team{
+		{},
+		{Name: "Platform", ID: "not-a-uuid"},
+	}
+	for _, tm := range valid {
+		test(true, tm)
+	}
+	for _, tm := range invalid {
+		test(false, tm)
+	}
+}
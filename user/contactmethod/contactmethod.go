@@ -17,9 +17,19 @@ type ContactMethod struct {
 	Disabled bool   `json:"disabled"`
 	UserID   string `json:"-"`
 
+	// ShiftStartReminderMinutes is the number of minutes before an on-call shift
+	// starts to send a reminder, or zero if disabled.
+	ShiftStartReminderMinutes int `json:"shift_start_reminder_minutes"`
+
+	// ShiftEndNotification indicates a notification should be sent when an on-call shift ends.
+	ShiftEndNotification bool `json:"shift_end_notification"`
+
 	lastTestVerifyAt sql.NullTime
 }
 
+// MaxShiftStartReminderMinutes is the maximum value for ShiftStartReminderMinutes.
+const MaxShiftStartReminderMinutes = 1440
+
 // LastTestVerifyAt will return the timestamp of the last test/verify request.
 func (c ContactMethod) LastTestVerifyAt() time.Time { return c.lastTestVerifyAt.Time }
 
@@ -33,6 +43,7 @@ func (c ContactMethod) Normalize() (*ContactMethod, error) {
 		validate.UUID("ID", c.ID),
 		validate.IDName("Name", c.Name),
 		validate.OneOf("Type", c.Type, TypeSMS, TypeVoice, TypeEmail, TypePush, TypeWebhook),
+		validate.Range("ShiftStartReminderMinutes", c.ShiftStartReminderMinutes, 0, MaxShiftStartReminderMinutes),
 	)
 
 	switch c.Type {
@@ -52,3 +63,11 @@ func (c ContactMethod) Normalize() (*ContactMethod, error) {
 
 	return &c, nil
 }
+
+func (c ContactMethod) shiftStartReminder() sql.NullInt32 {
+	if c.ShiftStartReminderMinutes == 0 {
+		return sql.NullInt32{}
+	}
+
+	return sql.NullInt32{Valid: true, Int32: int32(c.ShiftStartReminderMinutes)}
+}
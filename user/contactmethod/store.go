@@ -91,33 +91,33 @@ func NewDB(ctx context.Context, db *sql.DB) (*DB, error) {
 			WHERE id = any($1)
 		`),
 		insert: p.P(`
-			INSERT INTO user_contact_methods (id,name,type,value,disabled,user_id)
-			VALUES ($1,$2,$3,$4,$5,$6)
+			INSERT INTO user_contact_methods (id,name,type,value,disabled,user_id,shift_start_reminder_minutes,shift_end_notification)
+			VALUES ($1,$2,$3,$4,$5,$6,$7,$8)
 		`),
 		findOne: p.P(`
-			SELECT id,name,type,value,disabled,user_id,last_test_verify_at
+			SELECT id,name,type,value,disabled,user_id,last_test_verify_at,coalesce(shift_start_reminder_minutes, 0),shift_end_notification
 			FROM user_contact_methods
 			WHERE id = $1
 		`),
 		findOneUpd: p.P(`
-			SELECT id,name,type,value,disabled,user_id,last_test_verify_at
+			SELECT id,name,type,value,disabled,user_id,last_test_verify_at,coalesce(shift_start_reminder_minutes, 0),shift_end_notification
 			FROM user_contact_methods
 			WHERE id = $1
 			FOR UPDATE
 		`),
 		findMany: p.P(`
-			SELECT id,name,type,value,disabled,user_id,last_test_verify_at
+			SELECT id,name,type,value,disabled,user_id,last_test_verify_at,coalesce(shift_start_reminder_minutes, 0),shift_end_notification
 			FROM user_contact_methods
 			WHERE id = any($1)
 		`),
 		findAll: p.P(`
-			SELECT id,name,type,value,disabled,user_id,last_test_verify_at
+			SELECT id,name,type,value,disabled,user_id,last_test_verify_at,coalesce(shift_start_reminder_minutes, 0),shift_end_notification
 			FROM user_contact_methods
 			WHERE user_id = $1
 		`),
 		update: p.P(`
 				UPDATE user_contact_methods
-				SET name = $2, disabled = $3
+				SET name = $2, disabled = $3, shift_start_reminder_minutes = $4, shift_end_notification = $5
 				WHERE id = $1
 			`),
 		delete: p.P(`
@@ -259,7 +259,7 @@ func (db *DB) CreateTx(ctx context.Context, tx *sql.Tx, c *ContactMethod) (*Cont
 		return nil, err
 	}
 
-	_, err = wrapTx(ctx, tx, db.insert).ExecContext(ctx, n.ID, n.Name, n.Type, n.Value, n.Disabled, n.UserID)
+	_, err = wrapTx(ctx, tx, db.insert).ExecContext(ctx, n.ID, n.Name, n.Type, n.Value, n.Disabled, n.UserID, n.shiftStartReminder(), n.ShiftEndNotification)
 	if err != nil {
 		return nil, err
 	}
@@ -338,7 +338,7 @@ func (db *DB) FindOneTx(ctx context.Context, tx *sql.Tx, id string) (*ContactMet
 
 	var c ContactMethod
 	row := wrapTx(ctx, tx, db.findOneUpd).QueryRowContext(ctx, id)
-	err = row.Scan(&c.ID, &c.Name, &c.Type, &c.Value, &c.Disabled, &c.UserID, &c.lastTestVerifyAt)
+	err = row.Scan(&c.ID, &c.Name, &c.Type, &c.Value, &c.Disabled, &c.UserID, &c.lastTestVerifyAt, &c.ShiftStartReminderMinutes, &c.ShiftEndNotification)
 	if err != nil {
 		return nil, err
 	}
@@ -359,7 +359,7 @@ func (db *DB) FindOne(ctx context.Context, id string) (*ContactMethod, error) {
 
 	var c ContactMethod
 	row := db.findOne.QueryRowContext(ctx, id)
-	err = row.Scan(&c.ID, &c.Name, &c.Type, &c.Value, &c.Disabled, &c.UserID, &c.lastTestVerifyAt)
+	err = row.Scan(&c.ID, &c.Name, &c.Type, &c.Value, &c.Disabled, &c.UserID, &c.lastTestVerifyAt, &c.ShiftStartReminderMinutes, &c.ShiftEndNotification)
 	if err != nil {
 		return nil, err
 	}
@@ -398,7 +398,7 @@ func (db *DB) UpdateTx(ctx context.Context, tx *sql.Tx, c *ContactMethod) error
 	}
 
 	if permission.Admin(ctx) {
-		_, err = wrapTx(ctx, tx, db.update).ExecContext(ctx, n.ID, n.Name, n.Disabled)
+		_, err = wrapTx(ctx, tx, db.update).ExecContext(ctx, n.ID, n.Name, n.Disabled, n.shiftStartReminder(), n.ShiftEndNotification)
 		return err
 	}
 
@@ -407,7 +407,7 @@ func (db *DB) UpdateTx(ctx context.Context, tx *sql.Tx, c *ContactMethod) error
 		return err
 	}
 
-	_, err = wrapTx(ctx, tx, db.update).ExecContext(ctx, n.ID, n.Name, n.Disabled)
+	_, err = wrapTx(ctx, tx, db.update).ExecContext(ctx, n.ID, n.Name, n.Disabled, n.shiftStartReminder(), n.ShiftEndNotification)
 	return err
 }
 
@@ -436,7 +436,7 @@ func scanAll(rows *sql.Rows) ([]ContactMethod, error) {
 	var contactMethods []ContactMethod
 	for rows.Next() {
 		var c ContactMethod
-		err := rows.Scan(&c.ID, &c.Name, &c.Type, &c.Value, &c.Disabled, &c.UserID, &c.lastTestVerifyAt)
+		err := rows.Scan(&c.ID, &c.Name, &c.Type, &c.Value, &c.Disabled, &c.UserID, &c.lastTestVerifyAt, &c.ShiftStartReminderMinutes, &c.ShiftEndNotification)
 		if err != nil {
 			return nil, err
 		}
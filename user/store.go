@@ -37,6 +37,14 @@ type Store struct {
 	updateRotationPart *sql.Stmt
 	deleteRotationPart *sql.Stmt
 
+	setDisabled        *sql.Stmt
+	endSessions        *sql.Stmt
+	disableContactMeth *sql.Stmt
+	enableContactMeth  *sql.Stmt
+	deleteStepTargets  *sql.Stmt
+	clearEPFallback    *sql.Stmt
+	deleteSchedRules   *sql.Stmt
+
 	findOneForUpdate *sql.Stmt
 
 	findOneBySubject *sql.Stmt
@@ -122,6 +130,22 @@ func NewStore(ctx context.Context, db *sql.DB) (*Store, error) {
 		updateRotationPart: p.P(`UPDATE rotation_participants SET user_id = $2 WHERE id = $1`),
 		deleteRotationPart: p.P(`DELETE FROM rotation_participants WHERE id = $1`),
 
+		setDisabled:        p.P(`UPDATE users SET disabled = $2 WHERE id = $1`),
+		endSessions:        p.P(`DELETE FROM auth_user_sessions WHERE user_id = $1`),
+		disableContactMeth: p.P(`
+			UPDATE user_contact_methods
+			SET disabled = true, disabled_by_deactivation = true
+			WHERE user_id = $1 AND NOT disabled
+		`),
+		enableContactMeth: p.P(`
+			UPDATE user_contact_methods
+			SET disabled = false, disabled_by_deactivation = false
+			WHERE user_id = $1 AND disabled_by_deactivation
+		`),
+		deleteStepTargets: p.P(`DELETE FROM escalation_policy_actions WHERE user_id = $1`),
+		clearEPFallback:   p.P(`UPDATE escalation_policies SET fallback_user_id = NULL WHERE fallback_user_id = $1`),
+		deleteSchedRules:  p.P(`DELETE FROM schedule_rules WHERE tgt_user_id = $1`),
+
 		findOneBySubject: p.P(`
 			SELECT
 				u.id, u.name, u.email, u.avatar_url, u.role, u.alert_status_log_contact_method_id, false
@@ -403,6 +427,19 @@ func (s *Store) retryDeleteTx(ctx context.Context, tx *sql.Tx, id string) error
 
 func (s *Store) _deleteTx(ctx context.Context, tx *sql.Tx, id string) error {
 	// cleanup rotations first
+	err := s.removeUserFromRotations(ctx, tx, id)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.StmtContext(ctx, s.deleteOne).ExecContext(ctx, id)
+	if err != nil {
+		return fmt.Errorf("delete user row: %w", err)
+	}
+	return nil
+}
+
+func (s *Store) removeUserFromRotations(ctx context.Context, tx *sql.Tx, id string) error {
 	rows, err := tx.StmtContext(ctx, s.userRotations).QueryContext(ctx, id)
 	if errors.Is(err, sql.ErrNoRows) {
 		err = nil
@@ -429,10 +466,79 @@ func (s *Store) _deleteTx(ctx context.Context, tx *sql.Tx, id string) error {
 		}
 	}
 
-	_, err = tx.StmtContext(ctx, s.deleteOne).ExecContext(ctx, id)
+	return nil
+}
+
+// SetActiveTx will activate or deactivate the given user. If tx is nil, a transaction
+// will be started and committed before returning.
+//
+// Deactivated users can not login or use API keys. Deactivating a user also removes them from
+// all rotations (the same way as deleting them), escalation policies, and schedule rules, ends their
+// sessions, and disables their contact methods. Reactivating a user re-enables those contact methods,
+// but does not restore removed assignments.
+func (s *Store) SetActiveTx(ctx context.Context, tx *sql.Tx, id string, active bool) error {
+	err := permission.LimitCheckAny(ctx, permission.System, permission.Admin)
 	if err != nil {
-		return fmt.Errorf("delete user row: %w", err)
+		return err
+	}
+
+	err = validate.UUID("UserID", id)
+	if err != nil {
+		return err
+	}
+
+	var ownsTx bool
+	if tx == nil {
+		ownsTx = true
+		tx, err = s.db.BeginTx(ctx, nil)
+		if err != nil {
+			return err
+		}
+		defer tx.Rollback()
+	}
+
+	_, err = tx.StmtContext(ctx, s.setDisabled).ExecContext(ctx, id, !active)
+	if err != nil {
+		return fmt.Errorf("set user disabled: %w", err)
+	}
+
+	if !active {
+		err = s.removeUserFromRotations(ctx, tx, id)
+		if err != nil {
+			return err
+		}
+		_, err = tx.StmtContext(ctx, s.endSessions).ExecContext(ctx, id)
+		if err != nil {
+			return fmt.Errorf("end user sessions: %w", err)
+		}
+		_, err = tx.StmtContext(ctx, s.disableContactMeth).ExecContext(ctx, id)
+		if err != nil {
+			return fmt.Errorf("disable user contact methods: %w", err)
+		}
+		_, err = tx.StmtContext(ctx, s.deleteStepTargets).ExecContext(ctx, id)
+		if err != nil {
+			return fmt.Errorf("remove user from escalation policy steps: %w", err)
+		}
+		_, err = tx.StmtContext(ctx, s.clearEPFallback).ExecContext(ctx, id)
+		if err != nil {
+			return fmt.Errorf("remove user as escalation policy fallback: %w", err)
+		}
+		_, err = tx.StmtContext(ctx, s.deleteSchedRules).ExecContext(ctx, id)
+		if err != nil {
+			return fmt.Errorf("remove user from schedule rules: %w", err)
+		}
+	} else {
+		// only contact methods disabled by deactivation are re-enabled (e.g., not after an SMS STOP)
+		_, err = tx.StmtContext(ctx, s.enableContactMeth).ExecContext(ctx, id)
+		if err != nil {
+			return fmt.Errorf("enable user contact methods: %w", err)
+		}
+	}
+
+	if ownsTx {
+		return tx.Commit()
 	}
+
 	return nil
 }
 
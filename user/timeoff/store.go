@@ -0,0 +1,239 @@
+package timeoff
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/target/goalert/permission"
+	"github.com/target/goalert/util"
+	"github.com/target/goalert/util/sqlutil"
+	"github.com/target/goalert/validation"
+	"github.com/target/goalert/validation/validate"
+)
+
+// Store allows the lookup and management of user time off.
+type Store struct {
+	db *sql.DB
+
+	create         *sql.Stmt
+	findOne        *sql.Stmt
+	findAllByUser  *sql.Stmt
+	findMany       *sql.Stmt
+	findBySchedule *sql.Stmt
+	delete         *sql.Stmt
+	deleteByUser   *sql.Stmt
+}
+
+// NewStore will create a new Store, preparing all required statements.
+func NewStore(ctx context.Context, db *sql.DB) (*Store, error) {
+	p := &util.Prepare{DB: db, Ctx: ctx}
+
+	return &Store{
+		db: db,
+
+		create: p.P(`
+			insert into user_time_off (id, user_id, start_time, end_time, description)
+			values ($1, $2, $3, $4, $5)
+		`),
+		findOne: p.P(`
+			select id, user_id, start_time, end_time, description
+			from user_time_off
+			where id = $1
+		`),
+		findAllByUser: p.P(`
+			select id, user_id, start_time, end_time, description
+			from user_time_off
+			where user_id = $1 and end_time > now()
+			order by start_time
+		`),
+		findMany: p.P(`
+			select id, user_id, start_time, end_time, description
+			from user_time_off
+			where
+				user_id = any($1) and
+				end_time > $2 and
+				start_time < $3
+			order by start_time
+		`),
+		findBySchedule: p.P(`
+			select id, user_id, start_time, end_time, description
+			from user_time_off
+			where
+				end_time > $2 and
+				start_time < $3 and
+				user_id in (
+					select tgt_user_id
+					from schedule_rules
+					where schedule_id = $1 and tgt_user_id notnull
+					union
+					select part.user_id
+					from schedule_rules rule
+					join rotation_participants part on part.rotation_id = rule.tgt_rotation_id
+					where rule.schedule_id = $1
+					union
+					select add_user_id
+					from user_overrides
+					where tgt_schedule_id = $1 and add_user_id notnull
+				)
+			order by start_time
+		`),
+		delete:       p.P(`delete from user_time_off where id = any($1)`),
+		deleteByUser: p.P(`delete from user_time_off where id = any($1) and user_id = $2`),
+	}, p.Err
+}
+
+func wrapTx(ctx context.Context, tx *sql.Tx, stmt *sql.Stmt) *sql.Stmt {
+	if tx == nil {
+		return stmt
+	}
+	return tx.StmtContext(ctx, stmt)
+}
+
+func scanAll(rows *sql.Rows) ([]TimeOff, error) {
+	defer rows.Close()
+
+	var result []TimeOff
+	for rows.Next() {
+		var t TimeOff
+		err := rows.Scan(&t.ID, &t.UserID, &t.Start, &t.End, &t.Description)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, t)
+	}
+
+	return result, rows.Err()
+}
+
+// CreateTx will create a new period of time off for a user.
+func (s *Store) CreateTx(ctx context.Context, tx *sql.Tx, t *TimeOff) (*TimeOff, error) {
+	err := permission.LimitCheckAny(ctx, permission.System, permission.Admin, permission.MatchUser(t.UserID))
+	if err != nil {
+		return nil, err
+	}
+	n, err := t.Normalize()
+	if err != nil {
+		return nil, err
+	}
+	if !n.End.After(time.Now()) {
+		return nil, validation.NewFieldError("End", "must be in the future")
+	}
+
+	n.ID = uuid.New().String()
+	_, err = wrapTx(ctx, tx, s.create).ExecContext(ctx, n.ID, n.UserID, n.Start, n.End, n.Description)
+	if err != nil {
+		return nil, err
+	}
+
+	return n, nil
+}
+
+// FindOne will return a single period of time off by ID.
+func (s *Store) FindOne(ctx context.Context, id string) (*TimeOff, error) {
+	err := permission.LimitCheckAny(ctx, permission.User)
+	if err != nil {
+		return nil, err
+	}
+	err = validate.UUID("TimeOffID", id)
+	if err != nil {
+		return nil, err
+	}
+
+	var t TimeOff
+	err = s.findOne.QueryRowContext(ctx, id).Scan(&t.ID, &t.UserID, &t.Start, &t.End, &t.Description)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &t, nil
+}
+
+// FindAllByUser will return all current and upcoming time off for the given user.
+func (s *Store) FindAllByUser(ctx context.Context, userID string) ([]TimeOff, error) {
+	err := permission.LimitCheckAny(ctx, permission.User)
+	if err != nil {
+		return nil, err
+	}
+	err = validate.UUID("UserID", userID)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := s.findAllByUser.QueryContext(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return scanAll(rows)
+}
+
+// FindManyTx will return all time off for the given users that overlaps the provided time range.
+func (s *Store) FindManyTx(ctx context.Context, tx *sql.Tx, userIDs []string, start, end time.Time) ([]TimeOff, error) {
+	err := permission.LimitCheckAny(ctx, permission.System, permission.User)
+	if err != nil {
+		return nil, err
+	}
+	if len(userIDs) == 0 {
+		return nil, nil
+	}
+	err = validate.ManyUUID("UserIDs", userIDs, -1)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := wrapTx(ctx, tx, s.findMany).QueryContext(ctx, sqlutil.UUIDArray(userIDs), start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	return scanAll(rows)
+}
+
+// FindAllBySchedule will return all time off that overlaps the provided time range for any
+// user assigned to the schedule directly, through a rotation, or through an override.
+func (s *Store) FindAllBySchedule(ctx context.Context, scheduleID string, start, end time.Time) ([]TimeOff, error) {
+	err := permission.LimitCheckAny(ctx, permission.User)
+	if err != nil {
+		return nil, err
+	}
+	err = validate.UUID("ScheduleID", scheduleID)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := s.findBySchedule.QueryContext(ctx, scheduleID, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	return scanAll(rows)
+}
+
+// DeleteTx will delete the given time off entries. Non-admin users may only delete their own.
+func (s *Store) DeleteTx(ctx context.Context, tx *sql.Tx, ids ...string) error {
+	err := permission.LimitCheckAny(ctx, permission.System, permission.Admin, permission.User)
+	if err != nil {
+		return err
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	err = validate.ManyUUID("TimeOffID", ids, 50)
+	if err != nil {
+		return err
+	}
+
+	if permission.Admin(ctx) || permission.System(ctx) {
+		_, err = wrapTx(ctx, tx, s.delete).ExecContext(ctx, sqlutil.UUIDArray(ids))
+		return err
+	}
+
+	_, err = wrapTx(ctx, tx, s.deleteByUser).ExecContext(ctx, sqlutil.UUIDArray(ids), permission.UserID(ctx))
+	return err
+}
@@ -0,0 +1,48 @@
+package timeoff
+
+import (
+	"time"
+
+	"github.com/target/goalert/validation"
+	"github.com/target/goalert/validation/validate"
+)
+
+// MaxDuration is the longest single period of time off that can be declared.
+const MaxDuration = 366 * 24 * time.Hour
+
+// TimeOff represents a period of time a user is unavailable for on-call duty.
+type TimeOff struct {
+	ID          string
+	UserID      string
+	Start       time.Time
+	End         time.Time
+	Description string
+}
+
+// Normalize will validate fields and return a normalized copy.
+func (t TimeOff) Normalize() (*TimeOff, error) {
+	err := validate.Many(
+		validate.UUID("UserID", t.UserID),
+		validate.Text("Description", t.Description, 0, 255),
+	)
+	if !t.End.After(t.Start) {
+		err = validate.Many(err, validation.NewFieldError("End", "must occur after Start time"))
+	} else if t.End.Sub(t.Start) > MaxDuration {
+		err = validate.Many(err, validation.NewFieldError("End", "must be within 366 days of Start time"))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &t, nil
+}
+
+// Overlaps returns true if the time off overlaps with the given time range.
+func (t TimeOff) Overlaps(start, end time.Time) bool {
+	return t.Start.Before(end) && t.End.After(start)
+}
+
+// Contains returns true if the user is unavailable at the given time.
+func (t TimeOff) Contains(ts time.Time) bool {
+	return !ts.Before(t.Start) && ts.Before(t.End)
+}
@@ -0,0 +1,35 @@
+package timeoff
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTimeOff_Normalize(t *testing.T) {
+	start := time.Date(2021, 9, 1, 0, 0, 0, 0, time.UTC)
+	valid := TimeOff{
+		UserID:      "bcefacc0-4764-012d-7bfb-002500d5decb",
+		Start:       start,
+		End:         start.Add(24 * time.Hour),
+		Description: "Vacation",
+	}
+	_, err := valid.Normalize()
+	assert.NoError(t, err)
+
+	inv := valid
+	inv.End = inv.Start
+	_, err = inv.Normalize()
+	assert.Error(t, err, "end before start")
+
+	inv = valid
+	inv.End = inv.Start.AddDate(2, 0, 0)
+	_, err = inv.Normalize()
+	assert.Error(t, err, "too long")
+
+	inv = valid
+	inv.UserID = "foo"
+	_, err = inv.Normalize()
+	assert.Error(t, err, "bad user ID")
+}
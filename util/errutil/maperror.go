@@ -31,6 +31,8 @@ func MapDBError(err error) error {
 			return validation.NewFieldError("RemoveUserID", "user does not exist")
 		case "user_overrides_tgt_schedule_id_fkey":
 			return validation.NewFieldError("TargetID", "schedule does not exist")
+		case "user_time_off_user_id_fkey":
+			return validation.NewFieldError("UserID", "user does not exist")
 		case "alerts_services_id_fkey":
 			return validation.NewFieldError("ServiceID", "service does not exist")
 		case "schedule_rules_tgt_user_id_fkey":
@@ -55,6 +57,8 @@ func MapDBError(err error) error {
 		if dbErr.ConstraintName == "idx_no_alert_duplicates" {
 			return validation.NewFieldError("", "duplicate alert already exists")
 		}
+	case "2201B": // invalid regular expression, only used by escalation step conditions
+		return validation.NewFieldError("Condition.SummaryRegex", dbErr.Message)
 	case "23514": // check constraint
 		newErr := mapLimitError(dbErr)
 		if newErr != nil {
@@ -63,6 +67,8 @@ func MapDBError(err error) error {
 		switch dbErr.ConstraintName {
 		case "user_overrides_check2":
 			return validation.NewFieldError("AddUserID", "cannot be the same as the user being replaced")
+		case "user_time_off_valid_range":
+			return validation.NewFieldError("End", "must be after start time")
 		case "user_override_no_conflict_allowed":
 			return validation.NewFieldError("UserID", "cannot override the same user twice at the same time, check existing overrides; "+dbErr.Hint)
 		case "alert_status_user_id_match":
@@ -0,0 +1,31 @@
+package timeutil
+
+import (
+	"database/sql/driver"
+)
+
+// NullClock is a Clock that may be null.
+type NullClock struct {
+	Clock Clock
+	Valid bool // Valid is true if Clock is not null
+}
+
+// Value implements the driver.Valuer interface.
+func (n NullClock) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+
+	return n.Clock.Value()
+}
+
+// Scan implements the sql.Scanner interface.
+func (n *NullClock) Scan(value interface{}) error {
+	if value == nil {
+		n.Clock, n.Valid = 0, false
+		return nil
+	}
+
+	n.Valid = true
+	return n.Clock.Scan(value)
+}
@@ -0,0 +1,91 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/target/goalert/validation"
+)
+
+// Regexp will validate a regular expression.
+func Regexp(fname, expression string) error {
+	_, err := regexp.Compile(expression)
+	if err != nil {
+		return validation.NewFieldError(fname, err.Error())
+	}
+
+	return nil
+}
+
+var sqlRegexpRepeat = regexp.MustCompile(`^\{([0-9]+)(,([0-9]*))?\}`)
+
+// sqlRegexpMaxRepeat is the largest repetition count supported by Postgres.
+const sqlRegexpMaxRepeat = 255
+
+// SQLRegexp will validate a regular expression that may be evaluated by both Go and
+// Postgres (with the `~` operator). Only the subset of syntax both interpret the same way is
+// accepted: no flags other than a leading `(?i)`, no named or flagged groups other than `(?:`,
+// no word boundaries or Unicode classes, and only the `\d \s \w \D \S \W \t \n \r \f \v` escapes.
+func SQLRegexp(fname, expression string) error {
+	err := Regexp(fname, expression)
+	if err != nil {
+		return err
+	}
+
+	expr := strings.TrimPrefix(expression, "(?i)")
+	var inClass bool
+	for i := 0; i < len(expr); i++ {
+		c := expr[i]
+		switch {
+		case c == '\\':
+			// Regexp ensures a trailing backslash is not possible
+			i++
+			e := expr[i]
+			allowed := "dsDSWwtnrfv"
+			if inClass {
+				allowed = "dswtnrfv"
+			}
+			if isAlphaNum(e) && strings.IndexByte(allowed, e) == -1 {
+				return validation.NewFieldError(fname, fmt.Sprintf("unsupported escape sequence: \\%c", e))
+			}
+		case inClass && c == '[' && i+1 < len(expr) && expr[i+1] == ':':
+			// POSIX class like [:alpha:]
+			i += strings.Index(expr[i:], ":]") + 1
+		case inClass && c == ']':
+			inClass = false
+		case inClass:
+		case c == '[':
+			inClass = true
+			if i+1 < len(expr) && expr[i+1] == '^' {
+				i++
+			}
+			if i+1 < len(expr) && expr[i+1] == ']' {
+				// leading ] is a literal
+				i++
+			}
+		case c == '(' && i+1 < len(expr) && expr[i+1] == '?':
+			if !strings.HasPrefix(expr[i:], "(?:") {
+				return validation.NewFieldError(fname, "unsupported group; only (?: and a leading (?i) are allowed")
+			}
+		case c == '{':
+			m := sqlRegexpRepeat.FindStringSubmatch(expr[i:])
+			if m == nil {
+				return validation.NewFieldError(fname, "literal { must be escaped")
+			}
+			for _, n := range []string{m[1], m[3]} {
+				if v, _ := strconv.Atoi(n); v > sqlRegexpMaxRepeat {
+					return validation.NewFieldError(fname, fmt.Sprintf("repeat count must not be over %d", sqlRegexpMaxRepeat))
+				}
+			}
+			i += len(m[0]) - 1
+		}
+	}
+
+	return nil
+}
+
+func isAlphaNum(c byte) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9')
+}
@@ -0,0 +1,50 @@
+package validate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRegexp(t *testing.T) {
+	err := Regexp("test", "foobar")
+	assert.NoError(t, err)
+
+	err = Regexp("test", "^(db|cache)-[0-9]+")
+	assert.NoError(t, err)
+
+	err = Regexp("test", "foo(bar")
+	assert.Error(t, err)
+}
+
+func TestSQLRegexp(t *testing.T) {
+	valid := []string{
+		"foobar",
+		"^(db|cache)-[0-9]+$",
+		`(?i)^disk \d+% full`,
+		`[[:alpha:]_]+\.example\.com`,
+		`[]a]|[^]b]`,
+		`(?:foo){2,3}`,
+		`a{1,}\{literal\}`,
+	}
+	for _, expr := range valid {
+		assert.NoError(t, SQLRegexp("test", expr), expr)
+	}
+
+	invalid := []string{
+		"foo(bar",
+		`\bword\b`,
+		`\pL+`,
+		`\Qa.b\E`,
+		`end\z`,
+		`(?P<name>foo)`,
+		`foo(?i)bar`,
+		`(?s).*`,
+		`a{`,
+		`a{1,300}`,
+		`[\D]`,
+	}
+	for _, expr := range invalid {
+		assert.Error(t, SQLRegexp("test", expr), expr)
+	}
+}
@@ -66,6 +66,11 @@ export default function Login() {
   const fullScreen = useIsWidthDown('md')
   const [error, setError] = useState(getParameterByName('login_error') || '')
   const [providers, setProviders] = useState([])
+  const [enrollment, setEnrollment] = useState(null)
+
+  // set when an additional login step (e.g., MFA) is required
+  const challengeProvider = getParameterByName('login_challenge')
+  const challengeEnroll = getParameterByName('challenge_enroll') === '1'
 
   useEffect(() => {
     // get providers
@@ -75,6 +80,22 @@ export default function Login() {
       .catch((err) => setError(err))
   }, [])
 
+  useEffect(() => {
+    if (!challengeProvider || !challengeEnroll) return
+
+    // get secret and recovery codes for MFA setup (challenge token is sent as a cookie)
+    fetch(PROVIDERS_URL + '/' + challengeProvider + '/enroll', {
+      method: 'POST',
+      credentials: 'same-origin',
+    })
+      .then((res) => {
+        if (!res.ok) return res.text().then((text) => Promise.reject(text))
+        return res.json()
+      })
+      .then((data) => setEnrollment(data))
+      .catch((err) => setError(err))
+  }, [challengeProvider, challengeEnroll])
+
   /*
    * Sets the background image for the login page
    *
@@ -127,6 +148,88 @@ export default function Login() {
     }
   }
 
+  /*
+   * Renders details for setting up an authenticator app
+   */
+  function renderEnrollment() {
+    if (!enrollment) return null
+
+    return (
+      <React.Fragment>
+        <Grid item xs={12}>
+          <Typography>
+            Multi-factor authentication is required. Add the following key to
+            your authenticator app, or open the setup link on your device.
+          </Typography>
+        </Grid>
+        <Grid item xs={12}>
+          <Typography variant='h6' component='code'>
+            {enrollment.Secret.replace(/(.{4})/g, '$1 ').trim()}
+          </Typography>
+          <br />
+          <a href={enrollment.URL}>Setup link</a>
+        </Grid>
+        <Grid item xs={12}>
+          <Typography>
+            Save these recovery codes somewhere safe. Each can be used once in
+            place of a code if you lose your device.
+          </Typography>
+          <Typography component='pre'>
+            {enrollment.RecoveryCodes.join('\n')}
+          </Typography>
+        </Grid>
+      </React.Fragment>
+    )
+  }
+
+  /*
+   * Renders the additional login step for a provider
+   */
+  function renderChallenge(provider) {
+    return (
+      <React.Fragment>
+        {challengeEnroll && renderEnrollment()}
+        <Grid item xs={12}>
+          <form
+            action={provider.URL}
+            method='post'
+            id={'auth-' + provider.ID}
+          >
+            <input type='hidden' name='challenge' value='1' />
+            <Grid container spacing={2}>
+              <Grid item xs={12}>
+                <TextField
+                  label='Authentication Code'
+                  required
+                  autoFocus
+                  autoComplete='one-time-code'
+                  name='code'
+                />
+              </Grid>
+              <Grid item xs={12}>
+                <Button type='submit' variant='contained' color='primary'>
+                  Verify
+                </Button>
+              </Grid>
+            </Grid>
+          </form>
+        </Grid>
+      </React.Fragment>
+    )
+  }
+
+  /*
+   * Renders the login options, or the additional login step if required
+   */
+  function renderProviders() {
+    const challenge = providers.find((p) => p.ID === challengeProvider)
+    if (challenge) return renderChallenge(challenge)
+
+    return providers.map((provider, idx) =>
+      renderProvider(provider, idx, providers.length),
+    )
+  }
+
   /*
    * Renders a provider given from initial GET request
    */
@@ -224,9 +327,7 @@ export default function Login() {
                 <Grid item xs={12}>
                   {logo}
                 </Grid>
-                {providers.map((provider, idx) =>
-                  renderProvider(provider, idx, providers.length),
-                )}
+                {renderProviders()}
                 {errorJSX}
               </Grid>
             </CardContent>
@@ -239,9 +340,7 @@ export default function Login() {
             <Grid item xs={12}>
               {logo}
             </Grid>
-            {providers.map((provider, idx) =>
-              renderProvider(provider, idx, providers.length),
-            )}
+            {renderProviders()}
             {errorJSX}
           </Grid>
         </div>
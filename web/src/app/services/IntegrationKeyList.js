@@ -7,9 +7,14 @@ import CardContent from '@mui/material/CardContent'
 import CreateFAB from '../lists/CreateFAB'
 import FlatList from '../lists/FlatList'
 import IconButton from '@mui/material/IconButton'
+import Typography from '@mui/material/Typography'
+import RotateIcon from '@mui/icons-material/Autorenew'
 import { Trash } from '../icons'
 import IntegrationKeyCreateDialog from './IntegrationKeyCreateDialog'
 import IntegrationKeyDeleteDialog from './IntegrationKeyDeleteDialog'
+import IntegrationKeyRotateDialog from './IntegrationKeyRotateDialog'
+import Notices from '../details/Notices'
+import { formatTimeSince } from '../util/timeFormat'
 import RequireConfig from '../util/RequireConfig'
 import CopyText from '../util/CopyText'
 import AppLink from '../util/AppLink'
@@ -27,6 +32,13 @@ const query = gql`
         type
         name
         href
+        lastUsedAt
+        requestCount
+        notices {
+          type
+          message
+          details
+        }
       }
     }
   }
@@ -45,6 +57,9 @@ const useStyles = makeStyles({
   spacing: {
     marginBottom: 96,
   },
+  notices: {
+    marginBottom: 8,
+  },
 })
 
 const sortItems = (a, b) => {
@@ -74,6 +89,14 @@ export function IntegrationKeyDetails(props) {
           else='Email integration keys are currently disabled.'
         />
       )}
+      {props.requestCount !== undefined && (
+        <Typography variant='caption' component='p'>
+          {props.lastUsedAt
+            ? `Last used: ${formatTimeSince(props.lastUsedAt)}`
+            : 'Never used'}
+          {` (${props.requestCount} requests)`}
+        </Typography>
+      )}
     </React.Fragment>
   )
 }
@@ -82,6 +105,8 @@ IntegrationKeyDetails.propTypes = {
   href: p.string.isRequired,
   label: p.string.isRequired,
   type: p.string.isRequired,
+  lastUsedAt: p.string,
+  requestCount: p.number,
 }
 
 export default function IntegrationKeyList(props) {
@@ -89,6 +114,7 @@ export default function IntegrationKeyList(props) {
 
   const [create, setCreate] = useState(false)
   const [deleteDialog, setDeleteDialog] = useState(null)
+  const [rotateDialog, setRotateDialog] = useState(null)
 
   const { loading, error, data } = useQuery(query, {
     variables: { serviceID: props.serviceID },
@@ -115,20 +141,40 @@ export default function IntegrationKeyList(props) {
           href={key.href}
           label={typeLabels[key.type]}
           type={key.type}
+          lastUsedAt={key.lastUsedAt}
+          requestCount={key.requestCount}
         />
       ),
       secondaryAction: (
-        <IconButton onClick={() => setDeleteDialog(key.id)} size='large'>
-          <Trash />
-        </IconButton>
+        <React.Fragment>
+          <IconButton
+            onClick={() => setRotateDialog(key.id)}
+            size='large'
+            title='Rotate Secret'
+          >
+            <RotateIcon />
+          </IconButton>
+          <IconButton onClick={() => setDeleteDialog(key.id)} size='large'>
+            <Trash />
+          </IconButton>
+        </React.Fragment>
       ),
     }))
 
+  const notices = (data.service.integrationKeys || []).flatMap((key) =>
+    key.notices.map((n) => ({ ...n, message: `${key.name}: ${n.message}` })),
+  )
+
   return (
     <React.Fragment>
       <Grid item xs={12} className={classes.spacing}>
         <Card>
           <CardContent>
+            {notices.length > 0 && (
+              <div className={classes.notices}>
+                <Notices notices={notices} />
+              </div>
+            )}
             <FlatList
               data-cy='int-keys'
               headerNote={
@@ -159,6 +205,12 @@ export default function IntegrationKeyList(props) {
           onClose={() => setDeleteDialog(null)}
         />
       )}
+      {rotateDialog && (
+        <IntegrationKeyRotateDialog
+          integrationKeyID={rotateDialog}
+          onClose={() => setRotateDialog(null)}
+        />
+      )}
     </React.Fragment>
   )
 }
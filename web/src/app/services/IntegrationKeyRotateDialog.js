@@ -0,0 +1,70 @@
+import React from 'react'
+import { gql, useQuery, useMutation } from '@apollo/client'
+
+import p from 'prop-types'
+
+import { nonFieldErrors } from '../util/errutil'
+import Spinner from '../loading/components/Spinner'
+import { GenericError } from '../error-pages'
+import FormDialog from '../dialogs/FormDialog'
+
+const query = gql`
+  query ($id: ID!) {
+    integrationKey(id: $id) {
+      id
+      name
+    }
+  }
+`
+
+const mutation = gql`
+  mutation ($input: RotateIntegrationKeyInput!) {
+    rotateIntegrationKey(input: $input) {
+      id
+      href
+      previousSecretExpiresAt
+      notices {
+        type
+        message
+        details
+      }
+    }
+  }
+`
+
+export default function IntegrationKeyRotateDialog(props) {
+  const { loading, error, data } = useQuery(query, {
+    variables: { id: props.integrationKeyID },
+  })
+
+  const [rotateKey, rotateKeyStatus] = useMutation(mutation, {
+    onCompleted: props.onClose,
+  })
+
+  if (loading && !data) return <Spinner />
+  if (error) return <GenericError error={error.message} />
+
+  return (
+    <FormDialog
+      title='Are you sure?'
+      confirm
+      subTitle={`This will generate a new secret for the integration key: ${data?.integrationKey?.name}`}
+      caption='The current secret will continue to be accepted for 24 hours. Alert sources must be updated to use the new URL or address before then.'
+      loading={rotateKeyStatus.loading}
+      errors={nonFieldErrors(rotateKeyStatus.error)}
+      onClose={props.onClose}
+      onSubmit={() =>
+        rotateKey({
+          variables: {
+            input: { id: props.integrationKeyID },
+          },
+        })
+      }
+    />
+  )
+}
+
+IntegrationKeyRotateDialog.propTypes = {
+  integrationKeyID: p.string.isRequired,
+  onClose: p.func,
+}
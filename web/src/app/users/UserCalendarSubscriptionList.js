@@ -35,6 +35,9 @@ export const calendarSubscriptionsQuery = gql`
   }
 `
 
+// personal subscriptions (no schedule) include shifts from all schedules
+const scheduleName = (sub) => _.get(sub, 'schedule.name', 'All Schedules')
+
 export default function UserCalendarSubscriptionList(props) {
   const [creationDisabled] = useConfigValue(
     'General.DisableCalendarSubscriptions',
@@ -54,8 +57,8 @@ export default function UserCalendarSubscriptionList(props) {
 
   // sort by schedule names, then subscription names
   const subs = data.user.calendarSubscriptions.slice().sort((a, b) => {
-    if (a.schedule.name < b.schedule.name) return -1
-    if (a.schedule.name > b.schedule.name) return 1
+    if (scheduleName(a) < scheduleName(b)) return -1
+    if (scheduleName(a) > scheduleName(b)) return 1
 
     if (a.name > b.name) return 1
     if (a.name < b.name) return -1
@@ -83,13 +86,14 @@ export default function UserCalendarSubscriptionList(props) {
 
   // push schedule names as subheaders now that the array is sorted
   subs.forEach((sub) => {
-    if (!subheaderDict[sub.schedule.name]) {
-      subheaderDict[sub.schedule.name] = true
+    const name = scheduleName(sub)
+    if (!subheaderDict[name]) {
+      subheaderDict[name] = true
       items.push({
-        subHeader: (
-          <AppLink to={`/schedules/${sub.scheduleID}`}>
-            {sub.schedule.name}
-          </AppLink>
+        subHeader: sub.scheduleID ? (
+          <AppLink to={`/schedules/${sub.scheduleID}`}>{name}</AppLink>
+        ) : (
+          name
         ),
       })
     }
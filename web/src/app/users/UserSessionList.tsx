@@ -23,6 +23,7 @@ const profileQuery = gql`
       sessions {
         id
         userAgent
+        ipAddress
         current
         createdAt
         lastAccessAt
@@ -38,6 +39,7 @@ const byUserQuery = gql`
       sessions {
         id
         userAgent
+        ipAddress
         current
         createdAt
         lastAccessAt
@@ -48,7 +50,7 @@ const byUserQuery = gql`
 
 const mutationLogoutOne = gql`
   mutation ($id: ID!) {
-    deleteAll(input: [{ id: $id, type: userSession }])
+    endAuthSession(id: $id)
   }
 `
 
@@ -58,6 +60,12 @@ const mutationLogoutAll = gql`
   }
 `
 
+const mutationLogoutAllByUser = gql`
+  mutation ($userID: ID!) {
+    endAllAuthSessionsByUser(userID: $userID)
+  }
+`
+
 export interface UserSessionListProps {
   userID?: string
 }
@@ -112,25 +120,27 @@ export default function UserSessionList(
     variables: { id: (endSession as Session)?.id },
     onCompleted: () => setEndSession(null),
   })
-  const [logoutAll, logoutAllStatus] = useMutation(mutationLogoutAll, {
-    onCompleted: () => setEndSession(null),
-  })
+  const [logoutAll, logoutAllStatus] = useMutation(
+    userID ? mutationLogoutAllByUser : mutationLogoutAll,
+    {
+      variables: { userID },
+      onCompleted: () => setEndSession(null),
+    },
+  )
 
   return (
     <React.Fragment>
       <Grid container spacing={2}>
-        {!userID && (
-          <Grid item xs={12} container justifyContent='flex-end'>
-            <Button
-              color='primary'
-              variant='outlined'
-              data-cy='reset'
-              onClick={() => setEndSession('all')}
-            >
-              Log Out Other Sessions
-            </Button>
-          </Grid>
-        )}
+        <Grid item xs={12} container justifyContent='flex-end'>
+          <Button
+            color='primary'
+            variant='outlined'
+            data-cy='reset'
+            onClick={() => setEndSession('all')}
+          >
+            {userID ? 'Log Out All Sessions' : 'Log Out Other Sessions'}
+          </Button>
+        </Grid>
         <Grid item xs={12}>
           <Card>
             <FlatList
@@ -152,7 +162,9 @@ export default function UserSessionList(
                     <DeleteIcon />
                   </IconButton>
                 ),
-                subText: `Last access: ${formatTimeSince(s.lastAccessAt)}`,
+                subText:
+                  `Last access: ${formatTimeSince(s.lastAccessAt)}` +
+                  (s.ipAddress ? ` from ${s.ipAddress}` : ''),
               }))}
             />
           </Card>
@@ -165,7 +177,11 @@ export default function UserSessionList(
           confirm
           loading={logoutAllStatus.loading}
           errors={nonFieldErrors(logoutAllStatus.error as ApolloError)}
-          subTitle='This will log you out of all other sessions.'
+          subTitle={
+            userID
+              ? 'This will end all sessions for this user.'
+              : 'This will log you out of all other sessions.'
+          }
           onSubmit={() => logoutAll()}
           onClose={() => setEndSession(null)}
         />
@@ -177,7 +193,7 @@ export default function UserSessionList(
           confirm
           loading={logoutOneStatus.loading}
           errors={nonFieldErrors(logoutOneStatus.error as ApolloError)}
-          subTitle={`This will log you out of your "${friendlyUAString(
+          subTitle={`This will end the "${friendlyUAString(
             endSession.userAgent,
           )}" session.`}
           onSubmit={() => logoutOne()}
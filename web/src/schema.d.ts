@@ -16,9 +16,15 @@ export interface Query {
   calcRotationHandoffTimes: ISOTimestamp[]
   schedule?: Schedule
   userCalendarSubscription?: UserCalendarSubscription
+  apiKey?: APIKey
+  apiKeys: APIKey[]
+  team?: Team
+  teams: Team[]
   schedules: ScheduleConnection
   escalationPolicy?: EscalationPolicy
   escalationPolicies: EscalationPolicyConnection
+  escalationPolicyTemplate?: EscalationPolicyTemplate
+  escalationPolicyTemplates: EscalationPolicyTemplate[]
   authSubjectsForProvider: AuthSubjectConnection
   timeZones: TimeZoneConnection
   labels: LabelConnection
@@ -34,6 +40,153 @@ export interface Query {
   slackChannels: SlackChannelConnection
   slackChannel?: SlackChannel
   generateSlackAppManifest: string
+  onCallWorkloadReport: OnCallWorkload[]
+  onCallSnapshot: OnCallSnapshot
+  simulateEscalationPolicy: EscalationSimulationEvent[]
+  testIntegrationKeyRoute: IntegrationKeyRouteResult
+  auditLogs: AuditLogConnection
+}
+
+export interface TestIntegrationKeyRouteInput {
+  integrationKeyID: string
+  summary: string
+  details?: string
+  meta?: IntegrationKeyRouteMetaInput[]
+  rules?: IntegrationKeyRoutingRuleInput[]
+}
+
+export interface IntegrationKeyRouteMetaInput {
+  key: string
+  value: string
+}
+
+export interface IntegrationKeyRouteResult {
+  rule?: IntegrationKeyRoutingRule
+  ruleIndex: number
+  dropped: boolean
+  service?: Service
+}
+
+export interface SimulateEscalationPolicyInput {
+  escalationPolicyID?: string
+  steps?: SimulateEscalationPolicyStepInput[]
+  repeat?: number
+  repeatBackoffMinutes?: number
+  fallbackTarget?: TargetInput
+  start: ISOTimestamp
+  end?: ISOTimestamp
+  alertSource?: string
+  alertSummary?: string
+}
+
+export interface SimulateEscalationPolicyStepInput {
+  delayMinutes: number
+  targets: TargetInput[]
+  condition?: EscalationStepConditionInput
+  targetMode?: EscalationStepTargetMode
+  sequentialDelayMinutes?: number
+}
+
+export interface EscalationSimulationEvent {
+  time: ISOTimestamp
+  stepNumber: number
+  loopCount: number
+  user?: User
+  contactMethod?: UserContactMethod
+  target?: Target
+  fallback: boolean
+}
+
+export interface OnCallSnapshotInput {
+  serviceID?: string
+  escalationPolicyID?: string
+  time: ISOTimestamp
+}
+
+export interface OnCallSnapshot {
+  time: ISOTimestamp
+  escalationPolicyID: string
+  escalationPolicy?: EscalationPolicy
+  steps: OnCallSnapshotStep[]
+}
+
+export interface OnCallSnapshotStep {
+  stepNumber: number
+  targets: OnCallSnapshotTarget[]
+  users: User[]
+}
+
+export interface OnCallSnapshotTarget {
+  target: Target
+  users: User[]
+  overrides: UserOverride[]
+}
+
+export interface AuditLogSearchOptions {
+  first?: number
+  after?: string
+  filterByObjectType?: AuditLogObjectType[]
+  filterByObjectID?: string
+  filterByActorUserID?: string[]
+  filterByAction?: AuditLogAction[]
+  start?: ISOTimestamp
+  end?: ISOTimestamp
+}
+
+export interface AuditLogConnection {
+  nodes: AuditLog[]
+  pageInfo: PageInfo
+}
+
+export interface AuditLog {
+  id: number
+  timestamp: ISOTimestamp
+  actorType: AuditLogActorType
+  actorID: string
+  actorUser?: User
+  objectType: AuditLogObjectType
+  objectID: string
+  action: AuditLogAction
+  before?: string
+  after?: string
+}
+
+export type AuditLogActorType = 'system' | 'user' | 'apiKey' | 'integrationKey'
+
+export type AuditLogObjectType =
+  | 'service'
+  | 'integrationKey'
+  | 'escalationPolicy'
+  | 'escalationPolicyStep'
+  | 'escalationPolicyStepTarget'
+  | 'schedule'
+  | 'scheduleRule'
+  | 'rotation'
+  | 'rotationParticipant'
+  | 'user'
+  | 'contactMethod'
+  | 'config'
+
+export type AuditLogAction = 'create' | 'update' | 'delete'
+
+export interface OnCallWorkloadReportInput {
+  start: ISOTimestamp
+  end: ISOTimestamp
+  scheduleIDs?: string[]
+  userIDs?: string[]
+  timeZone?: string
+  businessStart?: ClockTime
+  businessEnd?: ClockTime
+}
+
+export interface OnCallWorkload {
+  userID: string
+  user?: User
+  onCallHours: number
+  offHoursHours: number
+  weekendHours: number
+  pagesReceived: number
+  nightsInterrupted: number
 }
 
 export interface DebugMessagesInput {
@@ -253,11 +406,14 @@ export interface Mutation {
   addAuthSubject: boolean
   deleteAuthSubject: boolean
   endAllAuthSessionsByCurrentUser: boolean
+  endAuthSession: boolean
+  endAllAuthSessionsByUser: boolean
   updateUser: boolean
   testContactMethod: boolean
   updateAlerts?: Alert[]
   updateRotation: boolean
   escalateAlerts?: Alert[]
+  snoozeAlert?: Alert
   setFavorite: boolean
   updateService: boolean
   updateEscalationPolicy: boolean
@@ -267,16 +423,33 @@ export interface Mutation {
   createService?: Service
   createEscalationPolicy?: EscalationPolicy
   createEscalationPolicyStep?: EscalationPolicyStep
+  cloneEscalationPolicy?: EscalationPolicy
+  createEscalationPolicyTemplate?: EscalationPolicyTemplate
+  deleteEscalationPolicyTemplates: boolean
+  createEscalationPolicyFromTemplate?: EscalationPolicy
   createRotation?: Rotation
   createIntegrationKey?: IntegrationKey
+  rotateIntegrationKey?: IntegrationKey
+  setIntegrationKeyExpiration: boolean
+  setIntegrationKeyRoutingRules: boolean
   createHeartbeatMonitor?: HeartbeatMonitor
   setLabel: boolean
   createSchedule?: Schedule
   createUser?: User
   createUserCalendarSubscription: UserCalendarSubscription
   updateUserCalendarSubscription: boolean
+  createAPIKey: APIKey
+  deleteAPIKeys: boolean
+  setupMFA: MFASetup
+  enableMFA: boolean
+  disableMFA: boolean
+  createTeam: Team
+  updateTeam: boolean
+  deleteTeams: boolean
+  updateTeamMembers: boolean
   updateScheduleTarget: boolean
   createUserOverride?: UserOverride
+  createUserTimeOff?: UserTimeOff
   createUserContactMethod?: UserContactMethod
   createUserNotificationRule?: UserNotificationRule
   updateUserContactMethod: boolean
@@ -290,6 +463,11 @@ export interface Mutation {
   setSystemLimits: boolean
 }
 
+export interface SnoozeAlertInput {
+  alertID: number
+  until: ISOTimestamp
+}
+
 export interface UpdateAlertsByServiceInput {
   serviceID: string
   newStatus: AlertStatus
@@ -314,7 +492,7 @@ export interface CreateUserInput {
 export interface CreateUserCalendarSubscriptionInput {
   name: string
   reminderMinutes?: number[]
-  scheduleID: string
+  scheduleID?: string
   disabled?: boolean
 }
 
@@ -325,11 +503,61 @@ export interface UpdateUserCalendarSubscriptionInput {
   disabled?: boolean
 }
 
+export interface CreateAPIKeyInput {
+  name: string
+  description?: string
+  userID?: string
+  serviceAccount?: boolean
+  scope: APIKeyScope
+  expiresAt?: ISOTimestamp
+}
+
+export type APIKeyScope = 'READ_ONLY' | 'ALERT_OPS' | 'ADMIN'
+
+export interface APIKey {
+  id: string
+  name: string
+  description: string
+  userID?: string
+  user?: User
+  serviceAccount: boolean
+  scope: APIKeyScope
+  createdAt: ISOTimestamp
+  expiresAt?: ISOTimestamp
+  lastUsedAt?: ISOTimestamp
+  token?: string
+}
+
+export interface CreateTeamInput {
+  name: string
+  description?: string
+  userIDs?: string[]
+}
+
+export interface UpdateTeamInput {
+  id: string
+  name?: string
+  description?: string
+}
+
+export interface UpdateTeamMembersInput {
+  teamID: string
+  addUserIDs?: string[]
+  removeUserIDs?: string[]
+}
+
+export interface Team {
+  id: string
+  name: string
+  description: string
+  members: User[]
+}
+
 export interface UserCalendarSubscription {
   id: string
   name: string
   reminderMinutes: number[]
-  scheduleID: string
+  scheduleID?: string
   schedule?: Schedule
   lastAccess: ISOTimestamp
   disabled: boolean
@@ -357,11 +585,28 @@ export interface CreateUserOverrideInput {
   removeUserID?: string
 }
 
+export interface CreateUserTimeOffInput {
+  userID: string
+  start: ISOTimestamp
+  end: ISOTimestamp
+  description?: string
+}
+
+export interface UserTimeOff {
+  id: string
+  userID: string
+  user?: User
+  start: ISOTimestamp
+  end: ISOTimestamp
+  description: string
+}
+
 export interface CreateScheduleInput {
   name: string
   description?: string
   timeZone: string
   favorite?: boolean
+  teamID?: string
   targets?: ScheduleTargetInput[]
   newUserOverrides?: CreateUserOverrideInput[]
 }
@@ -408,6 +653,11 @@ export interface CreateServiceInput {
   favorite?: boolean
   escalationPolicyID?: string
   newEscalationPolicy?: CreateEscalationPolicyInput
+  ackTimeoutMinutes?: number
+  ackTimeoutLimit?: number
+  alertStormThreshold?: number
+  alertStormWindowMinutes?: number
+  teamID?: string
   newIntegrationKeys?: CreateIntegrationKeyInput[]
   labels?: SetLabelInput[]
   newHeartbeatMonitors?: CreateHeartbeatMonitorInput[]
@@ -417,7 +667,10 @@ export interface CreateEscalationPolicyInput {
   name: string
   description?: string
   repeat?: number
+  repeatBackoffMinutes?: number
+  fallbackTarget?: TargetInput
   favorite?: boolean
+  teamID?: string
   steps?: CreateEscalationPolicyStepInput[]
 }
 
@@ -427,6 +680,9 @@ export interface CreateEscalationPolicyStepInput {
   targets?: TargetInput[]
   newRotation?: CreateRotationInput
   newSchedule?: CreateScheduleInput
+  condition?: EscalationStepConditionInput
+  targetMode?: EscalationStepTargetMode
+  sequentialDelayMinutes?: number
 }
 
 export interface EscalationPolicyStep {
@@ -435,6 +691,95 @@ export interface EscalationPolicyStep {
   delayMinutes: number
   targets: Target[]
   escalationPolicy?: EscalationPolicy
+  condition?: EscalationStepCondition
+  targetMode: EscalationStepTargetMode
+  sequentialDelayMinutes: number
+}
+
+export interface CloneEscalationPolicyInput {
+  id: string
+  name: string
+  favorite?: boolean
+}
+
+export interface EscalationPolicyTemplate {
+  id: string
+  name: string
+  description: string
+  repeat: number
+  repeatBackoffMinutes: number
+  steps: EscalationPolicyTemplateStep[]
+  teamID?: string
+  team?: Team
+  params: string[]
+}
+
+export interface EscalationPolicyTemplateStep {
+  delayMinutes: number
+  targetMode: EscalationStepTargetMode
+  sequentialDelayMinutes: number
+  targets: Target[]
+  params: string[]
+}
+
+export interface CreateEscalationPolicyTemplateInput {
+  name: string
+  description?: string
+  repeat?: number
+  repeatBackoffMinutes?: number
+  teamID?: string
+  steps: CreateEscalationPolicyTemplateStepInput[]
+}
+
+export interface CreateEscalationPolicyTemplateStepInput {
+  delayMinutes: number
+  targetMode?: EscalationStepTargetMode
+  sequentialDelayMinutes?: number
+  targets?: TargetInput[]
+  params?: string[]
+}
+
+export interface CreateEscalationPolicyFromTemplateInput {
+  templateID: string
+  name: string
+  description?: string
+  favorite?: boolean
+  params?: EscalationPolicyTemplateParamInput[]
+}
+
+export interface EscalationPolicyTemplateParamInput {
+  name: string
+  target: TargetInput
+}
+
+export type EscalationStepTargetMode = 'all' | 'round_robin' | 'sequential'
+
+export interface EscalationStepCondition {
+  source?: string
+  summaryRegex?: string
+  hours?: EscalationStepHours
+}
+
+export interface EscalationStepHours {
+  timeZone: string
+  start: ClockTime
+  end: ClockTime
+  weekdayFilter: WeekdayFilter
+  outside: boolean
+}
+
+export interface EscalationStepConditionInput {
+  source?: string
+  summaryRegex?: string
+  hours?: EscalationStepHoursInput
+}
+
+export interface EscalationStepHoursInput {
+  timeZone: string
+  start: ClockTime
+  end: ClockTime
+  weekdayFilter: WeekdayFilter
+  outside?: boolean
 }
 
 export interface UpdateScheduleInput {
@@ -442,6 +787,7 @@ export interface UpdateScheduleInput {
   name?: string
   description?: string
   timeZone?: string
+  teamID?: string
 }
 
 export interface UpdateServiceInput {
@@ -449,6 +795,11 @@ export interface UpdateServiceInput {
   name?: string
   description?: string
   escalationPolicyID?: string
+  ackTimeoutMinutes?: number
+  ackTimeoutLimit?: number
+  alertStormThreshold?: number
+  alertStormWindowMinutes?: number
+  teamID?: string
 }
 
 export interface UpdateEscalationPolicyInput {
@@ -456,13 +807,20 @@ export interface UpdateEscalationPolicyInput {
   name?: string
   description?: string
   repeat?: number
+  repeatBackoffMinutes?: number
+  fallbackTarget?: TargetInput
+  clearFallbackTarget?: boolean
   stepIDs?: string[]
+  teamID?: string
 }
 
 export interface UpdateEscalationPolicyStepInput {
   id: string
   delayMinutes?: number
   targets?: TargetInput[]
+  condition?: EscalationStepConditionInput
+  targetMode?: EscalationStepTargetMode
+  sequentialDelayMinutes?: number
 }
 
 export interface SetFavoriteInput {
@@ -490,6 +848,8 @@ export interface Schedule {
   name: string
   description: string
   timeZone: string
+  teamID?: string
+  team?: Team
   assignedTo: Target[]
   shifts: OnCallShift[]
   targets: ScheduleTarget[]
@@ -497,6 +857,7 @@ export interface Schedule {
   isFavorite: boolean
   temporarySchedules: TemporarySchedule[]
   onCallNotificationRules: OnCallNotificationRule[]
+  notices: Notice[]
 }
 
 export interface SetScheduleOnCallNotificationRulesInput {
@@ -554,6 +915,7 @@ export interface CreateRotationInput {
   favorite?: boolean
   type: RotationType
   shiftLength?: number
+  teamID?: string
   userIDs?: string[]
 }
 
@@ -566,6 +928,8 @@ export interface Rotation {
   timeZone: string
   type: RotationType
   shiftLength: number
+  teamID?: string
+  team?: Team
   activeUserIndex: number
   userIDs: string[]
   users: User[]
@@ -587,6 +951,7 @@ export interface UpdateRotationInput {
   start?: ISOTimestamp
   type?: RotationType
   shiftLength?: number
+  teamID?: string
   activeUserIndex?: number
   userIDs?: string[]
 }
@@ -723,6 +1088,8 @@ export interface AlertState {
   lastEscalation: ISOTimestamp
   stepNumber: number
   repeatCount: number
+  fallbackNotified: boolean
+  snoozeUntil?: ISOTimestamp
 }
 
 export interface Service {
@@ -732,16 +1099,42 @@ export interface Service {
   escalationPolicyID: string
   escalationPolicy?: EscalationPolicy
   isFavorite: boolean
+  ackTimeoutMinutes: number
+  ackTimeoutLimit: number
+  alertStormThreshold: number
+  alertStormWindowMinutes: number
+  alertStorm?: AlertStorm
+  teamID?: string
+  team?: Team
   onCallUsers: ServiceOnCallUser[]
   integrationKeys: IntegrationKey[]
   labels: Label[]
   heartbeatMonitors: HeartbeatMonitor[]
 }
 
+export interface AlertStorm {
+  alertID: number
+  alertCount: number
+  startedAt: ISOTimestamp
+  lastAlertAt: ISOTimestamp
+  active: boolean
+}
+
 export interface CreateIntegrationKeyInput {
   serviceID?: string
   type: IntegrationKeyType
   name: string
+  expiresAt?: ISOTimestamp
+}
+
+export interface RotateIntegrationKeyInput {
+  id: string
+  gracePeriodHours?: number
+}
+
+export interface SetIntegrationKeyExpirationInput {
+  id: string
+  expiresAt?: ISOTimestamp
 }
 
 export interface CreateHeartbeatMonitorInput {
@@ -779,6 +1172,49 @@ export interface IntegrationKey {
   type: IntegrationKeyType
   name: string
   href: string
+  routingRules: IntegrationKeyRoutingRule[]
+  createdAt: ISOTimestamp
+  expiresAt?: ISOTimestamp
+  previousSecretExpiresAt?: ISOTimestamp
+  lastUsedAt?: ISOTimestamp
+  requestCount: number
+  notices: Notice[]
+}
+
+export interface IntegrationKeyRoutingRule {
+  id: string
+  name: string
+  conditions: IntegrationKeyRouteCondition[]
+  action: IntegrationKeyRouteAction
+  service?: Service
+}
+
+export interface IntegrationKeyRouteCondition {
+  field: IntegrationKeyRouteField
+  key?: string
+  regex: string
+}
+
+export type IntegrationKeyRouteAction = 'redirect' | 'drop'
+
+export type IntegrationKeyRouteField = 'summary' | 'details' | 'meta'
+
+export interface SetIntegrationKeyRoutingRulesInput {
+  integrationKeyID: string
+  rules: IntegrationKeyRoutingRuleInput[]
+}
+
+export interface IntegrationKeyRoutingRuleInput {
+  name: string
+  conditions?: IntegrationKeyRouteConditionInput[]
+  action: IntegrationKeyRouteAction
+  serviceID?: string
+}
+
+export interface IntegrationKeyRouteConditionInput {
+  field: IntegrationKeyRouteField
+  key?: string
+  regex: string
 }
 
 export type IntegrationKeyType =
@@ -799,7 +1235,11 @@ export interface EscalationPolicy {
   name: string
   description: string
   repeat: number
+  repeatBackoffMinutes: number
+  fallbackTarget?: Target
   isFavorite: boolean
+  teamID?: string
+  team?: Team
   assignedTo: Target[]
   steps: EscalationPolicyStep[]
   notices: Notice[]
@@ -837,6 +1277,7 @@ export type TargetType =
   | 'heartbeatMonitor'
   | 'calendarSubscription'
   | 'userSession'
+  | 'userTimeOff'
 
 export interface ServiceConnection {
   nodes: Service[]
@@ -882,17 +1323,28 @@ export interface User {
   contactMethods: UserContactMethod[]
   notificationRules: UserNotificationRule[]
   calendarSubscriptions: UserCalendarSubscription[]
+  apiKeys: APIKey[]
+  teams: Team[]
   statusUpdateContactMethodID: string
   authSubjects: AuthSubject[]
   sessions: UserSession[]
+  mfaEnabled: boolean
   onCallSteps: EscalationPolicyStep[]
+  timeOff: UserTimeOff[]
   isFavorite: boolean
 }
 
+export interface MFASetup {
+  secret: string
+  url: string
+  recoveryCodes: string[]
+}
+
 export interface UserSession {
   id: string
   current: boolean
   userAgent: string
+  ipAddress: string
   createdAt: ISOTimestamp
   lastAccessAt: ISOTimestamp
 }
@@ -916,6 +1368,8 @@ export interface UserContactMethod {
   lastTestVerifyAt?: ISOTimestamp
   lastTestMessageState?: NotificationState
   lastVerifyMessageState?: NotificationState
+  shiftStartReminderMinutes: number
+  shiftEndNotification: boolean
 }
 
 export interface CreateUserContactMethodInput {
@@ -936,6 +1390,8 @@ export interface UpdateUserContactMethodInput {
   id: string
   name?: string
   value?: string
+  shiftStartReminderMinutes?: number
+  shiftEndNotification?: boolean
 }
 
 export interface SendContactMethodVerificationInput {